@@ -0,0 +1,110 @@
+// Command calibrate runs the calibration examples of a game against its judge and prints the report.
+// The run is stored like one started from the admin page, so both show the same history.
+//
+//	go run ./cmd/calibrate -env dev -game <game id> [-type regex] [-condition '...'] [-model name] [-strictness decoded]
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"log/slog"
+	"os"
+
+	"github.com/everyday-studio/ollm/internal/config"
+	"github.com/everyday-studio/ollm/internal/db"
+	"github.com/everyday-studio/ollm/internal/domain"
+	"github.com/everyday-studio/ollm/internal/kit/judge"
+	"github.com/everyday-studio/ollm/internal/kit/llm"
+	repository "github.com/everyday-studio/ollm/internal/repository/postgres"
+	"github.com/everyday-studio/ollm/internal/usecase"
+)
+
+func main() {
+	envFlag := flag.String("env", "dev", "Environment (dev, prod)")
+	gameID := flag.String("game", "", "ID of the game to calibrate")
+	judgeType := flag.String("type", "", "Candidate judge type (default: the game's)")
+	judgeCondition := flag.String("condition", "", "Candidate judge condition (default: the game's)")
+	judgeModel := flag.String("model", "", "Candidate judge model (default: the game's)")
+	judgeStrictness := flag.String("strictness", "", "Candidate target word strictness (default: the game's)")
+	flag.Parse()
+
+	if *gameID == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	cfg, err := config.LoadConfig(*envFlag)
+	if err != nil {
+		log.Fatalf("Config load error: %v", err)
+	}
+
+	dbConn, err := db.NewDBConnection(cfg)
+	if err != nil {
+		log.Fatalf("DB connection error: %v", err)
+	}
+	defer dbConn.Close()
+
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelWarn}))
+	llmRegistry, err := llm.NewRegistry(cfg.LLM, logger, repository.NewLLMCallRepository(dbConn))
+	if err != nil {
+		log.Fatalf("LLM registry error: %v", err)
+	}
+
+	calibrationUseCase := usecase.NewCalibrationUseCase(
+		repository.NewCalibrationRepository(dbConn),
+		repository.NewGameRepository(dbConn),
+		llmRegistry,
+		judge.NewRegistry(),
+	)
+
+	req := &domain.RunCalibrationRequest{}
+	if *judgeType != "" {
+		t := domain.JudgeType(*judgeType)
+		req.JudgeType = &t
+	}
+	if *judgeCondition != "" {
+		req.JudgeCondition = judgeCondition
+	}
+	if *judgeModel != "" {
+		req.JudgeModel = judgeModel
+	}
+	if *judgeStrictness != "" {
+		s := domain.JudgeStrictness(*judgeStrictness)
+		req.JudgeStrictness = &s
+	}
+
+	run, err := calibrationUseCase.Run(context.Background(), *gameID, req)
+	if err != nil {
+		log.Fatalf("Calibration failed: %v", err)
+	}
+
+	printReport(run)
+}
+
+func printReport(run *domain.CalibrationRun) {
+	fmt.Printf("Run %s · judge %s %q", run.ID, run.Judge.Type, run.Judge.Condition)
+	if run.Judge.Model != "" {
+		fmt.Printf(" · model %s", run.Judge.Model)
+	}
+	if run.Judge.Strictness != "" {
+		fmt.Printf(" · strictness %s", run.Judge.Strictness)
+	}
+	fmt.Println()
+
+	for _, result := range run.Results {
+		switch {
+		case result.Error != "":
+			fmt.Printf("  ERROR  %s expected %-8s %s\n", result.ExampleID, result.Expected, result.Error)
+		case result.Correct():
+			fmt.Printf("  ok     %s expected %-8s got %-8s %s\n", result.ExampleID, result.Expected, result.Outcome, result.Reason)
+		default:
+			fmt.Printf("  WRONG  %s expected %-8s got %-8s %s\n", result.ExampleID, result.Expected, result.Outcome, result.Reason)
+		}
+	}
+
+	fmt.Printf("\nAccuracy %.1f%% (%d/%d) · %d false positives · %d false negatives · %d errors\n",
+		run.Accuracy()*100, run.Correct, run.Total, run.FalsePositives, run.FalseNegatives, run.Errors)
+	fmt.Printf("Tokens %d prompt / %d completion\n", run.PromptTokens, run.CompletionTokens)
+}
@@ -19,6 +19,8 @@ import (
 	"github.com/everyday-studio/ollm/internal/db"
 	"github.com/everyday-studio/ollm/internal/domain"
 	"github.com/everyday-studio/ollm/internal/handler"
+	"github.com/everyday-studio/ollm/internal/job"
+	"github.com/everyday-studio/ollm/internal/kit/judge"
 	"github.com/everyday-studio/ollm/internal/kit/llm"
 	"github.com/everyday-studio/ollm/internal/kit/storage"
 	"github.com/everyday-studio/ollm/internal/middleware"
@@ -33,18 +35,13 @@ func main() {
 			NewLogger,
 			NewDB,
 			echo.New,
-			fx.Annotate(
-				func(cfg *config.Config) domain.LLMService {
-					return llm.NewOpenAIService(cfg.LLM.OpenAIAPIKey)
-				},
-				fx.ResultTags(`name:"chatLLM"`),
-			),
-			fx.Annotate(
-				func(cfg *config.Config) domain.LLMService {
-					return llm.NewOpenAIService(cfg.LLM.OpenAIAPIKey)
-				},
-				fx.ResultTags(`name:"judgeLLM"`),
-			),
+			job.NewScheduler,
+			func(cfg *config.Config, logger *slog.Logger, callRepo domain.LLMCallRepository) (domain.LLMRegistry, error) {
+				return llm.NewRegistry(cfg.LLM, logger, callRepo)
+			},
+			func() domain.JudgeRegistry {
+				return judge.NewRegistry()
+			},
 			func(cfg *config.Config) (domain.StorageService, error) {
 				if cfg.GCP.BucketName == "" {
 					return nil, nil // Or throw an error if you strictly require GCS
@@ -57,11 +54,13 @@ func main() {
 			usecase.NewAuthUseCase,
 			usecase.NewGameUseCase,
 			usecase.NewMatchUseCase,
-			fx.Annotate(
-				usecase.NewMessageUseCase,
-				fx.ParamTags("", "", `name:"chatLLM"`, `name:"judgeLLM"`, ""),
-			),
+			usecase.NewMessageUseCase,
 			usecase.NewLeaderboardUseCase,
+			usecase.NewLLMCallUseCase,
+			usecase.NewQuotaUseCase,
+			usecase.NewCalibrationUseCase,
+			usecase.NewAppealUseCase,
+			usecase.NewSweeperUseCase,
 			func(storage domain.StorageService, userRepo domain.UserRepository, gameRepo domain.GameRepository) domain.UploadUseCase {
 				if storage == nil {
 					return nil
@@ -79,6 +78,14 @@ func main() {
 				return repo.(domain.LeaderboardRepository)
 			},
 			repository.NewMessageRepository,
+			repository.NewLLMCallRepository,
+			repository.NewQuotaRepository,
+			repository.NewTurnVerdictRepository,
+			repository.NewCalibrationRepository,
+			repository.NewAppealRepository,
+			repository.NewMatchOverrideRepository,
+			repository.NewIdempotencyRepository,
+			repository.NewTxManager,
 		),
 		fx.Invoke(
 			middleware.Setup,
@@ -86,14 +93,17 @@ func main() {
 			handler.NewAuthHandler,
 			handler.NewGameHandler,
 			handler.NewMatchHandler,
+			handler.NewAppealHandler,
 			handler.NewMessageHandler,
 			handler.NewLeaderboardHandler,
 			handler.NewAdminHandler,
+			handler.NewHealthHandler,
 			func(h *handler.UploadHandler) {
 				// Simply invoking to trigger NewUploadHandler which registers the route
 			},
 		),
-		fx.Invoke(StartServer),
+		fx.Invoke(job.RegisterSweeper, job.RegisterIdempotencyPurge),
+		fx.Invoke(StartServer, StartJobs),
 		fx.WithLogger(
 			func(cfg *config.Config, logger *slog.Logger) fxevent.Logger {
 				if cfg.App.Env == "prod" || !cfg.App.Debug {
@@ -204,3 +214,15 @@ func StartServer(lc fx.Lifecycle, e *echo.Echo, cfg *config.Config) {
 		},
 	})
 }
+
+func StartJobs(lc fx.Lifecycle, scheduler *job.Scheduler) {
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			scheduler.Start()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			return scheduler.Stop(ctx)
+		},
+	})
+}
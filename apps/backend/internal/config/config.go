@@ -15,6 +15,8 @@ type Config struct {
 	DB     DBConfig     `mapstructure:"db"`
 	Secure SecureConfig `mapstructure:"secure"`
 	LLM    LLMConfig    `mapstructure:"llm"`
+	Quota  QuotaConfig  `mapstructure:"quota"`
+	Jobs   JobsConfig   `mapstructure:"jobs"`
 	GCP    GCPConfig    `mapstructure:"gcp"`
 }
 
@@ -58,6 +60,92 @@ type CookieConfig struct {
 type LLMConfig struct {
 	OpenAIAPIKey string `mapstructure:"openai_api_key"`
 	GroqAPIKey   string `mapstructure:"groq_api_key"`
+	// ChatModel and JudgeModel name the entries of Models used when a game does not select its own.
+	ChatModel  string                       `mapstructure:"chat_model"`
+	JudgeModel string                       `mapstructure:"judge_model"`
+	Providers  map[string]LLMProviderConfig `mapstructure:"providers"`
+	Models     map[string]LLMModelConfig    `mapstructure:"models"`
+	Resilience LLMResilienceConfig          `mapstructure:"resilience"`
+}
+
+// LLMResilienceConfig tunes the retries, timeouts and circuit breaker applied to every provider.
+// Zero values use the defaults of the llm package; a negative MaxRetries disables retries.
+type LLMResilienceConfig struct {
+	MaxRetries         int `mapstructure:"max_retries"`
+	InitialBackoffMs   int `mapstructure:"initial_backoff_ms"`
+	MaxBackoffMs       int `mapstructure:"max_backoff_ms"`
+	GenerateTimeoutSec int `mapstructure:"generate_timeout_sec"`
+	JudgeTimeoutSec    int `mapstructure:"judge_timeout_sec"`
+	AdviceTimeoutSec   int `mapstructure:"advice_timeout_sec"`
+	FailureThreshold   int `mapstructure:"failure_threshold"`
+	OpenDurationSec    int `mapstructure:"open_duration_sec"`
+}
+
+// LLMProviderConfig describes an OpenAI-compatible API endpoint.
+// Type selects the client implementation ("openai", "groq" or "scripted"). When APIKey is empty
+// the matching top-level key (openai_api_key, groq_api_key) is used.
+// The "scripted" type answers offline from the rules in ScriptPath, resolved relative to the config directory.
+type LLMProviderConfig struct {
+	Type       string `mapstructure:"type"`
+	BaseURL    string `mapstructure:"base_url"`
+	APIKey     string `mapstructure:"api_key"`
+	ScriptPath string `mapstructure:"script_path"`
+}
+
+// LLMModelConfig binds a model of a provider to its default sampling parameters.
+// Zero values leave the parameter to the provider's default.
+// Fallbacks names other models, tried in order when this model's provider fails.
+type LLMModelConfig struct {
+	Provider    string   `mapstructure:"provider"`
+	Model       string   `mapstructure:"model"`
+	Temperature float32  `mapstructure:"temperature"`
+	MaxTokens   int      `mapstructure:"max_tokens"`
+	Fallbacks   []string `mapstructure:"fallbacks"`
+	// InputPricePer1M and OutputPricePer1M are the USD prices per million prompt and completion tokens.
+	InputPricePer1M  float64 `mapstructure:"input_price_per_1m"`
+	OutputPricePer1M float64 `mapstructure:"output_price_per_1m"`
+}
+
+// QuotaConfig limits the daily LLM usage of each user and the daily spend of the whole platform.
+// Days start at 00:00 UTC and zero limits are unlimited.
+type QuotaConfig struct {
+	DailySpendCapUSD float64          `mapstructure:"daily_spend_cap_usd"`
+	Guest            QuotaLimitConfig `mapstructure:"guest"`
+	// Roles holds the limits of registered users keyed by lower-cased role name,
+	// as viper lower-cases map keys. Roles without an entry are unlimited.
+	Roles map[string]QuotaLimitConfig `mapstructure:"roles"`
+}
+
+// QuotaLimitConfig is the daily budget of a single user.
+type QuotaLimitConfig struct {
+	DailyTokens int `mapstructure:"daily_tokens"`
+	DailyTurns  int `mapstructure:"daily_turns"`
+}
+
+// JobsConfig holds the settings of the background jobs run by the server.
+type JobsConfig struct {
+	Sweeper          SweeperConfig          `mapstructure:"sweeper"`
+	IdempotencyPurge IdempotencyPurgeConfig `mapstructure:"idempotency_purge"`
+}
+
+// SweeperConfig tunes the job that expires idle matches and recovers matches stuck generating.
+// Zero values use the defaults of the sweeper usecase.
+type SweeperConfig struct {
+	Disabled    bool `mapstructure:"disabled"`
+	IntervalSec int  `mapstructure:"interval_sec"`
+	// IdleTTLMin applies to games that do not set their own idle_ttl_minutes.
+	IdleTTLMin           int `mapstructure:"idle_ttl_min"`
+	GeneratingTimeoutSec int `mapstructure:"generating_timeout_sec"`
+	BatchSize            int `mapstructure:"batch_size"`
+}
+
+// IdempotencyPurgeConfig tunes the job that deletes the idempotency keys of old turns.
+// Zero values use the defaults of the sweeper usecase.
+type IdempotencyPurgeConfig struct {
+	Disabled       bool `mapstructure:"disabled"`
+	IntervalSec    int  `mapstructure:"interval_sec"`
+	RetentionHours int  `mapstructure:"retention_hours"`
+	BatchSize      int  `mapstructure:"batch_size"`
 }
 
 // GCPConfig holds Google Cloud Platform settings including OAuth2 credentials.
@@ -98,5 +186,12 @@ func LoadConfig(env string) (*Config, error) {
 		return nil, fmt.Errorf("failed to unmarshal config: %w", err)
 	}
 
+	for name, provider := range config.LLM.Providers {
+		if provider.ScriptPath != "" && !filepath.IsAbs(provider.ScriptPath) {
+			provider.ScriptPath = filepath.Join(configPath, provider.ScriptPath)
+			config.LLM.Providers[name] = provider
+		}
+	}
+
 	return &config, nil
 }
@@ -10,8 +10,9 @@ import (
 
 func NewDBConnection(cfg *config.Config) (*sql.DB, error) {
 	//DSN Create
+	// CURRENT_TIMESTAMP defaults store UTC wall-clock times in the TIMESTAMP columns
 	dsn := fmt.Sprintf(
-		"host=%s port=%d user=%s password=%s dbname=%s sslmode=%s",
+		"host=%s port=%d user=%s password=%s dbname=%s sslmode=%s timezone=UTC",
 		cfg.DB.Host, cfg.DB.Port, cfg.DB.User, cfg.DB.Password, cfg.DB.DBName, cfg.DB.SSLMode,
 	)
 
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE games
+ADD COLUMN chat_model VARCHAR(100) NOT NULL DEFAULT '',
+ADD COLUMN judge_model VARCHAR(100) NOT NULL DEFAULT '',
+ADD COLUMN temperature DOUBLE PRECISION NOT NULL DEFAULT 0 CHECK (temperature >= 0 AND temperature <= 2),
+ADD COLUMN max_tokens INTEGER NOT NULL DEFAULT 0 CHECK (max_tokens >= 0);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE games
+DROP COLUMN IF EXISTS max_tokens,
+DROP COLUMN IF EXISTS temperature,
+DROP COLUMN IF EXISTS judge_model,
+DROP COLUMN IF EXISTS chat_model;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE messages
+ADD COLUMN provider VARCHAR(50) NOT NULL DEFAULT '',
+ADD COLUMN model VARCHAR(100) NOT NULL DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE messages
+DROP COLUMN IF EXISTS model,
+DROP COLUMN IF EXISTS provider;
+-- +goose StatementEnd
@@ -0,0 +1,33 @@
+-- +goose NO TRANSACTION
+
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS llm_calls (
+    id VARCHAR(26) PRIMARY KEY,
+    match_id VARCHAR(26) REFERENCES matches(id) ON DELETE CASCADE,
+    message_id VARCHAR(26),
+    purpose VARCHAR(20) NOT NULL CHECK (purpose IN ('chat', 'judge', 'advice')),
+    provider VARCHAR(50) NOT NULL DEFAULT '',
+    model VARCHAR(100) NOT NULL DEFAULT '',
+    request JSONB NOT NULL DEFAULT '[]',
+    response TEXT NOT NULL DEFAULT '',
+    latency_ms INTEGER NOT NULL DEFAULT 0,
+    prompt_tokens INTEGER NOT NULL DEFAULT 0,
+    completion_tokens INTEGER NOT NULL DEFAULT 0,
+    error TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_llm_calls_match_id ON llm_calls(match_id, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX CONCURRENTLY IF EXISTS idx_llm_calls_match_id;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE IF EXISTS llm_calls;
+-- +goose StatementEnd
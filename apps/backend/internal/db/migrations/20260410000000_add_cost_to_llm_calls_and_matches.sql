@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE llm_calls
+ADD COLUMN cost_usd NUMERIC(14, 8) NOT NULL DEFAULT 0;
+
+ALTER TABLE matches
+ADD COLUMN cost_usd NUMERIC(14, 8) NOT NULL DEFAULT 0;
+
+CREATE INDEX IF NOT EXISTS idx_llm_calls_created_at ON llm_calls(created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_llm_calls_created_at;
+
+ALTER TABLE matches
+DROP COLUMN IF EXISTS cost_usd;
+
+ALTER TABLE llm_calls
+DROP COLUMN IF EXISTS cost_usd;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+-- judge_type은 애플리케이션의 judge registry에서 검증
+ALTER TABLE games
+DROP CONSTRAINT IF EXISTS games_judge_type_check;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE games
+ADD CONSTRAINT games_judge_type_check
+    CHECK (judge_type IN ('target_word', 'format_break', 'llm_judge'));
+-- +goose StatementEnd
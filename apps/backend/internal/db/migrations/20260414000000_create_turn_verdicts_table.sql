@@ -0,0 +1,32 @@
+-- +goose NO TRANSACTION
+
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS turn_verdicts (
+    id VARCHAR(26) PRIMARY KEY,
+    match_id VARCHAR(26) NOT NULL REFERENCES matches(id) ON DELETE CASCADE,
+    message_id VARCHAR(26) NOT NULL REFERENCES messages(id) ON DELETE CASCADE,
+    turn_count INTEGER NOT NULL,
+    judge_type VARCHAR(50) NOT NULL,
+    outcome VARCHAR(20) NOT NULL CHECK (outcome IN ('continue', 'won', 'lost')),
+    reason TEXT NOT NULL DEFAULT '',
+    output TEXT NOT NULL DEFAULT '',
+    error TEXT NOT NULL DEFAULT '',
+    prompt_tokens INTEGER NOT NULL DEFAULT 0,
+    completion_tokens INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_turn_verdicts_match_id ON turn_verdicts(match_id, turn_count);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX CONCURRENTLY IF EXISTS idx_turn_verdicts_match_id;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE IF EXISTS turn_verdicts;
+-- +goose StatementEnd
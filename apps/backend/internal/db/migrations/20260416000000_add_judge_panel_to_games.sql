@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE games
+ADD COLUMN judge_panel TEXT[] NOT NULL DEFAULT '{}',
+ADD COLUMN judge_policy VARCHAR(20) NOT NULL DEFAULT '',
+ADD COLUMN judge_quorum INTEGER NOT NULL DEFAULT 0 CHECK (judge_quorum >= 0);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE turn_verdicts
+ADD COLUMN votes JSONB NOT NULL DEFAULT '[]';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE turn_verdicts
+DROP COLUMN IF EXISTS votes;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE games
+DROP COLUMN IF EXISTS judge_quorum,
+DROP COLUMN IF EXISTS judge_policy,
+DROP COLUMN IF EXISTS judge_panel;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE games
+ADD COLUMN judge_strictness VARCHAR(20) NOT NULL DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE turn_verdicts
+ADD COLUMN target_match JSONB;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE turn_verdicts
+DROP COLUMN IF EXISTS target_match;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE games
+DROP COLUMN IF EXISTS judge_strictness;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE matches
+ADD COLUMN judge_progress JSONB;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE matches
+DROP COLUMN IF EXISTS judge_progress;
+-- +goose StatementEnd
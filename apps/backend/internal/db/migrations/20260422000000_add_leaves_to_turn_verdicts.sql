@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE turn_verdicts
+ADD COLUMN leaves JSONB NOT NULL DEFAULT '[]';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE turn_verdicts
+DROP COLUMN IF EXISTS leaves;
+-- +goose StatementEnd
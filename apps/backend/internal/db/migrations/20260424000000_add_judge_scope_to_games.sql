@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE games
+ADD COLUMN judge_scope VARCHAR(20) NOT NULL DEFAULT '',
+ADD COLUMN judge_scope_turns INTEGER NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE games
+DROP COLUMN IF EXISTS judge_scope_turns,
+DROP COLUMN IF EXISTS judge_scope;
+-- +goose StatementEnd
@@ -0,0 +1,76 @@
+-- +goose NO TRANSACTION
+
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS appeals (
+    id VARCHAR(26) PRIMARY KEY,
+    match_id VARCHAR(26) NOT NULL REFERENCES matches(id) ON DELETE CASCADE,
+    user_id VARCHAR(26) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    verdict_id VARCHAR(26) NOT NULL REFERENCES turn_verdicts(id) ON DELETE CASCADE,
+    turn_count INTEGER NOT NULL,
+    comment TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'upheld', 'overturned')),
+    resolution TEXT NOT NULL DEFAULT '',
+    resolved_by VARCHAR(26) REFERENCES users(id) ON DELETE SET NULL,
+    resolved_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_appeals_match_id ON appeals(match_id, created_at);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+-- A match has at most one pending appeal
+CREATE UNIQUE INDEX CONCURRENTLY IF NOT EXISTS idx_appeals_pending_match_id ON appeals(match_id) WHERE status = 'pending';
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_appeals_status ON appeals(status, created_at);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS match_overrides (
+    id VARCHAR(26) PRIMARY KEY,
+    match_id VARCHAR(26) NOT NULL REFERENCES matches(id) ON DELETE CASCADE,
+    appeal_id VARCHAR(26) REFERENCES appeals(id) ON DELETE SET NULL,
+    admin_id VARCHAR(26) REFERENCES users(id) ON DELETE SET NULL,
+    action VARCHAR(20) NOT NULL CHECK (action IN ('rejudge', 'set_status', 'uphold')),
+    from_status VARCHAR(20) NOT NULL,
+    to_status VARCHAR(20) NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    verdict JSONB,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_match_overrides_match_id ON match_overrides(match_id, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX CONCURRENTLY IF EXISTS idx_match_overrides_match_id;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE IF EXISTS match_overrides;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP INDEX CONCURRENTLY IF EXISTS idx_appeals_status;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP INDEX CONCURRENTLY IF EXISTS idx_appeals_pending_match_id;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP INDEX CONCURRENTLY IF EXISTS idx_appeals_match_id;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE IF EXISTS appeals;
+-- +goose StatementEnd
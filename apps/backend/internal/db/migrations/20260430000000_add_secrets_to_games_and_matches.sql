@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE games
+ADD COLUMN secrets JSONB NOT NULL DEFAULT '{}';
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE matches
+ADD COLUMN secrets JSONB NOT NULL DEFAULT '{}';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE matches
+DROP COLUMN IF EXISTS secrets;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE games
+DROP COLUMN IF EXISTS secrets;
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose NO TRANSACTION
+
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE games
+ADD COLUMN idle_ttl_minutes INTEGER NOT NULL DEFAULT 0 CHECK (idle_ttl_minutes >= 0);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+-- The sweeper looks for active matches left idle and generating matches left stuck
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_matches_status_updated_at ON matches(status, updated_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX CONCURRENTLY IF EXISTS idx_matches_status_updated_at;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE games
+DROP COLUMN IF EXISTS idle_ttl_minutes;
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Bumped by every status transition so a writer holding a stale copy of a match is refused
+ALTER TABLE matches
+ADD COLUMN version INTEGER NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE matches
+DROP COLUMN IF EXISTS version;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- A zero temperature used to mean the model default; NULL means that now, so 0 can be a deterministic setting
+ALTER TABLE games
+ALTER COLUMN temperature DROP NOT NULL,
+ALTER COLUMN temperature DROP DEFAULT;
+
+UPDATE games SET temperature = NULL WHERE temperature = 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+UPDATE games SET temperature = 0 WHERE temperature IS NULL;
+
+ALTER TABLE games
+ALTER COLUMN temperature SET DEFAULT 0,
+ALTER COLUMN temperature SET NOT NULL;
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The token of the current reservation, so a request whose stale key was taken over cannot complete or release it
+ALTER TABLE idempotency_keys
+ADD COLUMN token VARCHAR(26) NOT NULL DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE idempotency_keys
+DROP COLUMN IF EXISTS token;
+-- +goose StatementEnd
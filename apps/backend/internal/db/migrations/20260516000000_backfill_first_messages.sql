@@ -0,0 +1,55 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Build a ULID for the given time, the way the message repository names messages
+CREATE OR REPLACE FUNCTION backfill_message_id(created TIMESTAMP)
+RETURNS VARCHAR(26) AS $$
+DECLARE
+  alphabet CONSTANT TEXT := '0123456789ABCDEFGHJKMNPQRSTVWXYZ';
+  millis BIGINT := floor(extract(epoch FROM created) * 1000)::BIGINT;
+  id TEXT := '';
+BEGIN
+  FOR i IN REVERSE 9..0 LOOP
+    id := id || substr(alphabet, ((millis >> (5 * i)) & 31)::INT + 1, 1);
+  END LOOP;
+  FOR i IN 1..16 LOOP
+    id := id || substr(alphabet, floor(random() * 32)::INT + 1, 1);
+  END LOOP;
+  RETURN id;
+END;
+$$ LANGUAGE plpgsql VOLATILE;
+
+-- Matches created before the first message was saved with the match get it as their turn 0 message,
+-- filled in the way the match usecase does: matches of that time have no secrets, only the nickname and game title
+INSERT INTO messages (id, match_id, role, content, is_visible, turn_count, token_count, created_at)
+SELECT
+  backfill_message_id(m.created_at),
+  m.id,
+  'assistant',
+  regexp_replace(
+    regexp_replace(g.first_message, '\{\{\s*nickname\s*\}\}', replace(u.name, '\', '\\'), 'g'),
+    '\{\{\s*game\s*\}\}', replace(g.title, '\', '\\'), 'g'
+  ),
+  true,
+  0,
+  0,
+  m.created_at
+FROM matches m
+JOIN games g ON g.id = m.game_id
+JOIN users u ON u.id = m.user_id
+WHERE btrim(g.first_message) <> ''
+  AND NOT EXISTS (
+    SELECT 1
+    FROM messages greeting
+    WHERE greeting.match_id = m.id
+      AND greeting.role = 'assistant'
+      AND greeting.turn_count = 0
+  );
+
+DROP FUNCTION backfill_message_id(TIMESTAMP);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- The backfilled greetings stay: they can't be told apart from the greetings saved with their match
+SELECT 1;
+-- +goose StatementEnd
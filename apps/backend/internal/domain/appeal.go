@@ -0,0 +1,112 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type AppealStatus string
+
+const (
+	AppealStatusPending    AppealStatus = "pending"    // waiting for an admin
+	AppealStatusUpheld     AppealStatus = "upheld"     // the verdict stands
+	AppealStatusOverturned AppealStatus = "overturned" // the match status was changed
+)
+
+// Appeal is a player's dispute of the verdict of one turn of a finished match.
+// Admins review pending appeals and either uphold the verdict, re-run the judge on the turn, or set the match status by hand.
+type Appeal struct {
+	ID         string       `json:"id"`
+	MatchID    string       `json:"match_id"`
+	UserID     string       `json:"user_id"`
+	VerdictID  string       `json:"verdict_id"` // verdict of the turn that is disputed
+	TurnCount  int          `json:"turn_count"`
+	Comment    string       `json:"comment"`
+	Status     AppealStatus `json:"status"`
+	Resolution string       `json:"resolution,omitempty"` // admin's answer to the player
+	ResolvedBy string       `json:"-"`                    // admin who resolved the appeal
+	ResolvedAt *time.Time   `json:"resolved_at,omitempty"`
+	CreatedAt  time.Time    `json:"created_at"`
+	UpdatedAt  time.Time    `json:"updated_at"`
+}
+
+type MatchOverrideAction string
+
+const (
+	MatchOverrideActionRejudge   MatchOverrideAction = "rejudge"    // the judge was run again on the turn
+	MatchOverrideActionSetStatus MatchOverrideAction = "set_status" // an admin set the status by hand
+	MatchOverrideActionUphold    MatchOverrideAction = "uphold"     // an admin upheld the verdict without change
+)
+
+// MatchOverride is the audit record of an admin decision on the outcome of a match.
+// Every decision is recorded, including those that leave the status as it was.
+type MatchOverride struct {
+	ID         string              `json:"id"`
+	MatchID    string              `json:"match_id"`
+	AppealID   string              `json:"appeal_id,omitempty"`
+	AdminID    string              `json:"admin_id"`
+	Action     MatchOverrideAction `json:"action"`
+	FromStatus MatchStatus         `json:"from_status"`
+	ToStatus   MatchStatus         `json:"to_status"`
+	Reason     string              `json:"reason"`
+	Verdict    *JudgeVerdict       `json:"verdict,omitempty"` // new verdict of the turn, for a re-judge
+	CreatedAt  time.Time           `json:"created_at"`
+}
+
+// AppealReview is everything an admin needs to decide an appeal.
+type AppealReview struct {
+	Appeal    Appeal
+	Match     Match
+	Game      Game
+	Messages  []Message       // transcript of the match
+	Verdicts  []TurnVerdict   // verdicts of every turn; the disputed one has the appeal's VerdictID
+	Overrides []MatchOverride // earlier decisions on the match, oldest first
+}
+
+// CreateAppealRequest is the DTO for disputing the verdict of a turn
+type CreateAppealRequest struct {
+	TurnCount int    `json:"turn_count"`
+	Comment   string `json:"comment"`
+}
+
+// ResolveAppealRequest is the DTO for an admin decision on an appeal.
+// Status is only read when setting the status by hand.
+type ResolveAppealRequest struct {
+	Status MatchStatus `json:"status"`
+	Reason string      `json:"reason"`
+}
+
+// AppealRepository defines the interface for appeal data access
+type AppealRepository interface {
+	// Create inserts an appeal, or returns ErrConflict if the match already has a pending one.
+	Create(ctx context.Context, appeal *Appeal) (*Appeal, error)
+	GetByID(ctx context.Context, id string) (*Appeal, error)
+	GetByMatchID(ctx context.Context, matchID string) ([]Appeal, error)
+	// GetPending returns the pending appeals, oldest first.
+	GetPending(ctx context.Context, limit int) ([]Appeal, error)
+	// Resolve closes a pending appeal, or returns ErrConflict if it was already resolved.
+	Resolve(ctx context.Context, appeal *Appeal) (*Appeal, error)
+}
+
+// MatchOverrideRepository defines the interface for the audit log of match overrides
+type MatchOverrideRepository interface {
+	Create(ctx context.Context, override *MatchOverride) (*MatchOverride, error)
+	GetByMatchID(ctx context.Context, matchID string) ([]MatchOverride, error)
+}
+
+// AppealUseCase defines the interface for appeals of judge verdicts
+type AppealUseCase interface {
+	// Create disputes the verdict of a turn of the user's finished match.
+	Create(ctx context.Context, matchID string, userID string, req *CreateAppealRequest) (*Appeal, error)
+	GetByMatchID(ctx context.Context, matchID string, userID string) ([]Appeal, error)
+
+	// GetPending returns the queue of appeals waiting for an admin, oldest first.
+	GetPending(ctx context.Context) ([]Appeal, error)
+	GetReview(ctx context.Context, id string) (*AppealReview, error)
+	// Rejudge runs the game's judge again on the disputed turn and applies its outcome to the match.
+	Rejudge(ctx context.Context, id string, adminID string, req *ResolveAppealRequest) (*MatchOverride, error)
+	// SetStatus sets the status of the match by hand.
+	SetStatus(ctx context.Context, id string, adminID string, req *ResolveAppealRequest) (*MatchOverride, error)
+	// Uphold closes the appeal and leaves the match as it is.
+	Uphold(ctx context.Context, id string, adminID string, req *ResolveAppealRequest) (*MatchOverride, error)
+}
@@ -0,0 +1,112 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// CalibrationExample is a labeled AI output of a game, with the verdict its judge is expected to reach on it.
+// The examples of a game are run against its judge to see whether a change made the judge stricter or looser.
+type CalibrationExample struct {
+	ID        string       `json:"id"`
+	GameID    string       `json:"game_id"`
+	Output    string       `json:"output"`   // AI reply to be judged
+	Expected  JudgeOutcome `json:"expected"` // won or continue
+	Note      string       `json:"note,omitempty"`
+	CreatedAt time.Time    `json:"created_at"`
+}
+
+// CalibrationJudge is the judge configuration a calibration run was made with.
+type CalibrationJudge struct {
+	Type       JudgeType       `json:"type"`
+	Condition  string          `json:"condition"`
+	Model      string          `json:"model,omitempty"`
+	Panel      []string        `json:"panel,omitempty"`
+	Policy     JudgePolicy     `json:"policy,omitempty"`
+	Quorum     int             `json:"quorum,omitempty"`
+	Strictness JudgeStrictness `json:"strictness,omitempty"`
+	Scope      JudgeScope      `json:"scope,omitempty"`
+	ScopeTurns int             `json:"scope_turns,omitempty"`
+}
+
+// CalibrationResult is the ruling of the judge on one example of a calibration run.
+type CalibrationResult struct {
+	ExampleID        string       `json:"example_id"`
+	Expected         JudgeOutcome `json:"expected"`
+	Outcome          JudgeOutcome `json:"outcome,omitempty"` // empty if the judge failed
+	Reason           string       `json:"reason,omitempty"`
+	Error            string       `json:"error,omitempty"`
+	PromptTokens     int          `json:"prompt_tokens"`
+	CompletionTokens int          `json:"completion_tokens"`
+}
+
+// Correct reports whether the judge ruled as the example is labeled.
+func (r CalibrationResult) Correct() bool {
+	return r.Error == "" && (r.Outcome == JudgeOutcomeWon) == (r.Expected == JudgeOutcomeWon)
+}
+
+// CalibrationRun is the report of running the calibration examples of a game against a judge configuration.
+// A false positive is a win the judge gave on an example labeled continue, a false negative a win it missed;
+// an example the judge failed on counts as an error and not as correct.
+type CalibrationRun struct {
+	ID               string              `json:"id"`
+	GameID           string              `json:"game_id"`
+	Judge            CalibrationJudge    `json:"judge"`
+	Total            int                 `json:"total"`
+	Correct          int                 `json:"correct"`
+	FalsePositives   int                 `json:"false_positives"`
+	FalseNegatives   int                 `json:"false_negatives"`
+	Errors           int                 `json:"errors"`
+	Results          []CalibrationResult `json:"results"`
+	PromptTokens     int                 `json:"prompt_tokens"`
+	CompletionTokens int                 `json:"completion_tokens"`
+	CreatedAt        time.Time           `json:"created_at"`
+}
+
+// Accuracy returns the share of the examples the judge ruled correctly, from 0 to 1.
+func (r *CalibrationRun) Accuracy() float64 {
+	if r.Total == 0 {
+		return 0
+	}
+	return float64(r.Correct) / float64(r.Total)
+}
+
+// CreateCalibrationExampleRequest is the DTO for labeling an AI output of a game
+type CreateCalibrationExampleRequest struct {
+	Output   string       `json:"output"`
+	Expected JudgeOutcome `json:"expected"`
+	Note     string       `json:"note"`
+}
+
+// RunCalibrationRequest is the DTO for a calibration run.
+// The judge settings given replace those of the game for this run only, so a change can be checked before it is saved;
+// nil keeps the game's own. A candidate judge type or model is judged alone unless a candidate panel is given too,
+// so the game's panel can't outvote it.
+type RunCalibrationRequest struct {
+	JudgeType       *JudgeType       `json:"judge_type"`
+	JudgeCondition  *string          `json:"judge_condition"`
+	JudgeModel      *string          `json:"judge_model"`
+	JudgePanel      *[]string        `json:"judge_panel"`
+	JudgeStrictness *JudgeStrictness `json:"judge_strictness"`
+}
+
+// CalibrationRepository defines the interface for calibration data access
+type CalibrationRepository interface {
+	CreateExample(ctx context.Context, example *CalibrationExample) (*CalibrationExample, error)
+	GetExamplesByGameID(ctx context.Context, gameID string) ([]CalibrationExample, error)
+	// DeleteExample deletes an example of the game, or returns ErrNotFound if the game has no such example.
+	DeleteExample(ctx context.Context, gameID, id string) error
+	CreateRun(ctx context.Context, run *CalibrationRun) (*CalibrationRun, error)
+	// GetRunsByGameID returns the latest runs of the game, newest first.
+	GetRunsByGameID(ctx context.Context, gameID string, limit int) ([]CalibrationRun, error)
+}
+
+// CalibrationUseCase defines the interface for calibrating the judge of a game against labeled examples
+type CalibrationUseCase interface {
+	CreateExample(ctx context.Context, gameID string, req *CreateCalibrationExampleRequest) (*CalibrationExample, error)
+	GetExamples(ctx context.Context, gameID string) ([]CalibrationExample, error)
+	DeleteExample(ctx context.Context, gameID, id string) error
+	// Run judges every example of the game and stores the report.
+	Run(ctx context.Context, gameID string, req *RunCalibrationRequest) (*CalibrationRun, error)
+	GetRuns(ctx context.Context, gameID string) ([]CalibrationRun, error)
+}
@@ -11,4 +11,6 @@ var (
 	ErrForbidden          = errors.New("forbidden access")
 	ErrInvalidCredentials = errors.New("invalid credentials")
 	ErrConflict           = errors.New("conflict")
+	ErrLLMUnavailable     = errors.New("llm unavailable")
+	ErrQuotaExceeded      = errors.New("quota exceeded")
 )
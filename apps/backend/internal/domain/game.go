@@ -7,6 +7,10 @@ import (
 
 type GameStatus string
 type JudgeType string
+type JudgePolicy string
+type JudgeStrictness string
+type JudgeScope string
+type SecretType string
 type GameSortBy string
 
 const (
@@ -16,54 +20,138 @@ const (
 	JudgeTypeTargetWord  JudgeType = "target_word"
 	JudgeTypeLLMJudge    JudgeType = "llm_judge"
 	JudgeTypeFormatBreak JudgeType = "format_break"
+	JudgeTypeRegex       JudgeType = "regex"
+	JudgeTypeJSONSchema  JudgeType = "json_schema"
+	JudgeTypeComposite   JudgeType = "composite"
+
+	JudgePolicyMajority  JudgePolicy = "majority"
+	JudgePolicyUnanimous JudgePolicy = "unanimous"
+	JudgePolicyQuorum    JudgePolicy = "quorum"
+
+	JudgeStrictnessExact      JudgeStrictness = "exact"      // whole word, ignoring case
+	JudgeStrictnessNormalized JudgeStrictness = "normalized" // also through spacing, look-alike letters, jamo, leetspeak and reversal
+	JudgeStrictnessDecoded    JudgeStrictness = "decoded"    // also inside base64, hex and ROT13
+
+	JudgeScopeLastReply  JudgeScope = "last_reply" // the AI reply of the turn only
+	JudgeScopeLastTurns  JudgeScope = "last_turns" // the user messages and AI replies of the last JudgeScopeTurns turns
+	JudgeScopeTranscript JudgeScope = "transcript" // the whole conversation of the match
+
+	SecretTypeWord   SecretType = "word"   // one of Words
+	SecretTypeCode   SecretType = "code"   // Length random characters of Charset
+	SecretTypeChoice SecretType = "choice" // one of Options, at the same position as every other choice with the same Seed
 
 	GameSortByRecent  GameSortBy = "recent"
 	GameSortByName    GameSortBy = "name"
 	GameSortByPopular GameSortBy = "popular"
 )
 
-// Game represents a text-based game in the platform
+// Game represents a text-based game in the platform.
+// ChatModel and JudgeModel name models of the LLM registry (empty uses the platform default);
+// Temperature and MaxTokens tune the chat model per game (nil and zero keep the model default).
+// JudgePanel names the judge models that vote on every turn of an LLM-judged game, decided by JudgePolicy
+// (empty is majority) with JudgeQuorum as the k of a quorum policy; an empty panel leaves the ruling to JudgeModel.
+// JudgeStrictness sets how hard the target word judge looks for the word (empty is normalized).
+// JudgeScope sets how much of the conversation the LLM judge rules on (empty is the last reply),
+// with JudgeScopeTurns as the N of the last N turns.
+// Secrets generate the {{name}} variables of SystemPrompt, JudgeCondition and FirstMessage afresh for every match.
+// IdleTTLMinutes is how long a match can sit untouched before it expires (zero uses the platform default).
 type Game struct {
-	ID             string     `json:"id"`
-	Title          string     `json:"title"`
-	Description    string     `json:"description"`
-	AuthorID       string     `json:"author_id"`
-	Status         GameStatus `json:"status"`
-	IsPublic       bool       `json:"is_public"`
-	SystemPrompt   string     `json:"system_prompt,omitempty"`
-	FirstMessage   string     `json:"first_message"`
-	JudgeType      JudgeType  `json:"judge_type"`
-	JudgeCondition string     `json:"judge_condition,omitempty"`
-	MaxTurns       int        `json:"max_turns"`
-	PlayCount      int        `json:"play_count"`
-	CreatedAt      time.Time  `json:"created_at"`
-	UpdatedAt      time.Time  `json:"updated_at"`
+	ID              string                     `json:"id"`
+	Title           string                     `json:"title"`
+	Description     string                     `json:"description"`
+	AuthorID        string                     `json:"author_id"`
+	Status          GameStatus                 `json:"status"`
+	IsPublic        bool                       `json:"is_public"`
+	SystemPrompt    string                     `json:"system_prompt,omitempty"`
+	FirstMessage    string                     `json:"first_message"`
+	JudgeType       JudgeType                  `json:"judge_type"`
+	JudgeCondition  string                     `json:"judge_condition,omitempty"`
+	MaxTurns        int                        `json:"max_turns"`
+	ChatModel       string                     `json:"chat_model,omitempty"`
+	JudgeModel      string                     `json:"judge_model,omitempty"`
+	JudgePanel      []string                   `json:"judge_panel,omitempty"`
+	JudgePolicy     JudgePolicy                `json:"judge_policy,omitempty"`
+	JudgeQuorum     int                        `json:"judge_quorum,omitempty"`
+	JudgeStrictness JudgeStrictness            `json:"judge_strictness,omitempty"`
+	JudgeScope      JudgeScope                 `json:"judge_scope,omitempty"`
+	JudgeScopeTurns int                        `json:"judge_scope_turns,omitempty"`
+	Temperature     *float64                   `json:"temperature,omitempty"`
+	MaxTokens       int                        `json:"max_tokens,omitempty"`
+	Secrets         map[string]SecretGenerator `json:"secrets,omitempty"`
+	IdleTTLMinutes  int                        `json:"idle_ttl_minutes,omitempty"`
+	PlayCount       int                        `json:"play_count"`
+	CreatedAt       time.Time                  `json:"created_at"`
+	UpdatedAt       time.Time                  `json:"updated_at"`
+}
+
+// ChatParams returns the sampling parameters the game's AI is played with
+func (g *Game) ChatParams() LLMParams {
+	return LLMParams{
+		Temperature: g.Temperature,
+		MaxTokens:   g.MaxTokens,
+	}
+}
+
+// SecretGenerator draws the value of a game's template variable when a match is created,
+// so a secret leaked by one player doesn't give away the matches of everyone else.
+type SecretGenerator struct {
+	Type    SecretType `json:"type"`
+	Words   []string   `json:"words,omitempty"`
+	Length  int        `json:"length,omitempty"`
+	Charset string     `json:"charset,omitempty"` // empty is upper-case letters and digits without look-alikes
+	Options []string   `json:"options,omitempty"`
+	Seed    string     `json:"seed,omitempty"` // choices sharing a seed stay paired, e.g. a persona and its password
 }
 
 // CreateGameRequest is the DTO for creating a new game
 type CreateGameRequest struct {
-	Title          string    `json:"title"`
-	Description    string    `json:"description"`
-	AuthorID       string    `json:"author_id"`
-	SystemPrompt   string    `json:"system_prompt"`
-	FirstMessage   string    `json:"first_message"`
-	JudgeType      JudgeType `json:"judge_type"`
-	JudgeCondition string    `json:"judge_condition"`
-	MaxTurns       int       `json:"max_turns"`
+	Title           string                     `json:"title"`
+	Description     string                     `json:"description"`
+	AuthorID        string                     `json:"author_id"`
+	SystemPrompt    string                     `json:"system_prompt"`
+	FirstMessage    string                     `json:"first_message"`
+	JudgeType       JudgeType                  `json:"judge_type"`
+	JudgeCondition  string                     `json:"judge_condition"`
+	MaxTurns        int                        `json:"max_turns"`
+	ChatModel       string                     `json:"chat_model"`
+	JudgeModel      string                     `json:"judge_model"`
+	JudgePanel      []string                   `json:"judge_panel"`
+	JudgePolicy     JudgePolicy                `json:"judge_policy"`
+	JudgeQuorum     int                        `json:"judge_quorum"`
+	JudgeStrictness JudgeStrictness            `json:"judge_strictness"`
+	JudgeScope      JudgeScope                 `json:"judge_scope"`
+	JudgeScopeTurns int                        `json:"judge_scope_turns"`
+	Temperature     *float64                   `json:"temperature"`
+	MaxTokens       int                        `json:"max_tokens"`
+	Secrets         map[string]SecretGenerator `json:"secrets"`
+	IdleTTLMinutes  int                        `json:"idle_ttl_minutes"`
 }
 
 // UpdateGameRequest is the DTO for updating an existing game
-// All fields are optional (pointers indicate optional fields)
+// All fields are optional (pointers indicate optional fields); ClearTemperature returns to the model's default temperature
 type UpdateGameRequest struct {
-	Title          *string     `json:"title"`
-	Description    *string     `json:"description"`
-	Status         *GameStatus `json:"status"`
-	IsPublic       *bool       `json:"is_public"`
-	SystemPrompt   *string     `json:"system_prompt"`
-	FirstMessage   *string     `json:"first_message"`
-	JudgeType      *JudgeType  `json:"judge_type"`
-	JudgeCondition *string     `json:"judge_condition"`
-	MaxTurns       *int        `json:"max_turns"`
+	Title            *string                     `json:"title"`
+	Description      *string                     `json:"description"`
+	Status           *GameStatus                 `json:"status"`
+	IsPublic         *bool                       `json:"is_public"`
+	SystemPrompt     *string                     `json:"system_prompt"`
+	FirstMessage     *string                     `json:"first_message"`
+	JudgeType        *JudgeType                  `json:"judge_type"`
+	JudgeCondition   *string                     `json:"judge_condition"`
+	MaxTurns         *int                        `json:"max_turns"`
+	ChatModel        *string                     `json:"chat_model"`
+	JudgeModel       *string                     `json:"judge_model"`
+	JudgePanel       *[]string                   `json:"judge_panel"`
+	JudgePolicy      *JudgePolicy                `json:"judge_policy"`
+	JudgeQuorum      *int                        `json:"judge_quorum"`
+	JudgeStrictness  *JudgeStrictness            `json:"judge_strictness"`
+	JudgeScope       *JudgeScope                 `json:"judge_scope"`
+	JudgeScopeTurns  *int                        `json:"judge_scope_turns"`
+	Temperature      *float64                    `json:"temperature"`
+	ClearTemperature bool                        `json:"clear_temperature"`
+	MaxTokens        *int                        `json:"max_tokens"`
+	Secrets          *map[string]SecretGenerator `json:"secrets"`
+	IdleTTLMinutes   *int                        `json:"idle_ttl_minutes"`
 }
 
 // GameFilter defines the filter options for game listing queries
@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// IdempotencyKey records a turn submitted with an Idempotency-Key header.
+// Fingerprint identifies the request the key was first used for, and Result holds
+// the outcome of the turn once it completed; a nil Result means the turn is still being played.
+// Token is drawn for every reservation, so a holder whose stale key was taken over can no longer touch it.
+type IdempotencyKey struct {
+	UserID      string
+	Key         string
+	Token       string
+	MatchID     string
+	Fingerprint string
+	Result      *TurnResult
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// IdempotencyRepository stores idempotency keys per user
+type IdempotencyRepository interface {
+	// Reserve claims the key for a new request. When the user already used the key it returns
+	// the existing record and false instead, leaving the record untouched; a key reserved before
+	// staleBefore that never got a result is taken over instead, since its request was abandoned.
+	Reserve(ctx context.Context, key *IdempotencyKey, staleBefore time.Time) (*IdempotencyKey, bool, error)
+	// Complete stores the result of the request that reserved the key with token.
+	Complete(ctx context.Context, userID string, key string, token string, result *TurnResult) error
+	// Release frees a key whose request failed, so it can be used again, unless another request took it over.
+	Release(ctx context.Context, userID string, key string, token string) error
+	// Purge deletes up to limit keys reserved before the given time and returns how many it deleted.
+	Purge(ctx context.Context, before time.Time, limit int) (int64, error)
+}
@@ -0,0 +1,190 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+type JudgeOutcome string
+
+const (
+	JudgeOutcomeContinue JudgeOutcome = "continue"
+	JudgeOutcomeWon      JudgeOutcome = "won"
+	JudgeOutcomeLost     JudgeOutcome = "lost"
+)
+
+type TargetMode string
+
+const (
+	TargetModeAny     TargetMode = "any"     // any one of the words wins
+	TargetModeAll     TargetMode = "all"     // every word, in any order and over any number of turns
+	TargetModeOrdered TargetMode = "ordered" // every word, in the listed order
+)
+
+// TargetWordCondition is the judge condition of a target word game with several words.
+// It is written as JSON in the game's JudgeCondition, e.g. {"words": ["alpha", "bravo"], "mode": "all"};
+// a plain condition is a single target word.
+type TargetWordCondition struct {
+	Words []string   `json:"words"`
+	Mode  TargetMode `json:"mode"`
+}
+
+type RegexMode string
+
+const (
+	RegexModeMatch   RegexMode = "match"    // a reply matching the pattern wins
+	RegexModeNoMatch RegexMode = "no_match" // a reply failing to match the pattern wins
+)
+
+// RegexCondition is the judge condition of a regex game.
+// It is written as JSON in the game's JudgeCondition, e.g. {"pattern": "^[A-Z ]+$", "mode": "no_match"};
+// a plain condition is a pattern the reply wins by matching.
+type RegexCondition struct {
+	Pattern string    `json:"pattern"`
+	Mode    RegexMode `json:"mode"`
+}
+
+type JudgeOp string
+
+const (
+	JudgeOpAnd JudgeOp = "and"
+	JudgeOpOr  JudgeOp = "or"
+	JudgeOpNot JudgeOp = "not"
+)
+
+// CompositeCondition is the judge condition of a composite game, a boolean expression over the other judges.
+// It is written as JSON in the game's JudgeCondition, e.g.
+// {"op": "and", "args": [{"type": "target_word", "condition": "apple"}, {"type": "json_schema", "condition": {"type": "object"}}]}.
+// A node is either an operator over its args or a leaf judge with its condition;
+// a leaf condition given as JSON other than a string is handed to the judge as JSON text.
+type CompositeCondition struct {
+	Op        JudgeOp              `json:"op,omitempty"`
+	Args      []CompositeCondition `json:"args,omitempty"`
+	Type      JudgeType            `json:"type,omitempty"`
+	Condition json.RawMessage      `json:"condition,omitempty"`
+}
+
+// JudgeProgress is how far a match has come towards a judge condition with several goals.
+// It is carried from turn to turn on the match and shown to the player.
+type JudgeProgress struct {
+	Achieved []string `json:"achieved"` // goals met so far, in the order they were met
+	Total    int      `json:"total"`
+}
+
+// JudgeVerdict is the structured result of judging a single turn.
+type JudgeVerdict struct {
+	Outcome          JudgeOutcome   `json:"outcome"`
+	Reason           string         `json:"reason"`
+	Output           string         `json:"output,omitempty"`   // raw output of the judge model, if one was asked
+	Votes            []JudgeVote    `json:"votes,omitempty"`    // rulings of the consensus panel, if the game has one
+	Match            *JudgeMatch    `json:"match,omitempty"`    // where the target was found, for judges that search the reply
+	Progress         *JudgeProgress `json:"progress,omitempty"` // progress of the match after this turn, for conditions with several goals
+	Leaves           []JudgeLeaf    `json:"leaves,omitempty"`   // verdicts of the leaf judges, if the condition is composite
+	PromptTokens     int            `json:"prompt_tokens"`
+	CompletionTokens int            `json:"completion_tokens"`
+}
+
+// JudgeVote is the ruling of one judge model of a consensus panel.
+type JudgeVote struct {
+	Model            string `json:"model"`
+	Result           bool   `json:"result"`
+	Reason           string `json:"reason"`
+	Failed           bool   `json:"failed,omitempty"` // the model could not rule; counts as a vote against
+	PromptTokens     int    `json:"prompt_tokens"`
+	CompletionTokens int    `json:"completion_tokens"`
+}
+
+// JudgeLeaf is the verdict of one leaf judge of a composite condition.
+type JudgeLeaf struct {
+	Leaf             int          `json:"leaf"` // position of the leaf among the leaves of the condition, as written
+	JudgeType        JudgeType    `json:"judge_type"`
+	Outcome          JudgeOutcome `json:"outcome"`
+	Reason           string       `json:"reason"`
+	Output           string       `json:"output,omitempty"`
+	Votes            []JudgeVote  `json:"votes,omitempty"`
+	Match            *JudgeMatch  `json:"match,omitempty"`
+	Failed           bool         `json:"failed,omitempty"` // the judge could not rule; the expression is decided without it if it can be
+	PromptTokens     int          `json:"prompt_tokens"`
+	CompletionTokens int          `json:"completion_tokens"`
+}
+
+// JudgeMatch is where a judge found its target in the AI reply.
+type JudgeMatch struct {
+	Text            string   `json:"text"`  // matched span of the reply as it was written
+	Start           int      `json:"start"` // byte offsets of the span in the reply
+	End             int      `json:"end"`
+	Transformations []string `json:"transformations,omitempty"` // what had to be undone to reveal the target, e.g. "base64"
+}
+
+// JudgePanelist is a judge model sitting on the consensus panel of a game.
+type JudgePanelist struct {
+	Model string
+	LLM   LLMService
+}
+
+// JudgeInput is what a judge sees of a turn.
+type JudgeInput struct {
+	Condition string     // judge condition configured on the game
+	Reply     *Message   // AI reply of the turn being judged
+	History   []Message  // conversation of the match including the reply, without the system prompt
+	LLM       LLMService // judge model of the game, for judges that ask an LLM
+
+	// Panel replaces LLM with a vote of several judge models when the game has a consensus panel.
+	Panel  []JudgePanelist
+	Policy JudgePolicy
+	Quorum int
+
+	Strictness JudgeStrictness // how hard the target word judge looks for the word
+	Scope      JudgeScope      // how much of History the LLM judge rules on
+	ScopeTurns int             // the N of the last N turns scope
+	Progress   *JudgeProgress  // progress of the match before this turn, if the condition has several goals
+}
+
+// TurnVerdict is the recorded verdict of a turn, saved against the AI reply that was judged.
+// Players see the verdicts of their match once it is over; admins can inspect them at any time.
+type TurnVerdict struct {
+	ID               string         `json:"id"`
+	MatchID          string         `json:"match_id"`
+	MessageID        string         `json:"message_id"` // AI reply that was judged
+	TurnCount        int            `json:"turn_count"`
+	JudgeType        JudgeType      `json:"judge_type"`
+	Outcome          JudgeOutcome   `json:"outcome"`
+	Reason           string         `json:"reason"`
+	Output           string         `json:"output,omitempty"`
+	Votes            []JudgeVote    `json:"votes,omitempty"`
+	Match            *JudgeMatch    `json:"match,omitempty"`
+	Leaves           []JudgeLeaf    `json:"leaves,omitempty"`
+	Progress         *JudgeProgress `json:"progress,omitempty"` // progress of the match after this turn, for conditions with several goals
+	Error            string         `json:"-"`                  // why the judge failed, if it did; not shown to players
+	PromptTokens     int            `json:"prompt_tokens"`
+	CompletionTokens int            `json:"completion_tokens"`
+	CreatedAt        time.Time      `json:"created_at"`
+}
+
+// Judge decides the outcome of a turn for one JudgeType.
+type Judge interface {
+	// Validate checks the judge condition a game is configured with.
+	Validate(condition string) error
+
+	// Evaluate judges the AI reply of a turn.
+	Evaluate(ctx context.Context, input JudgeInput) (*JudgeVerdict, error)
+}
+
+// JudgeRegistry resolves the judge of each JudgeType.
+type JudgeRegistry interface {
+	// Get returns the judge registered for the type, or ErrInvalidInput if there is none.
+	Get(judgeType JudgeType) (Judge, error)
+
+	// Validate checks that the type is registered and the condition is valid for its judge.
+	Validate(judgeType JudgeType, condition string) error
+
+	// Types returns the registered judge types, sorted.
+	Types() []JudgeType
+}
+
+// TurnVerdictRepository defines the interface for turn verdict data access
+type TurnVerdictRepository interface {
+	Create(ctx context.Context, verdict *TurnVerdict) (*TurnVerdict, error)
+	GetByMatchID(ctx context.Context, matchID string) ([]TurnVerdict, error)
+}
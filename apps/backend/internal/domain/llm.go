@@ -1,20 +1,86 @@
 package domain
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // LLMService defines the interface for communicating with external AI models.
 type LLMService interface {
-	// GenerateResponse takes the conversation history and returns the AI's generated text, prompt tokens, and completion tokens.
-	GenerateResponse(ctx context.Context, history []Message) (string, int, int, error)
+	// GenerateResponse takes the conversation history and returns the AI's generated reply with its token usage.
+	GenerateResponse(ctx context.Context, history []Message) (*LLMResponse, error)
+
+	// StreamResponse behaves like GenerateResponse but calls onDelta with each chunk of the reply as it arrives.
+	// The full reply is returned once the stream completes. If onDelta returns an error the stream is aborted.
+	StreamResponse(ctx context.Context, history []Message, onDelta func(delta string) error) (*LLMResponse, error)
 
 	// EvaluateWinCondition asks the LLM to judge if the user has met the win condition based on the conversation history.
-	// It returns true if the condition is met, false otherwise, along with token usage and any error.
-	EvaluateWinCondition(ctx context.Context, judgeCondition string, history []Message) (bool, int, int, error)
+	// The judgement's Result is true if the condition is met.
+	EvaluateWinCondition(ctx context.Context, judgeCondition string, history []Message) (*LLMJudgement, error)
 
 	// EvaluateFormatBreak asks the LLM to judge if the AI has failed to follow the format rules.
-	// It returns true if the format is broken (user wins), false otherwise, along with token usage and any error.
-	EvaluateFormatBreak(ctx context.Context, condition string, aiContent string) (bool, error)
+	// The judgement's Result is true if the format is broken (user wins).
+	EvaluateFormatBreak(ctx context.Context, condition string, aiContent string) (*LLMJudgement, error)
 
 	// EvaluatePromptAdvice asks the LLM to analyze the user's prompt and provide helpful advice.
 	EvaluatePromptAdvice(ctx context.Context, gameRule string, userContent string, aiContent string) (string, error)
 }
+
+// LLMResponse is a reply generated by an LLM along with the provider and model that served it.
+type LLMResponse struct {
+	Content          string
+	PromptTokens     int
+	CompletionTokens int
+	Provider         string // name of the configured provider, e.g. "openai"
+	Model            string // model name sent to the provider, e.g. "gpt-4o"
+}
+
+// LLMJudgement is the ruling of a judge model with the reasoning it gave and its token usage.
+type LLMJudgement struct {
+	Result           bool
+	Reason           string // reasoning of the model, empty if it gave none
+	Output           string // raw output of the model
+	PromptTokens     int
+	CompletionTokens int
+}
+
+// LLMParams overrides the default sampling parameters of a configured model.
+// A nil Temperature and a zero MaxTokens keep the model's defaults.
+type LLMParams struct {
+	Temperature *float64
+	MaxTokens   int
+}
+
+// LLMRegistry resolves the LLM services configured for the platform by model name.
+type LLMRegistry interface {
+	// Chat returns the service that plays the game's AI using the named model and params.
+	// An empty name resolves to the default chat model.
+	// Returns ErrLLMUnavailable while the circuit breaker of the model's provider is open.
+	Chat(name string, params LLMParams) (LLMService, error)
+
+	// Judge returns the service that judges turns and gives advice using the named model.
+	// An empty name resolves to the default judge model.
+	// Returns ErrLLMUnavailable while the circuit breaker of the model's provider is open.
+	Judge(name string) (LLMService, error)
+
+	// Models returns the names of all configured models, sorted.
+	Models() []string
+
+	// Health reports the circuit breaker state of every configured provider, sorted by name.
+	Health() []LLMProviderHealth
+}
+
+// Circuit breaker states of an LLM provider.
+const (
+	LLMCircuitClosed   = "closed"
+	LLMCircuitOpen     = "open"
+	LLMCircuitHalfOpen = "half_open"
+)
+
+// LLMProviderHealth is the circuit breaker state of a single LLM provider.
+type LLMProviderHealth struct {
+	Provider            string     `json:"provider"`
+	State               string     `json:"state"`
+	ConsecutiveFailures int        `json:"consecutive_failures"`
+	OpenedAt            *time.Time `json:"opened_at,omitempty"`
+}
@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type LLMCallPurpose string
+
+const (
+	LLMCallPurposeChat   LLMCallPurpose = "chat"
+	LLMCallPurposeJudge  LLMCallPurpose = "judge"
+	LLMCallPurposeAdvice LLMCallPurpose = "advice"
+)
+
+// LLMCallMessage is a single message of the prompt sent to an LLM provider.
+type LLMCallMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+// LLMCall is the audit record of a single request to an LLM provider.
+// Every attempt is recorded, including retries and calls that failed.
+type LLMCall struct {
+	ID               string           `json:"id"`
+	MatchID          string           `json:"match_id,omitempty"`
+	MessageID        string           `json:"message_id,omitempty"` // user message of the turn that made the call
+	Purpose          LLMCallPurpose   `json:"purpose"`
+	Provider         string           `json:"provider"`
+	Model            string           `json:"model"`
+	Request          []LLMCallMessage `json:"request"`
+	Response         string           `json:"response"`
+	LatencyMs        int64            `json:"latency_ms"`
+	PromptTokens     int              `json:"prompt_tokens"`
+	CompletionTokens int              `json:"completion_tokens"`
+	CostUSD          float64          `json:"cost_usd"` // priced from the model's per-token prices
+	Error            string           `json:"error,omitempty"`
+	CreatedAt        time.Time        `json:"created_at"`
+}
+
+// SpendSummary is the aggregated LLM usage and cost of a user, a game or a day.
+type SpendSummary struct {
+	Key              string  `json:"key"`   // user ID, game ID or date (YYYY-MM-DD)
+	Label            string  `json:"label"` // user name, game title or date
+	Calls            int     `json:"calls"`
+	PromptTokens     int64   `json:"prompt_tokens"`
+	CompletionTokens int64   `json:"completion_tokens"`
+	CostUSD          float64 `json:"cost_usd"`
+}
+
+// SpendReport is the LLM spend of the platform between From (inclusive) and To (exclusive).
+type SpendReport struct {
+	From   time.Time      `json:"from"`
+	To     time.Time      `json:"to"`
+	Total  SpendSummary   `json:"total"`
+	ByDay  []SpendSummary `json:"by_day"`
+	ByUser []SpendSummary `json:"by_user"`
+	ByGame []SpendSummary `json:"by_game"`
+}
+
+// LLMCallRepository defines the interface for LLM call audit data access
+type LLMCallRepository interface {
+	// Create records the call and adds its cost to the match it belongs to.
+	Create(ctx context.Context, call *LLMCall) (*LLMCall, error)
+	GetByMatchID(ctx context.Context, matchID string) ([]LLMCall, error)
+	// GetSpendByDay, GetSpendByUser and GetSpendByGame aggregate the calls made in [from, to).
+	// Users and games are ordered by cost, highest first.
+	GetSpendByDay(ctx context.Context, from, to time.Time) ([]SpendSummary, error)
+	GetSpendByUser(ctx context.Context, from, to time.Time, limit int) ([]SpendSummary, error)
+	GetSpendByGame(ctx context.Context, from, to time.Time, limit int) ([]SpendSummary, error)
+}
+
+// LLMCallUseCase defines the interface for inspecting the LLM call audit log
+type LLMCallUseCase interface {
+	GetByMatchID(ctx context.Context, matchID string) ([]LLMCall, error)
+	// GetSpendReport returns the spend of the given number of days, ending with today.
+	GetSpendReport(ctx context.Context, days int) (*SpendReport, error)
+}
@@ -19,15 +19,28 @@ const (
 
 // Match represents an individual play record of a game
 type Match struct {
-	ID          string      `json:"id"`
-	UserID      string      `json:"user_id"`
-	GameID      string      `json:"game_id"`
-	Status      MatchStatus `json:"status"`
-	MaxTurns    int         `json:"max_turns"`
-	TotalTokens int         `json:"total_tokens"`
-	TurnCount   int         `json:"turn_count"`
-	CreatedAt   time.Time   `json:"created_at"`
-	UpdatedAt   time.Time   `json:"updated_at"`
+	ID            string            `json:"id"`
+	UserID        string            `json:"user_id"`
+	GameID        string            `json:"game_id"`
+	Status        MatchStatus       `json:"status"`
+	MaxTurns      int               `json:"max_turns"`
+	TotalTokens   int               `json:"total_tokens"`
+	TurnCount     int               `json:"turn_count"`
+	CostUSD       float64           `json:"-"`                        // LLM spend of every call made for the match, not shown to players
+	JudgeProgress *JudgeProgress    `json:"judge_progress,omitempty"` // progress towards a judge condition with several goals
+	Secrets       map[string]string `json:"-"`                        // values drawn from the game's secret generators, never shown to players
+	Version       int               `json:"-"`                        // bumped by every state change, see MatchRepository.CompareAndSet
+	CreatedAt     time.Time         `json:"created_at"`
+	UpdatedAt     time.Time         `json:"updated_at"`
+}
+
+// IsOver reports whether the match has ended and can no longer be played
+func (m *Match) IsOver() bool {
+	switch m.Status {
+	case MatchStatusWon, MatchStatusLost, MatchStatusResigned, MatchStatusExpired:
+		return true
+	}
+	return false
 }
 
 // CreateMatchRequest is the DTO for creating a new match
@@ -43,8 +56,17 @@ type MatchRepository interface {
 	GetByUserID(ctx context.Context, userID string) ([]Match, error)
 	GetByUserIDAndGameID(ctx context.Context, userID string, gameID string) ([]Match, error)
 	CountByUserIDGameIDAndStatus(ctx context.Context, userID string, gameID string, status MatchStatus) (int, error)
-	Update(ctx context.Context, match *Match) (*Match, error)
+	// CompareAndSet saves the state of match (status, turns, tokens and judge progress) only while the
+	// stored match is still in status expected at match.Version, then bumps match.Version.
+	// It fails with ErrConflict when another request changed the match first; every status transition goes through it.
+	CompareAndSet(ctx context.Context, match *Match, expected MatchStatus) (*Match, error)
 	Delete(ctx context.Context, id string) error
+	// ExpireIdle moves up to limit active matches left untouched past their game's idle TTL
+	// (defaultTTL for games without one) to expired, returning the expired matches.
+	ExpireIdle(ctx context.Context, defaultTTL time.Duration, limit int) ([]Match, error)
+	// RecoverStuck moves up to limit matches generating for longer than timeout out of generating:
+	// to error when the user message of the turn was saved, else back to active. It returns the recovered matches.
+	RecoverStuck(ctx context.Context, timeout time.Duration, limit int) ([]Match, error)
 }
 
 // MatchUseCase defines the interface for match business logic
@@ -55,4 +77,17 @@ type MatchUseCase interface {
 	GetByUserIDAndGameID(ctx context.Context, userID string, gameID string) ([]Match, error)
 	Resign(ctx context.Context, id string, userID string) error
 	Delete(ctx context.Context, id string) error
+	// GetVerdicts returns the judge verdict of every turn of the user's match once the match is over.
+	GetVerdicts(ctx context.Context, id string, userID string) ([]TurnVerdict, error)
+	// GetVerdictsByMatchID returns the verdicts of any match at any time, for admins.
+	GetVerdictsByMatchID(ctx context.Context, matchID string) ([]TurnVerdict, error)
+}
+
+// SweeperUseCase cleans up matches nobody will finish: idle matches are expired and
+// matches left generating by a crashed turn are made playable or retryable again.
+// It also purges the idempotency keys of turns past their retention.
+type SweeperUseCase interface {
+	ExpireIdle(ctx context.Context) ([]Match, error)
+	RecoverStuck(ctx context.Context) ([]Match, error)
+	PurgeIdempotencyKeys(ctx context.Context) (int64, error)
 }
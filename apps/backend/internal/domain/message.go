@@ -15,20 +15,38 @@ const (
 
 // Message represents a single conversation turn within a Match
 type Message struct {
-	ID         string      `json:"id"`
-	MatchID    string      `json:"match_id"`
-	Role       MessageRole `json:"role"`
-	Content    string      `json:"content"`
-	IsVisible  bool        `json:"is_visible"`
-	TurnCount  int         `json:"turn_count"`
+	ID           string      `json:"id"`
+	MatchID      string      `json:"match_id"`
+	Role         MessageRole `json:"role"`
+	Content      string      `json:"content"`
+	IsVisible    bool        `json:"is_visible"`
+	TurnCount    int         `json:"turn_count"`
 	TokenCount   int         `json:"token_count"`
 	PromptAdvice *string     `json:"prompt_advice,omitempty"`
-	CreatedAt    time.Time   `json:"created_at"`
+	// Provider and Model record which LLM served an assistant reply, for auditing fallbacks.
+	Provider  string    `json:"provider,omitempty"`
+	Model     string    `json:"model,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
 }
 
 // CreateMessageRequest is the DTO for creating a new message
 type CreateMessageRequest struct {
 	Content string `json:"content"`
+	// IdempotencyKey comes from the Idempotency-Key header; a retried request with the same key
+	// is answered with the result of the first one instead of playing the turn again.
+	IdempotencyKey string `json:"-"`
+}
+
+// TurnResult is the outcome of a single game turn: the saved AI reply,
+// the match status decided by the judge and the advice for the player's prompt.
+// Verdict explains the judge's decision and is only set once the turn ends the match.
+// JudgeProgress is the partial progress of the match when the judge condition has several goals.
+type TurnResult struct {
+	Message       *Message       `json:"message"`
+	MatchStatus   MatchStatus    `json:"match_status"`
+	PromptAdvice  *string        `json:"prompt_advice,omitempty"`
+	Verdict       *TurnVerdict   `json:"verdict,omitempty"`
+	JudgeProgress *JudgeProgress `json:"judge_progress,omitempty"`
 }
 
 // MessageRepository defines the interface for message data access
@@ -43,6 +61,10 @@ type MessageRepository interface {
 // MessageUseCase defines the interface for message business logic
 type MessageUseCase interface {
 	Create(ctx context.Context, matchID string, userID string, req *CreateMessageRequest) (*Message, error)
+	// CreateStream plays a turn like Create but streams the AI reply through onDelta as it is generated.
+	CreateStream(ctx context.Context, matchID string, userID string, req *CreateMessageRequest, onDelta func(delta string) error) (*TurnResult, error)
+	// Retry regenerates and judges the reply to the last user message of a match in error status.
+	Retry(ctx context.Context, matchID string, userID string) (*TurnResult, error)
 	GetByID(ctx context.Context, id string) (*Message, error)
 	GetByMatchID(ctx context.Context, matchID string, userID string) ([]Message, error)
 	Delete(ctx context.Context, id string) error
@@ -0,0 +1,332 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/everyday-studio/ollm/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AppealRepository is an autogenerated mock type for the AppealRepository type
+type AppealRepository struct {
+	mock.Mock
+}
+
+type AppealRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AppealRepository) EXPECT() *AppealRepository_Expecter {
+	return &AppealRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, appeal
+func (_m *AppealRepository) Create(ctx context.Context, appeal *domain.Appeal) (*domain.Appeal, error) {
+	ret := _m.Called(ctx, appeal)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *domain.Appeal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Appeal) (*domain.Appeal, error)); ok {
+		return rf(ctx, appeal)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Appeal) *domain.Appeal); ok {
+		r0 = rf(ctx, appeal)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Appeal)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.Appeal) error); ok {
+		r1 = rf(ctx, appeal)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AppealRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type AppealRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - appeal *domain.Appeal
+func (_e *AppealRepository_Expecter) Create(ctx interface{}, appeal interface{}) *AppealRepository_Create_Call {
+	return &AppealRepository_Create_Call{Call: _e.mock.On("Create", ctx, appeal)}
+}
+
+func (_c *AppealRepository_Create_Call) Run(run func(ctx context.Context, appeal *domain.Appeal)) *AppealRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.Appeal))
+	})
+	return _c
+}
+
+func (_c *AppealRepository_Create_Call) Return(_a0 *domain.Appeal, _a1 error) *AppealRepository_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AppealRepository_Create_Call) RunAndReturn(run func(context.Context, *domain.Appeal) (*domain.Appeal, error)) *AppealRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *AppealRepository) GetByID(ctx context.Context, id string) (*domain.Appeal, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *domain.Appeal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*domain.Appeal, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.Appeal); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Appeal)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AppealRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type AppealRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *AppealRepository_Expecter) GetByID(ctx interface{}, id interface{}) *AppealRepository_GetByID_Call {
+	return &AppealRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *AppealRepository_GetByID_Call) Run(run func(ctx context.Context, id string)) *AppealRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *AppealRepository_GetByID_Call) Return(_a0 *domain.Appeal, _a1 error) *AppealRepository_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AppealRepository_GetByID_Call) RunAndReturn(run func(context.Context, string) (*domain.Appeal, error)) *AppealRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByMatchID provides a mock function with given fields: ctx, matchID
+func (_m *AppealRepository) GetByMatchID(ctx context.Context, matchID string) ([]domain.Appeal, error) {
+	ret := _m.Called(ctx, matchID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByMatchID")
+	}
+
+	var r0 []domain.Appeal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]domain.Appeal, error)); ok {
+		return rf(ctx, matchID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []domain.Appeal); ok {
+		r0 = rf(ctx, matchID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Appeal)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, matchID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AppealRepository_GetByMatchID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByMatchID'
+type AppealRepository_GetByMatchID_Call struct {
+	*mock.Call
+}
+
+// GetByMatchID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - matchID string
+func (_e *AppealRepository_Expecter) GetByMatchID(ctx interface{}, matchID interface{}) *AppealRepository_GetByMatchID_Call {
+	return &AppealRepository_GetByMatchID_Call{Call: _e.mock.On("GetByMatchID", ctx, matchID)}
+}
+
+func (_c *AppealRepository_GetByMatchID_Call) Run(run func(ctx context.Context, matchID string)) *AppealRepository_GetByMatchID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *AppealRepository_GetByMatchID_Call) Return(_a0 []domain.Appeal, _a1 error) *AppealRepository_GetByMatchID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AppealRepository_GetByMatchID_Call) RunAndReturn(run func(context.Context, string) ([]domain.Appeal, error)) *AppealRepository_GetByMatchID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPending provides a mock function with given fields: ctx, limit
+func (_m *AppealRepository) GetPending(ctx context.Context, limit int) ([]domain.Appeal, error) {
+	ret := _m.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPending")
+	}
+
+	var r0 []domain.Appeal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]domain.Appeal, error)); ok {
+		return rf(ctx, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []domain.Appeal); ok {
+		r0 = rf(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Appeal)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AppealRepository_GetPending_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPending'
+type AppealRepository_GetPending_Call struct {
+	*mock.Call
+}
+
+// GetPending is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+func (_e *AppealRepository_Expecter) GetPending(ctx interface{}, limit interface{}) *AppealRepository_GetPending_Call {
+	return &AppealRepository_GetPending_Call{Call: _e.mock.On("GetPending", ctx, limit)}
+}
+
+func (_c *AppealRepository_GetPending_Call) Run(run func(ctx context.Context, limit int)) *AppealRepository_GetPending_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *AppealRepository_GetPending_Call) Return(_a0 []domain.Appeal, _a1 error) *AppealRepository_GetPending_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AppealRepository_GetPending_Call) RunAndReturn(run func(context.Context, int) ([]domain.Appeal, error)) *AppealRepository_GetPending_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Resolve provides a mock function with given fields: ctx, appeal
+func (_m *AppealRepository) Resolve(ctx context.Context, appeal *domain.Appeal) (*domain.Appeal, error) {
+	ret := _m.Called(ctx, appeal)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Resolve")
+	}
+
+	var r0 *domain.Appeal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Appeal) (*domain.Appeal, error)); ok {
+		return rf(ctx, appeal)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Appeal) *domain.Appeal); ok {
+		r0 = rf(ctx, appeal)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Appeal)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.Appeal) error); ok {
+		r1 = rf(ctx, appeal)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AppealRepository_Resolve_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Resolve'
+type AppealRepository_Resolve_Call struct {
+	*mock.Call
+}
+
+// Resolve is a helper method to define mock.On call
+//   - ctx context.Context
+//   - appeal *domain.Appeal
+func (_e *AppealRepository_Expecter) Resolve(ctx interface{}, appeal interface{}) *AppealRepository_Resolve_Call {
+	return &AppealRepository_Resolve_Call{Call: _e.mock.On("Resolve", ctx, appeal)}
+}
+
+func (_c *AppealRepository_Resolve_Call) Run(run func(ctx context.Context, appeal *domain.Appeal)) *AppealRepository_Resolve_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.Appeal))
+	})
+	return _c
+}
+
+func (_c *AppealRepository_Resolve_Call) Return(_a0 *domain.Appeal, _a1 error) *AppealRepository_Resolve_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AppealRepository_Resolve_Call) RunAndReturn(run func(context.Context, *domain.Appeal) (*domain.Appeal, error)) *AppealRepository_Resolve_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAppealRepository creates a new instance of AppealRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAppealRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AppealRepository {
+	mock := &AppealRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,458 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/everyday-studio/ollm/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AppealUseCase is an autogenerated mock type for the AppealUseCase type
+type AppealUseCase struct {
+	mock.Mock
+}
+
+type AppealUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AppealUseCase) EXPECT() *AppealUseCase_Expecter {
+	return &AppealUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, matchID, userID, req
+func (_m *AppealUseCase) Create(ctx context.Context, matchID string, userID string, req *domain.CreateAppealRequest) (*domain.Appeal, error) {
+	ret := _m.Called(ctx, matchID, userID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *domain.Appeal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *domain.CreateAppealRequest) (*domain.Appeal, error)); ok {
+		return rf(ctx, matchID, userID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *domain.CreateAppealRequest) *domain.Appeal); ok {
+		r0 = rf(ctx, matchID, userID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Appeal)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *domain.CreateAppealRequest) error); ok {
+		r1 = rf(ctx, matchID, userID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AppealUseCase_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type AppealUseCase_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - matchID string
+//   - userID string
+//   - req *domain.CreateAppealRequest
+func (_e *AppealUseCase_Expecter) Create(ctx interface{}, matchID interface{}, userID interface{}, req interface{}) *AppealUseCase_Create_Call {
+	return &AppealUseCase_Create_Call{Call: _e.mock.On("Create", ctx, matchID, userID, req)}
+}
+
+func (_c *AppealUseCase_Create_Call) Run(run func(ctx context.Context, matchID string, userID string, req *domain.CreateAppealRequest)) *AppealUseCase_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(*domain.CreateAppealRequest))
+	})
+	return _c
+}
+
+func (_c *AppealUseCase_Create_Call) Return(_a0 *domain.Appeal, _a1 error) *AppealUseCase_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AppealUseCase_Create_Call) RunAndReturn(run func(context.Context, string, string, *domain.CreateAppealRequest) (*domain.Appeal, error)) *AppealUseCase_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByMatchID provides a mock function with given fields: ctx, matchID, userID
+func (_m *AppealUseCase) GetByMatchID(ctx context.Context, matchID string, userID string) ([]domain.Appeal, error) {
+	ret := _m.Called(ctx, matchID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByMatchID")
+	}
+
+	var r0 []domain.Appeal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]domain.Appeal, error)); ok {
+		return rf(ctx, matchID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []domain.Appeal); ok {
+		r0 = rf(ctx, matchID, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Appeal)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, matchID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AppealUseCase_GetByMatchID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByMatchID'
+type AppealUseCase_GetByMatchID_Call struct {
+	*mock.Call
+}
+
+// GetByMatchID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - matchID string
+//   - userID string
+func (_e *AppealUseCase_Expecter) GetByMatchID(ctx interface{}, matchID interface{}, userID interface{}) *AppealUseCase_GetByMatchID_Call {
+	return &AppealUseCase_GetByMatchID_Call{Call: _e.mock.On("GetByMatchID", ctx, matchID, userID)}
+}
+
+func (_c *AppealUseCase_GetByMatchID_Call) Run(run func(ctx context.Context, matchID string, userID string)) *AppealUseCase_GetByMatchID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *AppealUseCase_GetByMatchID_Call) Return(_a0 []domain.Appeal, _a1 error) *AppealUseCase_GetByMatchID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AppealUseCase_GetByMatchID_Call) RunAndReturn(run func(context.Context, string, string) ([]domain.Appeal, error)) *AppealUseCase_GetByMatchID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPending provides a mock function with given fields: ctx
+func (_m *AppealUseCase) GetPending(ctx context.Context) ([]domain.Appeal, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPending")
+	}
+
+	var r0 []domain.Appeal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]domain.Appeal, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []domain.Appeal); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Appeal)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AppealUseCase_GetPending_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPending'
+type AppealUseCase_GetPending_Call struct {
+	*mock.Call
+}
+
+// GetPending is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *AppealUseCase_Expecter) GetPending(ctx interface{}) *AppealUseCase_GetPending_Call {
+	return &AppealUseCase_GetPending_Call{Call: _e.mock.On("GetPending", ctx)}
+}
+
+func (_c *AppealUseCase_GetPending_Call) Run(run func(ctx context.Context)) *AppealUseCase_GetPending_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *AppealUseCase_GetPending_Call) Return(_a0 []domain.Appeal, _a1 error) *AppealUseCase_GetPending_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AppealUseCase_GetPending_Call) RunAndReturn(run func(context.Context) ([]domain.Appeal, error)) *AppealUseCase_GetPending_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReview provides a mock function with given fields: ctx, id
+func (_m *AppealUseCase) GetReview(ctx context.Context, id string) (*domain.AppealReview, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReview")
+	}
+
+	var r0 *domain.AppealReview
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*domain.AppealReview, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.AppealReview); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.AppealReview)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AppealUseCase_GetReview_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReview'
+type AppealUseCase_GetReview_Call struct {
+	*mock.Call
+}
+
+// GetReview is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *AppealUseCase_Expecter) GetReview(ctx interface{}, id interface{}) *AppealUseCase_GetReview_Call {
+	return &AppealUseCase_GetReview_Call{Call: _e.mock.On("GetReview", ctx, id)}
+}
+
+func (_c *AppealUseCase_GetReview_Call) Run(run func(ctx context.Context, id string)) *AppealUseCase_GetReview_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *AppealUseCase_GetReview_Call) Return(_a0 *domain.AppealReview, _a1 error) *AppealUseCase_GetReview_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AppealUseCase_GetReview_Call) RunAndReturn(run func(context.Context, string) (*domain.AppealReview, error)) *AppealUseCase_GetReview_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Rejudge provides a mock function with given fields: ctx, id, adminID, req
+func (_m *AppealUseCase) Rejudge(ctx context.Context, id string, adminID string, req *domain.ResolveAppealRequest) (*domain.MatchOverride, error) {
+	ret := _m.Called(ctx, id, adminID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Rejudge")
+	}
+
+	var r0 *domain.MatchOverride
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *domain.ResolveAppealRequest) (*domain.MatchOverride, error)); ok {
+		return rf(ctx, id, adminID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *domain.ResolveAppealRequest) *domain.MatchOverride); ok {
+		r0 = rf(ctx, id, adminID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.MatchOverride)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *domain.ResolveAppealRequest) error); ok {
+		r1 = rf(ctx, id, adminID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AppealUseCase_Rejudge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rejudge'
+type AppealUseCase_Rejudge_Call struct {
+	*mock.Call
+}
+
+// Rejudge is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - adminID string
+//   - req *domain.ResolveAppealRequest
+func (_e *AppealUseCase_Expecter) Rejudge(ctx interface{}, id interface{}, adminID interface{}, req interface{}) *AppealUseCase_Rejudge_Call {
+	return &AppealUseCase_Rejudge_Call{Call: _e.mock.On("Rejudge", ctx, id, adminID, req)}
+}
+
+func (_c *AppealUseCase_Rejudge_Call) Run(run func(ctx context.Context, id string, adminID string, req *domain.ResolveAppealRequest)) *AppealUseCase_Rejudge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(*domain.ResolveAppealRequest))
+	})
+	return _c
+}
+
+func (_c *AppealUseCase_Rejudge_Call) Return(_a0 *domain.MatchOverride, _a1 error) *AppealUseCase_Rejudge_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AppealUseCase_Rejudge_Call) RunAndReturn(run func(context.Context, string, string, *domain.ResolveAppealRequest) (*domain.MatchOverride, error)) *AppealUseCase_Rejudge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetStatus provides a mock function with given fields: ctx, id, adminID, req
+func (_m *AppealUseCase) SetStatus(ctx context.Context, id string, adminID string, req *domain.ResolveAppealRequest) (*domain.MatchOverride, error) {
+	ret := _m.Called(ctx, id, adminID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetStatus")
+	}
+
+	var r0 *domain.MatchOverride
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *domain.ResolveAppealRequest) (*domain.MatchOverride, error)); ok {
+		return rf(ctx, id, adminID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *domain.ResolveAppealRequest) *domain.MatchOverride); ok {
+		r0 = rf(ctx, id, adminID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.MatchOverride)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *domain.ResolveAppealRequest) error); ok {
+		r1 = rf(ctx, id, adminID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AppealUseCase_SetStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetStatus'
+type AppealUseCase_SetStatus_Call struct {
+	*mock.Call
+}
+
+// SetStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - adminID string
+//   - req *domain.ResolveAppealRequest
+func (_e *AppealUseCase_Expecter) SetStatus(ctx interface{}, id interface{}, adminID interface{}, req interface{}) *AppealUseCase_SetStatus_Call {
+	return &AppealUseCase_SetStatus_Call{Call: _e.mock.On("SetStatus", ctx, id, adminID, req)}
+}
+
+func (_c *AppealUseCase_SetStatus_Call) Run(run func(ctx context.Context, id string, adminID string, req *domain.ResolveAppealRequest)) *AppealUseCase_SetStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(*domain.ResolveAppealRequest))
+	})
+	return _c
+}
+
+func (_c *AppealUseCase_SetStatus_Call) Return(_a0 *domain.MatchOverride, _a1 error) *AppealUseCase_SetStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AppealUseCase_SetStatus_Call) RunAndReturn(run func(context.Context, string, string, *domain.ResolveAppealRequest) (*domain.MatchOverride, error)) *AppealUseCase_SetStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Uphold provides a mock function with given fields: ctx, id, adminID, req
+func (_m *AppealUseCase) Uphold(ctx context.Context, id string, adminID string, req *domain.ResolveAppealRequest) (*domain.MatchOverride, error) {
+	ret := _m.Called(ctx, id, adminID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Uphold")
+	}
+
+	var r0 *domain.MatchOverride
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *domain.ResolveAppealRequest) (*domain.MatchOverride, error)); ok {
+		return rf(ctx, id, adminID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *domain.ResolveAppealRequest) *domain.MatchOverride); ok {
+		r0 = rf(ctx, id, adminID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.MatchOverride)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *domain.ResolveAppealRequest) error); ok {
+		r1 = rf(ctx, id, adminID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AppealUseCase_Uphold_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Uphold'
+type AppealUseCase_Uphold_Call struct {
+	*mock.Call
+}
+
+// Uphold is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - adminID string
+//   - req *domain.ResolveAppealRequest
+func (_e *AppealUseCase_Expecter) Uphold(ctx interface{}, id interface{}, adminID interface{}, req interface{}) *AppealUseCase_Uphold_Call {
+	return &AppealUseCase_Uphold_Call{Call: _e.mock.On("Uphold", ctx, id, adminID, req)}
+}
+
+func (_c *AppealUseCase_Uphold_Call) Run(run func(ctx context.Context, id string, adminID string, req *domain.ResolveAppealRequest)) *AppealUseCase_Uphold_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(*domain.ResolveAppealRequest))
+	})
+	return _c
+}
+
+func (_c *AppealUseCase_Uphold_Call) Return(_a0 *domain.MatchOverride, _a1 error) *AppealUseCase_Uphold_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AppealUseCase_Uphold_Call) RunAndReturn(run func(context.Context, string, string, *domain.ResolveAppealRequest) (*domain.MatchOverride, error)) *AppealUseCase_Uphold_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAppealUseCase creates a new instance of AppealUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAppealUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AppealUseCase {
+	mock := &AppealUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,322 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/everyday-studio/ollm/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CalibrationRepository is an autogenerated mock type for the CalibrationRepository type
+type CalibrationRepository struct {
+	mock.Mock
+}
+
+type CalibrationRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *CalibrationRepository) EXPECT() *CalibrationRepository_Expecter {
+	return &CalibrationRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateExample provides a mock function with given fields: ctx, example
+func (_m *CalibrationRepository) CreateExample(ctx context.Context, example *domain.CalibrationExample) (*domain.CalibrationExample, error) {
+	ret := _m.Called(ctx, example)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateExample")
+	}
+
+	var r0 *domain.CalibrationExample
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.CalibrationExample) (*domain.CalibrationExample, error)); ok {
+		return rf(ctx, example)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.CalibrationExample) *domain.CalibrationExample); ok {
+		r0 = rf(ctx, example)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.CalibrationExample)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.CalibrationExample) error); ok {
+		r1 = rf(ctx, example)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CalibrationRepository_CreateExample_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateExample'
+type CalibrationRepository_CreateExample_Call struct {
+	*mock.Call
+}
+
+// CreateExample is a helper method to define mock.On call
+//   - ctx context.Context
+//   - example *domain.CalibrationExample
+func (_e *CalibrationRepository_Expecter) CreateExample(ctx interface{}, example interface{}) *CalibrationRepository_CreateExample_Call {
+	return &CalibrationRepository_CreateExample_Call{Call: _e.mock.On("CreateExample", ctx, example)}
+}
+
+func (_c *CalibrationRepository_CreateExample_Call) Run(run func(ctx context.Context, example *domain.CalibrationExample)) *CalibrationRepository_CreateExample_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.CalibrationExample))
+	})
+	return _c
+}
+
+func (_c *CalibrationRepository_CreateExample_Call) Return(_a0 *domain.CalibrationExample, _a1 error) *CalibrationRepository_CreateExample_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CalibrationRepository_CreateExample_Call) RunAndReturn(run func(context.Context, *domain.CalibrationExample) (*domain.CalibrationExample, error)) *CalibrationRepository_CreateExample_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateRun provides a mock function with given fields: ctx, run
+func (_m *CalibrationRepository) CreateRun(ctx context.Context, run *domain.CalibrationRun) (*domain.CalibrationRun, error) {
+	ret := _m.Called(ctx, run)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRun")
+	}
+
+	var r0 *domain.CalibrationRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.CalibrationRun) (*domain.CalibrationRun, error)); ok {
+		return rf(ctx, run)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.CalibrationRun) *domain.CalibrationRun); ok {
+		r0 = rf(ctx, run)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.CalibrationRun)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.CalibrationRun) error); ok {
+		r1 = rf(ctx, run)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CalibrationRepository_CreateRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRun'
+type CalibrationRepository_CreateRun_Call struct {
+	*mock.Call
+}
+
+// CreateRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - run *domain.CalibrationRun
+func (_e *CalibrationRepository_Expecter) CreateRun(ctx interface{}, run interface{}) *CalibrationRepository_CreateRun_Call {
+	return &CalibrationRepository_CreateRun_Call{Call: _e.mock.On("CreateRun", ctx, run)}
+}
+
+func (_c *CalibrationRepository_CreateRun_Call) Run(run func(ctx context.Context, run *domain.CalibrationRun)) *CalibrationRepository_CreateRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.CalibrationRun))
+	})
+	return _c
+}
+
+func (_c *CalibrationRepository_CreateRun_Call) Return(_a0 *domain.CalibrationRun, _a1 error) *CalibrationRepository_CreateRun_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CalibrationRepository_CreateRun_Call) RunAndReturn(run func(context.Context, *domain.CalibrationRun) (*domain.CalibrationRun, error)) *CalibrationRepository_CreateRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteExample provides a mock function with given fields: ctx, gameID, id
+func (_m *CalibrationRepository) DeleteExample(ctx context.Context, gameID string, id string) error {
+	ret := _m.Called(ctx, gameID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteExample")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, gameID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CalibrationRepository_DeleteExample_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteExample'
+type CalibrationRepository_DeleteExample_Call struct {
+	*mock.Call
+}
+
+// DeleteExample is a helper method to define mock.On call
+//   - ctx context.Context
+//   - gameID string
+//   - id string
+func (_e *CalibrationRepository_Expecter) DeleteExample(ctx interface{}, gameID interface{}, id interface{}) *CalibrationRepository_DeleteExample_Call {
+	return &CalibrationRepository_DeleteExample_Call{Call: _e.mock.On("DeleteExample", ctx, gameID, id)}
+}
+
+func (_c *CalibrationRepository_DeleteExample_Call) Run(run func(ctx context.Context, gameID string, id string)) *CalibrationRepository_DeleteExample_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *CalibrationRepository_DeleteExample_Call) Return(_a0 error) *CalibrationRepository_DeleteExample_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CalibrationRepository_DeleteExample_Call) RunAndReturn(run func(context.Context, string, string) error) *CalibrationRepository_DeleteExample_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetExamplesByGameID provides a mock function with given fields: ctx, gameID
+func (_m *CalibrationRepository) GetExamplesByGameID(ctx context.Context, gameID string) ([]domain.CalibrationExample, error) {
+	ret := _m.Called(ctx, gameID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExamplesByGameID")
+	}
+
+	var r0 []domain.CalibrationExample
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]domain.CalibrationExample, error)); ok {
+		return rf(ctx, gameID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []domain.CalibrationExample); ok {
+		r0 = rf(ctx, gameID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.CalibrationExample)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, gameID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CalibrationRepository_GetExamplesByGameID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExamplesByGameID'
+type CalibrationRepository_GetExamplesByGameID_Call struct {
+	*mock.Call
+}
+
+// GetExamplesByGameID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - gameID string
+func (_e *CalibrationRepository_Expecter) GetExamplesByGameID(ctx interface{}, gameID interface{}) *CalibrationRepository_GetExamplesByGameID_Call {
+	return &CalibrationRepository_GetExamplesByGameID_Call{Call: _e.mock.On("GetExamplesByGameID", ctx, gameID)}
+}
+
+func (_c *CalibrationRepository_GetExamplesByGameID_Call) Run(run func(ctx context.Context, gameID string)) *CalibrationRepository_GetExamplesByGameID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *CalibrationRepository_GetExamplesByGameID_Call) Return(_a0 []domain.CalibrationExample, _a1 error) *CalibrationRepository_GetExamplesByGameID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CalibrationRepository_GetExamplesByGameID_Call) RunAndReturn(run func(context.Context, string) ([]domain.CalibrationExample, error)) *CalibrationRepository_GetExamplesByGameID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRunsByGameID provides a mock function with given fields: ctx, gameID, limit
+func (_m *CalibrationRepository) GetRunsByGameID(ctx context.Context, gameID string, limit int) ([]domain.CalibrationRun, error) {
+	ret := _m.Called(ctx, gameID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRunsByGameID")
+	}
+
+	var r0 []domain.CalibrationRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) ([]domain.CalibrationRun, error)); ok {
+		return rf(ctx, gameID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) []domain.CalibrationRun); ok {
+		r0 = rf(ctx, gameID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.CalibrationRun)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(ctx, gameID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CalibrationRepository_GetRunsByGameID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRunsByGameID'
+type CalibrationRepository_GetRunsByGameID_Call struct {
+	*mock.Call
+}
+
+// GetRunsByGameID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - gameID string
+//   - limit int
+func (_e *CalibrationRepository_Expecter) GetRunsByGameID(ctx interface{}, gameID interface{}, limit interface{}) *CalibrationRepository_GetRunsByGameID_Call {
+	return &CalibrationRepository_GetRunsByGameID_Call{Call: _e.mock.On("GetRunsByGameID", ctx, gameID, limit)}
+}
+
+func (_c *CalibrationRepository_GetRunsByGameID_Call) Run(run func(ctx context.Context, gameID string, limit int)) *CalibrationRepository_GetRunsByGameID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *CalibrationRepository_GetRunsByGameID_Call) Return(_a0 []domain.CalibrationRun, _a1 error) *CalibrationRepository_GetRunsByGameID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CalibrationRepository_GetRunsByGameID_Call) RunAndReturn(run func(context.Context, string, int) ([]domain.CalibrationRun, error)) *CalibrationRepository_GetRunsByGameID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewCalibrationRepository creates a new instance of CalibrationRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCalibrationRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CalibrationRepository {
+	mock := &CalibrationRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,323 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/everyday-studio/ollm/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CalibrationUseCase is an autogenerated mock type for the CalibrationUseCase type
+type CalibrationUseCase struct {
+	mock.Mock
+}
+
+type CalibrationUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *CalibrationUseCase) EXPECT() *CalibrationUseCase_Expecter {
+	return &CalibrationUseCase_Expecter{mock: &_m.Mock}
+}
+
+// CreateExample provides a mock function with given fields: ctx, gameID, req
+func (_m *CalibrationUseCase) CreateExample(ctx context.Context, gameID string, req *domain.CreateCalibrationExampleRequest) (*domain.CalibrationExample, error) {
+	ret := _m.Called(ctx, gameID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateExample")
+	}
+
+	var r0 *domain.CalibrationExample
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *domain.CreateCalibrationExampleRequest) (*domain.CalibrationExample, error)); ok {
+		return rf(ctx, gameID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *domain.CreateCalibrationExampleRequest) *domain.CalibrationExample); ok {
+		r0 = rf(ctx, gameID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.CalibrationExample)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *domain.CreateCalibrationExampleRequest) error); ok {
+		r1 = rf(ctx, gameID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CalibrationUseCase_CreateExample_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateExample'
+type CalibrationUseCase_CreateExample_Call struct {
+	*mock.Call
+}
+
+// CreateExample is a helper method to define mock.On call
+//   - ctx context.Context
+//   - gameID string
+//   - req *domain.CreateCalibrationExampleRequest
+func (_e *CalibrationUseCase_Expecter) CreateExample(ctx interface{}, gameID interface{}, req interface{}) *CalibrationUseCase_CreateExample_Call {
+	return &CalibrationUseCase_CreateExample_Call{Call: _e.mock.On("CreateExample", ctx, gameID, req)}
+}
+
+func (_c *CalibrationUseCase_CreateExample_Call) Run(run func(ctx context.Context, gameID string, req *domain.CreateCalibrationExampleRequest)) *CalibrationUseCase_CreateExample_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*domain.CreateCalibrationExampleRequest))
+	})
+	return _c
+}
+
+func (_c *CalibrationUseCase_CreateExample_Call) Return(_a0 *domain.CalibrationExample, _a1 error) *CalibrationUseCase_CreateExample_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CalibrationUseCase_CreateExample_Call) RunAndReturn(run func(context.Context, string, *domain.CreateCalibrationExampleRequest) (*domain.CalibrationExample, error)) *CalibrationUseCase_CreateExample_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteExample provides a mock function with given fields: ctx, gameID, id
+func (_m *CalibrationUseCase) DeleteExample(ctx context.Context, gameID string, id string) error {
+	ret := _m.Called(ctx, gameID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteExample")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, gameID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CalibrationUseCase_DeleteExample_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteExample'
+type CalibrationUseCase_DeleteExample_Call struct {
+	*mock.Call
+}
+
+// DeleteExample is a helper method to define mock.On call
+//   - ctx context.Context
+//   - gameID string
+//   - id string
+func (_e *CalibrationUseCase_Expecter) DeleteExample(ctx interface{}, gameID interface{}, id interface{}) *CalibrationUseCase_DeleteExample_Call {
+	return &CalibrationUseCase_DeleteExample_Call{Call: _e.mock.On("DeleteExample", ctx, gameID, id)}
+}
+
+func (_c *CalibrationUseCase_DeleteExample_Call) Run(run func(ctx context.Context, gameID string, id string)) *CalibrationUseCase_DeleteExample_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *CalibrationUseCase_DeleteExample_Call) Return(_a0 error) *CalibrationUseCase_DeleteExample_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CalibrationUseCase_DeleteExample_Call) RunAndReturn(run func(context.Context, string, string) error) *CalibrationUseCase_DeleteExample_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetExamples provides a mock function with given fields: ctx, gameID
+func (_m *CalibrationUseCase) GetExamples(ctx context.Context, gameID string) ([]domain.CalibrationExample, error) {
+	ret := _m.Called(ctx, gameID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExamples")
+	}
+
+	var r0 []domain.CalibrationExample
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]domain.CalibrationExample, error)); ok {
+		return rf(ctx, gameID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []domain.CalibrationExample); ok {
+		r0 = rf(ctx, gameID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.CalibrationExample)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, gameID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CalibrationUseCase_GetExamples_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExamples'
+type CalibrationUseCase_GetExamples_Call struct {
+	*mock.Call
+}
+
+// GetExamples is a helper method to define mock.On call
+//   - ctx context.Context
+//   - gameID string
+func (_e *CalibrationUseCase_Expecter) GetExamples(ctx interface{}, gameID interface{}) *CalibrationUseCase_GetExamples_Call {
+	return &CalibrationUseCase_GetExamples_Call{Call: _e.mock.On("GetExamples", ctx, gameID)}
+}
+
+func (_c *CalibrationUseCase_GetExamples_Call) Run(run func(ctx context.Context, gameID string)) *CalibrationUseCase_GetExamples_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *CalibrationUseCase_GetExamples_Call) Return(_a0 []domain.CalibrationExample, _a1 error) *CalibrationUseCase_GetExamples_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CalibrationUseCase_GetExamples_Call) RunAndReturn(run func(context.Context, string) ([]domain.CalibrationExample, error)) *CalibrationUseCase_GetExamples_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRuns provides a mock function with given fields: ctx, gameID
+func (_m *CalibrationUseCase) GetRuns(ctx context.Context, gameID string) ([]domain.CalibrationRun, error) {
+	ret := _m.Called(ctx, gameID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRuns")
+	}
+
+	var r0 []domain.CalibrationRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]domain.CalibrationRun, error)); ok {
+		return rf(ctx, gameID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []domain.CalibrationRun); ok {
+		r0 = rf(ctx, gameID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.CalibrationRun)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, gameID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CalibrationUseCase_GetRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRuns'
+type CalibrationUseCase_GetRuns_Call struct {
+	*mock.Call
+}
+
+// GetRuns is a helper method to define mock.On call
+//   - ctx context.Context
+//   - gameID string
+func (_e *CalibrationUseCase_Expecter) GetRuns(ctx interface{}, gameID interface{}) *CalibrationUseCase_GetRuns_Call {
+	return &CalibrationUseCase_GetRuns_Call{Call: _e.mock.On("GetRuns", ctx, gameID)}
+}
+
+func (_c *CalibrationUseCase_GetRuns_Call) Run(run func(ctx context.Context, gameID string)) *CalibrationUseCase_GetRuns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *CalibrationUseCase_GetRuns_Call) Return(_a0 []domain.CalibrationRun, _a1 error) *CalibrationUseCase_GetRuns_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CalibrationUseCase_GetRuns_Call) RunAndReturn(run func(context.Context, string) ([]domain.CalibrationRun, error)) *CalibrationUseCase_GetRuns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Run provides a mock function with given fields: ctx, gameID, req
+func (_m *CalibrationUseCase) Run(ctx context.Context, gameID string, req *domain.RunCalibrationRequest) (*domain.CalibrationRun, error) {
+	ret := _m.Called(ctx, gameID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Run")
+	}
+
+	var r0 *domain.CalibrationRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *domain.RunCalibrationRequest) (*domain.CalibrationRun, error)); ok {
+		return rf(ctx, gameID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *domain.RunCalibrationRequest) *domain.CalibrationRun); ok {
+		r0 = rf(ctx, gameID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.CalibrationRun)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *domain.RunCalibrationRequest) error); ok {
+		r1 = rf(ctx, gameID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CalibrationUseCase_Run_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Run'
+type CalibrationUseCase_Run_Call struct {
+	*mock.Call
+}
+
+// Run is a helper method to define mock.On call
+//   - ctx context.Context
+//   - gameID string
+//   - req *domain.RunCalibrationRequest
+func (_e *CalibrationUseCase_Expecter) Run(ctx interface{}, gameID interface{}, req interface{}) *CalibrationUseCase_Run_Call {
+	return &CalibrationUseCase_Run_Call{Call: _e.mock.On("Run", ctx, gameID, req)}
+}
+
+func (_c *CalibrationUseCase_Run_Call) Run(run func(ctx context.Context, gameID string, req *domain.RunCalibrationRequest)) *CalibrationUseCase_Run_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*domain.RunCalibrationRequest))
+	})
+	return _c
+}
+
+func (_c *CalibrationUseCase_Run_Call) Return(_a0 *domain.CalibrationRun, _a1 error) *CalibrationUseCase_Run_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CalibrationUseCase_Run_Call) RunAndReturn(run func(context.Context, string, *domain.RunCalibrationRequest) (*domain.CalibrationRun, error)) *CalibrationUseCase_Run_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewCalibrationUseCase creates a new instance of CalibrationUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCalibrationUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CalibrationUseCase {
+	mock := &CalibrationUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,263 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/everyday-studio/ollm/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// IdempotencyRepository is an autogenerated mock type for the IdempotencyRepository type
+type IdempotencyRepository struct {
+	mock.Mock
+}
+
+type IdempotencyRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IdempotencyRepository) EXPECT() *IdempotencyRepository_Expecter {
+	return &IdempotencyRepository_Expecter{mock: &_m.Mock}
+}
+
+// Complete provides a mock function with given fields: ctx, userID, key, token, result
+func (_m *IdempotencyRepository) Complete(ctx context.Context, userID string, key string, token string, result *domain.TurnResult) error {
+	ret := _m.Called(ctx, userID, key, token, result)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Complete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, *domain.TurnResult) error); ok {
+		r0 = rf(ctx, userID, key, token, result)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IdempotencyRepository_Complete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Complete'
+type IdempotencyRepository_Complete_Call struct {
+	*mock.Call
+}
+
+// Complete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - key string
+//   - token string
+//   - result *domain.TurnResult
+func (_e *IdempotencyRepository_Expecter) Complete(ctx interface{}, userID interface{}, key interface{}, token interface{}, result interface{}) *IdempotencyRepository_Complete_Call {
+	return &IdempotencyRepository_Complete_Call{Call: _e.mock.On("Complete", ctx, userID, key, token, result)}
+}
+
+func (_c *IdempotencyRepository_Complete_Call) Run(run func(ctx context.Context, userID string, key string, token string, result *domain.TurnResult)) *IdempotencyRepository_Complete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(*domain.TurnResult))
+	})
+	return _c
+}
+
+func (_c *IdempotencyRepository_Complete_Call) Return(_a0 error) *IdempotencyRepository_Complete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IdempotencyRepository_Complete_Call) RunAndReturn(run func(context.Context, string, string, string, *domain.TurnResult) error) *IdempotencyRepository_Complete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Purge provides a mock function with given fields: ctx, before, limit
+func (_m *IdempotencyRepository) Purge(ctx context.Context, before time.Time, limit int) (int64, error) {
+	ret := _m.Called(ctx, before, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Purge")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) (int64, error)); ok {
+		return rf(ctx, before, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) int64); ok {
+		r0 = rf(ctx, before, limit)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, before, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IdempotencyRepository_Purge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Purge'
+type IdempotencyRepository_Purge_Call struct {
+	*mock.Call
+}
+
+// Purge is a helper method to define mock.On call
+//   - ctx context.Context
+//   - before time.Time
+//   - limit int
+func (_e *IdempotencyRepository_Expecter) Purge(ctx interface{}, before interface{}, limit interface{}) *IdempotencyRepository_Purge_Call {
+	return &IdempotencyRepository_Purge_Call{Call: _e.mock.On("Purge", ctx, before, limit)}
+}
+
+func (_c *IdempotencyRepository_Purge_Call) Run(run func(ctx context.Context, before time.Time, limit int)) *IdempotencyRepository_Purge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *IdempotencyRepository_Purge_Call) Return(_a0 int64, _a1 error) *IdempotencyRepository_Purge_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IdempotencyRepository_Purge_Call) RunAndReturn(run func(context.Context, time.Time, int) (int64, error)) *IdempotencyRepository_Purge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Release provides a mock function with given fields: ctx, userID, key, token
+func (_m *IdempotencyRepository) Release(ctx context.Context, userID string, key string, token string) error {
+	ret := _m.Called(ctx, userID, key, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Release")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, userID, key, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IdempotencyRepository_Release_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Release'
+type IdempotencyRepository_Release_Call struct {
+	*mock.Call
+}
+
+// Release is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - key string
+//   - token string
+func (_e *IdempotencyRepository_Expecter) Release(ctx interface{}, userID interface{}, key interface{}, token interface{}) *IdempotencyRepository_Release_Call {
+	return &IdempotencyRepository_Release_Call{Call: _e.mock.On("Release", ctx, userID, key, token)}
+}
+
+func (_c *IdempotencyRepository_Release_Call) Run(run func(ctx context.Context, userID string, key string, token string)) *IdempotencyRepository_Release_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *IdempotencyRepository_Release_Call) Return(_a0 error) *IdempotencyRepository_Release_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IdempotencyRepository_Release_Call) RunAndReturn(run func(context.Context, string, string, string) error) *IdempotencyRepository_Release_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reserve provides a mock function with given fields: ctx, key, staleBefore
+func (_m *IdempotencyRepository) Reserve(ctx context.Context, key *domain.IdempotencyKey, staleBefore time.Time) (*domain.IdempotencyKey, bool, error) {
+	ret := _m.Called(ctx, key, staleBefore)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reserve")
+	}
+
+	var r0 *domain.IdempotencyKey
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.IdempotencyKey, time.Time) (*domain.IdempotencyKey, bool, error)); ok {
+		return rf(ctx, key, staleBefore)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.IdempotencyKey, time.Time) *domain.IdempotencyKey); ok {
+		r0 = rf(ctx, key, staleBefore)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.IdempotencyKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.IdempotencyKey, time.Time) bool); ok {
+		r1 = rf(ctx, key, staleBefore)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *domain.IdempotencyKey, time.Time) error); ok {
+		r2 = rf(ctx, key, staleBefore)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// IdempotencyRepository_Reserve_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reserve'
+type IdempotencyRepository_Reserve_Call struct {
+	*mock.Call
+}
+
+// Reserve is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key *domain.IdempotencyKey
+//   - staleBefore time.Time
+func (_e *IdempotencyRepository_Expecter) Reserve(ctx interface{}, key interface{}, staleBefore interface{}) *IdempotencyRepository_Reserve_Call {
+	return &IdempotencyRepository_Reserve_Call{Call: _e.mock.On("Reserve", ctx, key, staleBefore)}
+}
+
+func (_c *IdempotencyRepository_Reserve_Call) Run(run func(ctx context.Context, key *domain.IdempotencyKey, staleBefore time.Time)) *IdempotencyRepository_Reserve_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.IdempotencyKey), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *IdempotencyRepository_Reserve_Call) Return(_a0 *domain.IdempotencyKey, _a1 bool, _a2 error) *IdempotencyRepository_Reserve_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *IdempotencyRepository_Reserve_Call) RunAndReturn(run func(context.Context, *domain.IdempotencyKey, time.Time) (*domain.IdempotencyKey, bool, error)) *IdempotencyRepository_Reserve_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIdempotencyRepository creates a new instance of IdempotencyRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIdempotencyRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IdempotencyRepository {
+	mock := &IdempotencyRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,142 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/everyday-studio/ollm/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Judge is an autogenerated mock type for the Judge type
+type Judge struct {
+	mock.Mock
+}
+
+type Judge_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Judge) EXPECT() *Judge_Expecter {
+	return &Judge_Expecter{mock: &_m.Mock}
+}
+
+// Evaluate provides a mock function with given fields: ctx, input
+func (_m *Judge) Evaluate(ctx context.Context, input domain.JudgeInput) (*domain.JudgeVerdict, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Evaluate")
+	}
+
+	var r0 *domain.JudgeVerdict
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.JudgeInput) (*domain.JudgeVerdict, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.JudgeInput) *domain.JudgeVerdict); ok {
+		r0 = rf(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.JudgeVerdict)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.JudgeInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Judge_Evaluate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Evaluate'
+type Judge_Evaluate_Call struct {
+	*mock.Call
+}
+
+// Evaluate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input domain.JudgeInput
+func (_e *Judge_Expecter) Evaluate(ctx interface{}, input interface{}) *Judge_Evaluate_Call {
+	return &Judge_Evaluate_Call{Call: _e.mock.On("Evaluate", ctx, input)}
+}
+
+func (_c *Judge_Evaluate_Call) Run(run func(ctx context.Context, input domain.JudgeInput)) *Judge_Evaluate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.JudgeInput))
+	})
+	return _c
+}
+
+func (_c *Judge_Evaluate_Call) Return(_a0 *domain.JudgeVerdict, _a1 error) *Judge_Evaluate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Judge_Evaluate_Call) RunAndReturn(run func(context.Context, domain.JudgeInput) (*domain.JudgeVerdict, error)) *Judge_Evaluate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Validate provides a mock function with given fields: condition
+func (_m *Judge) Validate(condition string) error {
+	ret := _m.Called(condition)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Validate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(condition)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Judge_Validate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Validate'
+type Judge_Validate_Call struct {
+	*mock.Call
+}
+
+// Validate is a helper method to define mock.On call
+//   - condition string
+func (_e *Judge_Expecter) Validate(condition interface{}) *Judge_Validate_Call {
+	return &Judge_Validate_Call{Call: _e.mock.On("Validate", condition)}
+}
+
+func (_c *Judge_Validate_Call) Run(run func(condition string)) *Judge_Validate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Judge_Validate_Call) Return(_a0 error) *Judge_Validate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Judge_Validate_Call) RunAndReturn(run func(string) error) *Judge_Validate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewJudge creates a new instance of Judge. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewJudge(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Judge {
+	mock := &Judge{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,339 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/everyday-studio/ollm/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// LLMCallRepository is an autogenerated mock type for the LLMCallRepository type
+type LLMCallRepository struct {
+	mock.Mock
+}
+
+type LLMCallRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *LLMCallRepository) EXPECT() *LLMCallRepository_Expecter {
+	return &LLMCallRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, call
+func (_m *LLMCallRepository) Create(ctx context.Context, call *domain.LLMCall) (*domain.LLMCall, error) {
+	ret := _m.Called(ctx, call)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *domain.LLMCall
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.LLMCall) (*domain.LLMCall, error)); ok {
+		return rf(ctx, call)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.LLMCall) *domain.LLMCall); ok {
+		r0 = rf(ctx, call)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.LLMCall)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.LLMCall) error); ok {
+		r1 = rf(ctx, call)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LLMCallRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type LLMCallRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - call *domain.LLMCall
+func (_e *LLMCallRepository_Expecter) Create(ctx interface{}, call interface{}) *LLMCallRepository_Create_Call {
+	return &LLMCallRepository_Create_Call{Call: _e.mock.On("Create", ctx, call)}
+}
+
+func (_c *LLMCallRepository_Create_Call) Run(run func(ctx context.Context, call *domain.LLMCall)) *LLMCallRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.LLMCall))
+	})
+	return _c
+}
+
+func (_c *LLMCallRepository_Create_Call) Return(_a0 *domain.LLMCall, _a1 error) *LLMCallRepository_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LLMCallRepository_Create_Call) RunAndReturn(run func(context.Context, *domain.LLMCall) (*domain.LLMCall, error)) *LLMCallRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByMatchID provides a mock function with given fields: ctx, matchID
+func (_m *LLMCallRepository) GetByMatchID(ctx context.Context, matchID string) ([]domain.LLMCall, error) {
+	ret := _m.Called(ctx, matchID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByMatchID")
+	}
+
+	var r0 []domain.LLMCall
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]domain.LLMCall, error)); ok {
+		return rf(ctx, matchID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []domain.LLMCall); ok {
+		r0 = rf(ctx, matchID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.LLMCall)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, matchID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LLMCallRepository_GetByMatchID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByMatchID'
+type LLMCallRepository_GetByMatchID_Call struct {
+	*mock.Call
+}
+
+// GetByMatchID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - matchID string
+func (_e *LLMCallRepository_Expecter) GetByMatchID(ctx interface{}, matchID interface{}) *LLMCallRepository_GetByMatchID_Call {
+	return &LLMCallRepository_GetByMatchID_Call{Call: _e.mock.On("GetByMatchID", ctx, matchID)}
+}
+
+func (_c *LLMCallRepository_GetByMatchID_Call) Run(run func(ctx context.Context, matchID string)) *LLMCallRepository_GetByMatchID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *LLMCallRepository_GetByMatchID_Call) Return(_a0 []domain.LLMCall, _a1 error) *LLMCallRepository_GetByMatchID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LLMCallRepository_GetByMatchID_Call) RunAndReturn(run func(context.Context, string) ([]domain.LLMCall, error)) *LLMCallRepository_GetByMatchID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSpendByDay provides a mock function with given fields: ctx, from, to
+func (_m *LLMCallRepository) GetSpendByDay(ctx context.Context, from time.Time, to time.Time) ([]domain.SpendSummary, error) {
+	ret := _m.Called(ctx, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSpendByDay")
+	}
+
+	var r0 []domain.SpendSummary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) ([]domain.SpendSummary, error)); ok {
+		return rf(ctx, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) []domain.SpendSummary); ok {
+		r0 = rf(ctx, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.SpendSummary)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LLMCallRepository_GetSpendByDay_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSpendByDay'
+type LLMCallRepository_GetSpendByDay_Call struct {
+	*mock.Call
+}
+
+// GetSpendByDay is a helper method to define mock.On call
+//   - ctx context.Context
+//   - from time.Time
+//   - to time.Time
+func (_e *LLMCallRepository_Expecter) GetSpendByDay(ctx interface{}, from interface{}, to interface{}) *LLMCallRepository_GetSpendByDay_Call {
+	return &LLMCallRepository_GetSpendByDay_Call{Call: _e.mock.On("GetSpendByDay", ctx, from, to)}
+}
+
+func (_c *LLMCallRepository_GetSpendByDay_Call) Run(run func(ctx context.Context, from time.Time, to time.Time)) *LLMCallRepository_GetSpendByDay_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *LLMCallRepository_GetSpendByDay_Call) Return(_a0 []domain.SpendSummary, _a1 error) *LLMCallRepository_GetSpendByDay_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LLMCallRepository_GetSpendByDay_Call) RunAndReturn(run func(context.Context, time.Time, time.Time) ([]domain.SpendSummary, error)) *LLMCallRepository_GetSpendByDay_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSpendByGame provides a mock function with given fields: ctx, from, to, limit
+func (_m *LLMCallRepository) GetSpendByGame(ctx context.Context, from time.Time, to time.Time, limit int) ([]domain.SpendSummary, error) {
+	ret := _m.Called(ctx, from, to, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSpendByGame")
+	}
+
+	var r0 []domain.SpendSummary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, int) ([]domain.SpendSummary, error)); ok {
+		return rf(ctx, from, to, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, int) []domain.SpendSummary); ok {
+		r0 = rf(ctx, from, to, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.SpendSummary)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time, int) error); ok {
+		r1 = rf(ctx, from, to, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LLMCallRepository_GetSpendByGame_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSpendByGame'
+type LLMCallRepository_GetSpendByGame_Call struct {
+	*mock.Call
+}
+
+// GetSpendByGame is a helper method to define mock.On call
+//   - ctx context.Context
+//   - from time.Time
+//   - to time.Time
+//   - limit int
+func (_e *LLMCallRepository_Expecter) GetSpendByGame(ctx interface{}, from interface{}, to interface{}, limit interface{}) *LLMCallRepository_GetSpendByGame_Call {
+	return &LLMCallRepository_GetSpendByGame_Call{Call: _e.mock.On("GetSpendByGame", ctx, from, to, limit)}
+}
+
+func (_c *LLMCallRepository_GetSpendByGame_Call) Run(run func(ctx context.Context, from time.Time, to time.Time, limit int)) *LLMCallRepository_GetSpendByGame_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(time.Time), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *LLMCallRepository_GetSpendByGame_Call) Return(_a0 []domain.SpendSummary, _a1 error) *LLMCallRepository_GetSpendByGame_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LLMCallRepository_GetSpendByGame_Call) RunAndReturn(run func(context.Context, time.Time, time.Time, int) ([]domain.SpendSummary, error)) *LLMCallRepository_GetSpendByGame_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSpendByUser provides a mock function with given fields: ctx, from, to, limit
+func (_m *LLMCallRepository) GetSpendByUser(ctx context.Context, from time.Time, to time.Time, limit int) ([]domain.SpendSummary, error) {
+	ret := _m.Called(ctx, from, to, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSpendByUser")
+	}
+
+	var r0 []domain.SpendSummary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, int) ([]domain.SpendSummary, error)); ok {
+		return rf(ctx, from, to, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, int) []domain.SpendSummary); ok {
+		r0 = rf(ctx, from, to, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.SpendSummary)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time, int) error); ok {
+		r1 = rf(ctx, from, to, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LLMCallRepository_GetSpendByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSpendByUser'
+type LLMCallRepository_GetSpendByUser_Call struct {
+	*mock.Call
+}
+
+// GetSpendByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - from time.Time
+//   - to time.Time
+//   - limit int
+func (_e *LLMCallRepository_Expecter) GetSpendByUser(ctx interface{}, from interface{}, to interface{}, limit interface{}) *LLMCallRepository_GetSpendByUser_Call {
+	return &LLMCallRepository_GetSpendByUser_Call{Call: _e.mock.On("GetSpendByUser", ctx, from, to, limit)}
+}
+
+func (_c *LLMCallRepository_GetSpendByUser_Call) Run(run func(ctx context.Context, from time.Time, to time.Time, limit int)) *LLMCallRepository_GetSpendByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(time.Time), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *LLMCallRepository_GetSpendByUser_Call) Return(_a0 []domain.SpendSummary, _a1 error) *LLMCallRepository_GetSpendByUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LLMCallRepository_GetSpendByUser_Call) RunAndReturn(run func(context.Context, time.Time, time.Time, int) ([]domain.SpendSummary, error)) *LLMCallRepository_GetSpendByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewLLMCallRepository creates a new instance of LLMCallRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewLLMCallRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *LLMCallRepository {
+	mock := &LLMCallRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,155 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/everyday-studio/ollm/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// LLMCallUseCase is an autogenerated mock type for the LLMCallUseCase type
+type LLMCallUseCase struct {
+	mock.Mock
+}
+
+type LLMCallUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *LLMCallUseCase) EXPECT() *LLMCallUseCase_Expecter {
+	return &LLMCallUseCase_Expecter{mock: &_m.Mock}
+}
+
+// GetByMatchID provides a mock function with given fields: ctx, matchID
+func (_m *LLMCallUseCase) GetByMatchID(ctx context.Context, matchID string) ([]domain.LLMCall, error) {
+	ret := _m.Called(ctx, matchID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByMatchID")
+	}
+
+	var r0 []domain.LLMCall
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]domain.LLMCall, error)); ok {
+		return rf(ctx, matchID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []domain.LLMCall); ok {
+		r0 = rf(ctx, matchID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.LLMCall)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, matchID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LLMCallUseCase_GetByMatchID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByMatchID'
+type LLMCallUseCase_GetByMatchID_Call struct {
+	*mock.Call
+}
+
+// GetByMatchID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - matchID string
+func (_e *LLMCallUseCase_Expecter) GetByMatchID(ctx interface{}, matchID interface{}) *LLMCallUseCase_GetByMatchID_Call {
+	return &LLMCallUseCase_GetByMatchID_Call{Call: _e.mock.On("GetByMatchID", ctx, matchID)}
+}
+
+func (_c *LLMCallUseCase_GetByMatchID_Call) Run(run func(ctx context.Context, matchID string)) *LLMCallUseCase_GetByMatchID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *LLMCallUseCase_GetByMatchID_Call) Return(_a0 []domain.LLMCall, _a1 error) *LLMCallUseCase_GetByMatchID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LLMCallUseCase_GetByMatchID_Call) RunAndReturn(run func(context.Context, string) ([]domain.LLMCall, error)) *LLMCallUseCase_GetByMatchID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSpendReport provides a mock function with given fields: ctx, days
+func (_m *LLMCallUseCase) GetSpendReport(ctx context.Context, days int) (*domain.SpendReport, error) {
+	ret := _m.Called(ctx, days)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSpendReport")
+	}
+
+	var r0 *domain.SpendReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*domain.SpendReport, error)); ok {
+		return rf(ctx, days)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *domain.SpendReport); ok {
+		r0 = rf(ctx, days)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.SpendReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, days)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LLMCallUseCase_GetSpendReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSpendReport'
+type LLMCallUseCase_GetSpendReport_Call struct {
+	*mock.Call
+}
+
+// GetSpendReport is a helper method to define mock.On call
+//   - ctx context.Context
+//   - days int
+func (_e *LLMCallUseCase_Expecter) GetSpendReport(ctx interface{}, days interface{}) *LLMCallUseCase_GetSpendReport_Call {
+	return &LLMCallUseCase_GetSpendReport_Call{Call: _e.mock.On("GetSpendReport", ctx, days)}
+}
+
+func (_c *LLMCallUseCase_GetSpendReport_Call) Run(run func(ctx context.Context, days int)) *LLMCallUseCase_GetSpendReport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *LLMCallUseCase_GetSpendReport_Call) Return(_a0 *domain.SpendReport, _a1 error) *LLMCallUseCase_GetSpendReport_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LLMCallUseCase_GetSpendReport_Call) RunAndReturn(run func(context.Context, int) (*domain.SpendReport, error)) *LLMCallUseCase_GetSpendReport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewLLMCallUseCase creates a new instance of LLMCallUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewLLMCallUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *LLMCallUseCase {
+	mock := &LLMCallUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,246 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	domain "github.com/everyday-studio/ollm/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// LLMRegistry is an autogenerated mock type for the LLMRegistry type
+type LLMRegistry struct {
+	mock.Mock
+}
+
+type LLMRegistry_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *LLMRegistry) EXPECT() *LLMRegistry_Expecter {
+	return &LLMRegistry_Expecter{mock: &_m.Mock}
+}
+
+// Chat provides a mock function with given fields: name, params
+func (_m *LLMRegistry) Chat(name string, params domain.LLMParams) (domain.LLMService, error) {
+	ret := _m.Called(name, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Chat")
+	}
+
+	var r0 domain.LLMService
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, domain.LLMParams) (domain.LLMService, error)); ok {
+		return rf(name, params)
+	}
+	if rf, ok := ret.Get(0).(func(string, domain.LLMParams) domain.LLMService); ok {
+		r0 = rf(name, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(domain.LLMService)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, domain.LLMParams) error); ok {
+		r1 = rf(name, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LLMRegistry_Chat_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Chat'
+type LLMRegistry_Chat_Call struct {
+	*mock.Call
+}
+
+// Chat is a helper method to define mock.On call
+//   - name string
+//   - params domain.LLMParams
+func (_e *LLMRegistry_Expecter) Chat(name interface{}, params interface{}) *LLMRegistry_Chat_Call {
+	return &LLMRegistry_Chat_Call{Call: _e.mock.On("Chat", name, params)}
+}
+
+func (_c *LLMRegistry_Chat_Call) Run(run func(name string, params domain.LLMParams)) *LLMRegistry_Chat_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(domain.LLMParams))
+	})
+	return _c
+}
+
+func (_c *LLMRegistry_Chat_Call) Return(_a0 domain.LLMService, _a1 error) *LLMRegistry_Chat_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LLMRegistry_Chat_Call) RunAndReturn(run func(string, domain.LLMParams) (domain.LLMService, error)) *LLMRegistry_Chat_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Health provides a mock function with no fields
+func (_m *LLMRegistry) Health() []domain.LLMProviderHealth {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Health")
+	}
+
+	var r0 []domain.LLMProviderHealth
+	if rf, ok := ret.Get(0).(func() []domain.LLMProviderHealth); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.LLMProviderHealth)
+		}
+	}
+
+	return r0
+}
+
+// LLMRegistry_Health_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Health'
+type LLMRegistry_Health_Call struct {
+	*mock.Call
+}
+
+// Health is a helper method to define mock.On call
+func (_e *LLMRegistry_Expecter) Health() *LLMRegistry_Health_Call {
+	return &LLMRegistry_Health_Call{Call: _e.mock.On("Health")}
+}
+
+func (_c *LLMRegistry_Health_Call) Run(run func()) *LLMRegistry_Health_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *LLMRegistry_Health_Call) Return(_a0 []domain.LLMProviderHealth) *LLMRegistry_Health_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LLMRegistry_Health_Call) RunAndReturn(run func() []domain.LLMProviderHealth) *LLMRegistry_Health_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Judge provides a mock function with given fields: name
+func (_m *LLMRegistry) Judge(name string) (domain.LLMService, error) {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Judge")
+	}
+
+	var r0 domain.LLMService
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (domain.LLMService, error)); ok {
+		return rf(name)
+	}
+	if rf, ok := ret.Get(0).(func(string) domain.LLMService); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(domain.LLMService)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LLMRegistry_Judge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Judge'
+type LLMRegistry_Judge_Call struct {
+	*mock.Call
+}
+
+// Judge is a helper method to define mock.On call
+//   - name string
+func (_e *LLMRegistry_Expecter) Judge(name interface{}) *LLMRegistry_Judge_Call {
+	return &LLMRegistry_Judge_Call{Call: _e.mock.On("Judge", name)}
+}
+
+func (_c *LLMRegistry_Judge_Call) Run(run func(name string)) *LLMRegistry_Judge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *LLMRegistry_Judge_Call) Return(_a0 domain.LLMService, _a1 error) *LLMRegistry_Judge_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LLMRegistry_Judge_Call) RunAndReturn(run func(string) (domain.LLMService, error)) *LLMRegistry_Judge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Models provides a mock function with no fields
+func (_m *LLMRegistry) Models() []string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Models")
+	}
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
+// LLMRegistry_Models_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Models'
+type LLMRegistry_Models_Call struct {
+	*mock.Call
+}
+
+// Models is a helper method to define mock.On call
+func (_e *LLMRegistry_Expecter) Models() *LLMRegistry_Models_Call {
+	return &LLMRegistry_Models_Call{Call: _e.mock.On("Models")}
+}
+
+func (_c *LLMRegistry_Models_Call) Run(run func()) *LLMRegistry_Models_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *LLMRegistry_Models_Call) Return(_a0 []string) *LLMRegistry_Models_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LLMRegistry_Models_Call) RunAndReturn(run func() []string) *LLMRegistry_Models_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewLLMRegistry creates a new instance of LLMRegistry. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewLLMRegistry(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *LLMRegistry {
+	mock := &LLMRegistry{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
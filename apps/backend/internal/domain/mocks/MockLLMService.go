@@ -23,22 +23,24 @@ func (_m *LLMService) EXPECT() *LLMService_Expecter {
 }
 
 // EvaluateFormatBreak provides a mock function with given fields: ctx, condition, aiContent
-func (_m *LLMService) EvaluateFormatBreak(ctx context.Context, condition string, aiContent string) (bool, error) {
+func (_m *LLMService) EvaluateFormatBreak(ctx context.Context, condition string, aiContent string) (*domain.LLMJudgement, error) {
 	ret := _m.Called(ctx, condition, aiContent)
 
 	if len(ret) == 0 {
 		panic("no return value specified for EvaluateFormatBreak")
 	}
 
-	var r0 bool
+	var r0 *domain.LLMJudgement
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) (bool, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*domain.LLMJudgement, error)); ok {
 		return rf(ctx, condition, aiContent)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *domain.LLMJudgement); ok {
 		r0 = rf(ctx, condition, aiContent)
 	} else {
-		r0 = ret.Get(0).(bool)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.LLMJudgement)
+		}
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
@@ -70,12 +72,12 @@ func (_c *LLMService_EvaluateFormatBreak_Call) Run(run func(ctx context.Context,
 	return _c
 }
 
-func (_c *LLMService_EvaluateFormatBreak_Call) Return(_a0 bool, _a1 error) *LLMService_EvaluateFormatBreak_Call {
+func (_c *LLMService_EvaluateFormatBreak_Call) Return(_a0 *domain.LLMJudgement, _a1 error) *LLMService_EvaluateFormatBreak_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *LLMService_EvaluateFormatBreak_Call) RunAndReturn(run func(context.Context, string, string) (bool, error)) *LLMService_EvaluateFormatBreak_Call {
+func (_c *LLMService_EvaluateFormatBreak_Call) RunAndReturn(run func(context.Context, string, string) (*domain.LLMJudgement, error)) *LLMService_EvaluateFormatBreak_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -140,45 +142,33 @@ func (_c *LLMService_EvaluatePromptAdvice_Call) RunAndReturn(run func(context.Co
 }
 
 // EvaluateWinCondition provides a mock function with given fields: ctx, judgeCondition, history
-func (_m *LLMService) EvaluateWinCondition(ctx context.Context, judgeCondition string, history []domain.Message) (bool, int, int, error) {
+func (_m *LLMService) EvaluateWinCondition(ctx context.Context, judgeCondition string, history []domain.Message) (*domain.LLMJudgement, error) {
 	ret := _m.Called(ctx, judgeCondition, history)
 
 	if len(ret) == 0 {
 		panic("no return value specified for EvaluateWinCondition")
 	}
 
-	var r0 bool
-	var r1 int
-	var r2 int
-	var r3 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, []domain.Message) (bool, int, int, error)); ok {
+	var r0 *domain.LLMJudgement
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []domain.Message) (*domain.LLMJudgement, error)); ok {
 		return rf(ctx, judgeCondition, history)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, []domain.Message) bool); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string, []domain.Message) *domain.LLMJudgement); ok {
 		r0 = rf(ctx, judgeCondition, history)
 	} else {
-		r0 = ret.Get(0).(bool)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.LLMJudgement)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, []domain.Message) int); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, string, []domain.Message) error); ok {
 		r1 = rf(ctx, judgeCondition, history)
 	} else {
-		r1 = ret.Get(1).(int)
-	}
-
-	if rf, ok := ret.Get(2).(func(context.Context, string, []domain.Message) int); ok {
-		r2 = rf(ctx, judgeCondition, history)
-	} else {
-		r2 = ret.Get(2).(int)
-	}
-
-	if rf, ok := ret.Get(3).(func(context.Context, string, []domain.Message) error); ok {
-		r3 = rf(ctx, judgeCondition, history)
-	} else {
-		r3 = ret.Error(3)
+		r1 = ret.Error(1)
 	}
 
-	return r0, r1, r2, r3
+	return r0, r1
 }
 
 // LLMService_EvaluateWinCondition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EvaluateWinCondition'
@@ -201,56 +191,44 @@ func (_c *LLMService_EvaluateWinCondition_Call) Run(run func(ctx context.Context
 	return _c
 }
 
-func (_c *LLMService_EvaluateWinCondition_Call) Return(_a0 bool, _a1 int, _a2 int, _a3 error) *LLMService_EvaluateWinCondition_Call {
-	_c.Call.Return(_a0, _a1, _a2, _a3)
+func (_c *LLMService_EvaluateWinCondition_Call) Return(_a0 *domain.LLMJudgement, _a1 error) *LLMService_EvaluateWinCondition_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *LLMService_EvaluateWinCondition_Call) RunAndReturn(run func(context.Context, string, []domain.Message) (bool, int, int, error)) *LLMService_EvaluateWinCondition_Call {
+func (_c *LLMService_EvaluateWinCondition_Call) RunAndReturn(run func(context.Context, string, []domain.Message) (*domain.LLMJudgement, error)) *LLMService_EvaluateWinCondition_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // GenerateResponse provides a mock function with given fields: ctx, history
-func (_m *LLMService) GenerateResponse(ctx context.Context, history []domain.Message) (string, int, int, error) {
+func (_m *LLMService) GenerateResponse(ctx context.Context, history []domain.Message) (*domain.LLMResponse, error) {
 	ret := _m.Called(ctx, history)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GenerateResponse")
 	}
 
-	var r0 string
-	var r1 int
-	var r2 int
-	var r3 error
-	if rf, ok := ret.Get(0).(func(context.Context, []domain.Message) (string, int, int, error)); ok {
+	var r0 *domain.LLMResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.Message) (*domain.LLMResponse, error)); ok {
 		return rf(ctx, history)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, []domain.Message) string); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.Message) *domain.LLMResponse); ok {
 		r0 = rf(ctx, history)
 	} else {
-		r0 = ret.Get(0).(string)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.LLMResponse)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, []domain.Message) int); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, []domain.Message) error); ok {
 		r1 = rf(ctx, history)
 	} else {
-		r1 = ret.Get(1).(int)
-	}
-
-	if rf, ok := ret.Get(2).(func(context.Context, []domain.Message) int); ok {
-		r2 = rf(ctx, history)
-	} else {
-		r2 = ret.Get(2).(int)
-	}
-
-	if rf, ok := ret.Get(3).(func(context.Context, []domain.Message) error); ok {
-		r3 = rf(ctx, history)
-	} else {
-		r3 = ret.Error(3)
+		r1 = ret.Error(1)
 	}
 
-	return r0, r1, r2, r3
+	return r0, r1
 }
 
 // LLMService_GenerateResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateResponse'
@@ -272,12 +250,72 @@ func (_c *LLMService_GenerateResponse_Call) Run(run func(ctx context.Context, hi
 	return _c
 }
 
-func (_c *LLMService_GenerateResponse_Call) Return(_a0 string, _a1 int, _a2 int, _a3 error) *LLMService_GenerateResponse_Call {
-	_c.Call.Return(_a0, _a1, _a2, _a3)
+func (_c *LLMService_GenerateResponse_Call) Return(_a0 *domain.LLMResponse, _a1 error) *LLMService_GenerateResponse_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LLMService_GenerateResponse_Call) RunAndReturn(run func(context.Context, []domain.Message) (*domain.LLMResponse, error)) *LLMService_GenerateResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StreamResponse provides a mock function with given fields: ctx, history, onDelta
+func (_m *LLMService) StreamResponse(ctx context.Context, history []domain.Message, onDelta func(string) error) (*domain.LLMResponse, error) {
+	ret := _m.Called(ctx, history, onDelta)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamResponse")
+	}
+
+	var r0 *domain.LLMResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.Message, func(string) error) (*domain.LLMResponse, error)); ok {
+		return rf(ctx, history, onDelta)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.Message, func(string) error) *domain.LLMResponse); ok {
+		r0 = rf(ctx, history, onDelta)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.LLMResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []domain.Message, func(string) error) error); ok {
+		r1 = rf(ctx, history, onDelta)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LLMService_StreamResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamResponse'
+type LLMService_StreamResponse_Call struct {
+	*mock.Call
+}
+
+// StreamResponse is a helper method to define mock.On call
+//   - ctx context.Context
+//   - history []domain.Message
+//   - onDelta func(string) error
+func (_e *LLMService_Expecter) StreamResponse(ctx interface{}, history interface{}, onDelta interface{}) *LLMService_StreamResponse_Call {
+	return &LLMService_StreamResponse_Call{Call: _e.mock.On("StreamResponse", ctx, history, onDelta)}
+}
+
+func (_c *LLMService_StreamResponse_Call) Run(run func(ctx context.Context, history []domain.Message, onDelta func(string) error)) *LLMService_StreamResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]domain.Message), args[2].(func(string) error))
+	})
+	return _c
+}
+
+func (_c *LLMService_StreamResponse_Call) Return(_a0 *domain.LLMResponse, _a1 error) *LLMService_StreamResponse_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *LLMService_GenerateResponse_Call) RunAndReturn(run func(context.Context, []domain.Message) (string, int, int, error)) *LLMService_GenerateResponse_Call {
+func (_c *LLMService_StreamResponse_Call) RunAndReturn(run func(context.Context, []domain.Message, func(string) error) (*domain.LLMResponse, error)) *LLMService_StreamResponse_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -7,6 +7,8 @@ import (
 
 	domain "github.com/everyday-studio/ollm/internal/domain"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // MatchRepository is an autogenerated mock type for the MatchRepository type
@@ -22,6 +24,66 @@ func (_m *MatchRepository) EXPECT() *MatchRepository_Expecter {
 	return &MatchRepository_Expecter{mock: &_m.Mock}
 }
 
+// CompareAndSet provides a mock function with given fields: ctx, match, expected
+func (_m *MatchRepository) CompareAndSet(ctx context.Context, match *domain.Match, expected domain.MatchStatus) (*domain.Match, error) {
+	ret := _m.Called(ctx, match, expected)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompareAndSet")
+	}
+
+	var r0 *domain.Match
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Match, domain.MatchStatus) (*domain.Match, error)); ok {
+		return rf(ctx, match, expected)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Match, domain.MatchStatus) *domain.Match); ok {
+		r0 = rf(ctx, match, expected)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Match)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.Match, domain.MatchStatus) error); ok {
+		r1 = rf(ctx, match, expected)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MatchRepository_CompareAndSet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompareAndSet'
+type MatchRepository_CompareAndSet_Call struct {
+	*mock.Call
+}
+
+// CompareAndSet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - match *domain.Match
+//   - expected domain.MatchStatus
+func (_e *MatchRepository_Expecter) CompareAndSet(ctx interface{}, match interface{}, expected interface{}) *MatchRepository_CompareAndSet_Call {
+	return &MatchRepository_CompareAndSet_Call{Call: _e.mock.On("CompareAndSet", ctx, match, expected)}
+}
+
+func (_c *MatchRepository_CompareAndSet_Call) Run(run func(ctx context.Context, match *domain.Match, expected domain.MatchStatus)) *MatchRepository_CompareAndSet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.Match), args[2].(domain.MatchStatus))
+	})
+	return _c
+}
+
+func (_c *MatchRepository_CompareAndSet_Call) Return(_a0 *domain.Match, _a1 error) *MatchRepository_CompareAndSet_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MatchRepository_CompareAndSet_Call) RunAndReturn(run func(context.Context, *domain.Match, domain.MatchStatus) (*domain.Match, error)) *MatchRepository_CompareAndSet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CountByUserIDGameIDAndStatus provides a mock function with given fields: ctx, userID, gameID, status
 func (_m *MatchRepository) CountByUserIDGameIDAndStatus(ctx context.Context, userID string, gameID string, status domain.MatchStatus) (int, error) {
 	ret := _m.Called(ctx, userID, gameID, status)
@@ -187,6 +249,66 @@ func (_c *MatchRepository_Delete_Call) RunAndReturn(run func(context.Context, st
 	return _c
 }
 
+// ExpireIdle provides a mock function with given fields: ctx, defaultTTL, limit
+func (_m *MatchRepository) ExpireIdle(ctx context.Context, defaultTTL time.Duration, limit int) ([]domain.Match, error) {
+	ret := _m.Called(ctx, defaultTTL, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpireIdle")
+	}
+
+	var r0 []domain.Match
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration, int) ([]domain.Match, error)); ok {
+		return rf(ctx, defaultTTL, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration, int) []domain.Match); ok {
+		r0 = rf(ctx, defaultTTL, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Match)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Duration, int) error); ok {
+		r1 = rf(ctx, defaultTTL, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MatchRepository_ExpireIdle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpireIdle'
+type MatchRepository_ExpireIdle_Call struct {
+	*mock.Call
+}
+
+// ExpireIdle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - defaultTTL time.Duration
+//   - limit int
+func (_e *MatchRepository_Expecter) ExpireIdle(ctx interface{}, defaultTTL interface{}, limit interface{}) *MatchRepository_ExpireIdle_Call {
+	return &MatchRepository_ExpireIdle_Call{Call: _e.mock.On("ExpireIdle", ctx, defaultTTL, limit)}
+}
+
+func (_c *MatchRepository_ExpireIdle_Call) Run(run func(ctx context.Context, defaultTTL time.Duration, limit int)) *MatchRepository_ExpireIdle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Duration), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MatchRepository_ExpireIdle_Call) Return(_a0 []domain.Match, _a1 error) *MatchRepository_ExpireIdle_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MatchRepository_ExpireIdle_Call) RunAndReturn(run func(context.Context, time.Duration, int) ([]domain.Match, error)) *MatchRepository_ExpireIdle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetByID provides a mock function with given fields: ctx, id
 func (_m *MatchRepository) GetByID(ctx context.Context, id string) (*domain.Match, error) {
 	ret := _m.Called(ctx, id)
@@ -365,29 +487,29 @@ func (_c *MatchRepository_GetByUserIDAndGameID_Call) RunAndReturn(run func(conte
 	return _c
 }
 
-// Update provides a mock function with given fields: ctx, match
-func (_m *MatchRepository) Update(ctx context.Context, match *domain.Match) (*domain.Match, error) {
-	ret := _m.Called(ctx, match)
+// RecoverStuck provides a mock function with given fields: ctx, timeout, limit
+func (_m *MatchRepository) RecoverStuck(ctx context.Context, timeout time.Duration, limit int) ([]domain.Match, error) {
+	ret := _m.Called(ctx, timeout, limit)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Update")
+		panic("no return value specified for RecoverStuck")
 	}
 
-	var r0 *domain.Match
+	var r0 []domain.Match
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *domain.Match) (*domain.Match, error)); ok {
-		return rf(ctx, match)
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration, int) ([]domain.Match, error)); ok {
+		return rf(ctx, timeout, limit)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *domain.Match) *domain.Match); ok {
-		r0 = rf(ctx, match)
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration, int) []domain.Match); ok {
+		r0 = rf(ctx, timeout, limit)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.Match)
+			r0 = ret.Get(0).([]domain.Match)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *domain.Match) error); ok {
-		r1 = rf(ctx, match)
+	if rf, ok := ret.Get(1).(func(context.Context, time.Duration, int) error); ok {
+		r1 = rf(ctx, timeout, limit)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -395,31 +517,32 @@ func (_m *MatchRepository) Update(ctx context.Context, match *domain.Match) (*do
 	return r0, r1
 }
 
-// MatchRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
-type MatchRepository_Update_Call struct {
+// MatchRepository_RecoverStuck_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecoverStuck'
+type MatchRepository_RecoverStuck_Call struct {
 	*mock.Call
 }
 
-// Update is a helper method to define mock.On call
+// RecoverStuck is a helper method to define mock.On call
 //   - ctx context.Context
-//   - match *domain.Match
-func (_e *MatchRepository_Expecter) Update(ctx interface{}, match interface{}) *MatchRepository_Update_Call {
-	return &MatchRepository_Update_Call{Call: _e.mock.On("Update", ctx, match)}
+//   - timeout time.Duration
+//   - limit int
+func (_e *MatchRepository_Expecter) RecoverStuck(ctx interface{}, timeout interface{}, limit interface{}) *MatchRepository_RecoverStuck_Call {
+	return &MatchRepository_RecoverStuck_Call{Call: _e.mock.On("RecoverStuck", ctx, timeout, limit)}
 }
 
-func (_c *MatchRepository_Update_Call) Run(run func(ctx context.Context, match *domain.Match)) *MatchRepository_Update_Call {
+func (_c *MatchRepository_RecoverStuck_Call) Run(run func(ctx context.Context, timeout time.Duration, limit int)) *MatchRepository_RecoverStuck_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*domain.Match))
+		run(args[0].(context.Context), args[1].(time.Duration), args[2].(int))
 	})
 	return _c
 }
 
-func (_c *MatchRepository_Update_Call) Return(_a0 *domain.Match, _a1 error) *MatchRepository_Update_Call {
+func (_c *MatchRepository_RecoverStuck_Call) Return(_a0 []domain.Match, _a1 error) *MatchRepository_RecoverStuck_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MatchRepository_Update_Call) RunAndReturn(run func(context.Context, *domain.Match) (*domain.Match, error)) *MatchRepository_Update_Call {
+func (_c *MatchRepository_RecoverStuck_Call) RunAndReturn(run func(context.Context, time.Duration, int) ([]domain.Match, error)) *MatchRepository_RecoverStuck_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -307,6 +307,125 @@ func (_c *MatchUseCase_GetByUserIDAndGameID_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// GetVerdicts provides a mock function with given fields: ctx, id, userID
+func (_m *MatchUseCase) GetVerdicts(ctx context.Context, id string, userID string) ([]domain.TurnVerdict, error) {
+	ret := _m.Called(ctx, id, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVerdicts")
+	}
+
+	var r0 []domain.TurnVerdict
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]domain.TurnVerdict, error)); ok {
+		return rf(ctx, id, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []domain.TurnVerdict); ok {
+		r0 = rf(ctx, id, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.TurnVerdict)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, id, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MatchUseCase_GetVerdicts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVerdicts'
+type MatchUseCase_GetVerdicts_Call struct {
+	*mock.Call
+}
+
+// GetVerdicts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - userID string
+func (_e *MatchUseCase_Expecter) GetVerdicts(ctx interface{}, id interface{}, userID interface{}) *MatchUseCase_GetVerdicts_Call {
+	return &MatchUseCase_GetVerdicts_Call{Call: _e.mock.On("GetVerdicts", ctx, id, userID)}
+}
+
+func (_c *MatchUseCase_GetVerdicts_Call) Run(run func(ctx context.Context, id string, userID string)) *MatchUseCase_GetVerdicts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MatchUseCase_GetVerdicts_Call) Return(_a0 []domain.TurnVerdict, _a1 error) *MatchUseCase_GetVerdicts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MatchUseCase_GetVerdicts_Call) RunAndReturn(run func(context.Context, string, string) ([]domain.TurnVerdict, error)) *MatchUseCase_GetVerdicts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetVerdictsByMatchID provides a mock function with given fields: ctx, matchID
+func (_m *MatchUseCase) GetVerdictsByMatchID(ctx context.Context, matchID string) ([]domain.TurnVerdict, error) {
+	ret := _m.Called(ctx, matchID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVerdictsByMatchID")
+	}
+
+	var r0 []domain.TurnVerdict
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]domain.TurnVerdict, error)); ok {
+		return rf(ctx, matchID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []domain.TurnVerdict); ok {
+		r0 = rf(ctx, matchID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.TurnVerdict)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, matchID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MatchUseCase_GetVerdictsByMatchID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVerdictsByMatchID'
+type MatchUseCase_GetVerdictsByMatchID_Call struct {
+	*mock.Call
+}
+
+// GetVerdictsByMatchID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - matchID string
+func (_e *MatchUseCase_Expecter) GetVerdictsByMatchID(ctx interface{}, matchID interface{}) *MatchUseCase_GetVerdictsByMatchID_Call {
+	return &MatchUseCase_GetVerdictsByMatchID_Call{Call: _e.mock.On("GetVerdictsByMatchID", ctx, matchID)}
+}
+
+func (_c *MatchUseCase_GetVerdictsByMatchID_Call) Run(run func(ctx context.Context, matchID string)) *MatchUseCase_GetVerdictsByMatchID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MatchUseCase_GetVerdictsByMatchID_Call) Return(_a0 []domain.TurnVerdict, _a1 error) *MatchUseCase_GetVerdictsByMatchID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MatchUseCase_GetVerdictsByMatchID_Call) RunAndReturn(run func(context.Context, string) ([]domain.TurnVerdict, error)) *MatchUseCase_GetVerdictsByMatchID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Resign provides a mock function with given fields: ctx, id, userID
 func (_m *MatchUseCase) Resign(ctx context.Context, id string, userID string) error {
 	ret := _m.Called(ctx, id, userID)
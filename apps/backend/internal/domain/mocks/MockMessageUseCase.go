@@ -83,6 +83,68 @@ func (_c *MessageUseCase_Create_Call) RunAndReturn(run func(context.Context, str
 	return _c
 }
 
+// CreateStream provides a mock function with given fields: ctx, matchID, userID, req, onDelta
+func (_m *MessageUseCase) CreateStream(ctx context.Context, matchID string, userID string, req *domain.CreateMessageRequest, onDelta func(string) error) (*domain.TurnResult, error) {
+	ret := _m.Called(ctx, matchID, userID, req, onDelta)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateStream")
+	}
+
+	var r0 *domain.TurnResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *domain.CreateMessageRequest, func(string) error) (*domain.TurnResult, error)); ok {
+		return rf(ctx, matchID, userID, req, onDelta)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *domain.CreateMessageRequest, func(string) error) *domain.TurnResult); ok {
+		r0 = rf(ctx, matchID, userID, req, onDelta)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.TurnResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *domain.CreateMessageRequest, func(string) error) error); ok {
+		r1 = rf(ctx, matchID, userID, req, onDelta)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MessageUseCase_CreateStream_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateStream'
+type MessageUseCase_CreateStream_Call struct {
+	*mock.Call
+}
+
+// CreateStream is a helper method to define mock.On call
+//   - ctx context.Context
+//   - matchID string
+//   - userID string
+//   - req *domain.CreateMessageRequest
+//   - onDelta func(string) error
+func (_e *MessageUseCase_Expecter) CreateStream(ctx interface{}, matchID interface{}, userID interface{}, req interface{}, onDelta interface{}) *MessageUseCase_CreateStream_Call {
+	return &MessageUseCase_CreateStream_Call{Call: _e.mock.On("CreateStream", ctx, matchID, userID, req, onDelta)}
+}
+
+func (_c *MessageUseCase_CreateStream_Call) Run(run func(ctx context.Context, matchID string, userID string, req *domain.CreateMessageRequest, onDelta func(string) error)) *MessageUseCase_CreateStream_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(*domain.CreateMessageRequest), args[4].(func(string) error))
+	})
+	return _c
+}
+
+func (_c *MessageUseCase_CreateStream_Call) Return(_a0 *domain.TurnResult, _a1 error) *MessageUseCase_CreateStream_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MessageUseCase_CreateStream_Call) RunAndReturn(run func(context.Context, string, string, *domain.CreateMessageRequest, func(string) error) (*domain.TurnResult, error)) *MessageUseCase_CreateStream_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Delete provides a mock function with given fields: ctx, id
 func (_m *MessageUseCase) Delete(ctx context.Context, id string) error {
 	ret := _m.Called(ctx, id)
@@ -249,6 +311,66 @@ func (_c *MessageUseCase_GetByMatchID_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// Retry provides a mock function with given fields: ctx, matchID, userID
+func (_m *MessageUseCase) Retry(ctx context.Context, matchID string, userID string) (*domain.TurnResult, error) {
+	ret := _m.Called(ctx, matchID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Retry")
+	}
+
+	var r0 *domain.TurnResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*domain.TurnResult, error)); ok {
+		return rf(ctx, matchID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *domain.TurnResult); ok {
+		r0 = rf(ctx, matchID, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.TurnResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, matchID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MessageUseCase_Retry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Retry'
+type MessageUseCase_Retry_Call struct {
+	*mock.Call
+}
+
+// Retry is a helper method to define mock.On call
+//   - ctx context.Context
+//   - matchID string
+//   - userID string
+func (_e *MessageUseCase_Expecter) Retry(ctx interface{}, matchID interface{}, userID interface{}) *MessageUseCase_Retry_Call {
+	return &MessageUseCase_Retry_Call{Call: _e.mock.On("Retry", ctx, matchID, userID)}
+}
+
+func (_c *MessageUseCase_Retry_Call) Run(run func(ctx context.Context, matchID string, userID string)) *MessageUseCase_Retry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MessageUseCase_Retry_Call) Return(_a0 *domain.TurnResult, _a1 error) *MessageUseCase_Retry_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MessageUseCase_Retry_Call) RunAndReturn(run func(context.Context, string, string) (*domain.TurnResult, error)) *MessageUseCase_Retry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMessageUseCase creates a new instance of MessageUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMessageUseCase(t interface {
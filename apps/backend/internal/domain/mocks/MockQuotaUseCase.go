@@ -0,0 +1,143 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/everyday-studio/ollm/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// QuotaUseCase is an autogenerated mock type for the QuotaUseCase type
+type QuotaUseCase struct {
+	mock.Mock
+}
+
+type QuotaUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *QuotaUseCase) EXPECT() *QuotaUseCase_Expecter {
+	return &QuotaUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Check provides a mock function with given fields: ctx, userID
+func (_m *QuotaUseCase) Check(ctx context.Context, userID string) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Check")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// QuotaUseCase_Check_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Check'
+type QuotaUseCase_Check_Call struct {
+	*mock.Call
+}
+
+// Check is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *QuotaUseCase_Expecter) Check(ctx interface{}, userID interface{}) *QuotaUseCase_Check_Call {
+	return &QuotaUseCase_Check_Call{Call: _e.mock.On("Check", ctx, userID)}
+}
+
+func (_c *QuotaUseCase_Check_Call) Run(run func(ctx context.Context, userID string)) *QuotaUseCase_Check_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *QuotaUseCase_Check_Call) Return(_a0 error) *QuotaUseCase_Check_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *QuotaUseCase_Check_Call) RunAndReturn(run func(context.Context, string) error) *QuotaUseCase_Check_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, userID
+func (_m *QuotaUseCase) Get(ctx context.Context, userID string) (*domain.Quota, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *domain.Quota
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*domain.Quota, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.Quota); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Quota)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// QuotaUseCase_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type QuotaUseCase_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *QuotaUseCase_Expecter) Get(ctx interface{}, userID interface{}) *QuotaUseCase_Get_Call {
+	return &QuotaUseCase_Get_Call{Call: _e.mock.On("Get", ctx, userID)}
+}
+
+func (_c *QuotaUseCase_Get_Call) Run(run func(ctx context.Context, userID string)) *QuotaUseCase_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *QuotaUseCase_Get_Call) Return(_a0 *domain.Quota, _a1 error) *QuotaUseCase_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *QuotaUseCase_Get_Call) RunAndReturn(run func(context.Context, string) (*domain.Quota, error)) *QuotaUseCase_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewQuotaUseCase creates a new instance of QuotaUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewQuotaUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *QuotaUseCase {
+	mock := &QuotaUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// QuotaUsage is the LLM usage of a user since the start of the day.
+type QuotaUsage struct {
+	Tokens int // chat and judge tokens saved with the turns of the user's matches
+	Turns  int // messages sent by the user
+}
+
+// Quota is the daily LLM budget of a user and what remains of it.
+// A zero limit is unlimited and its remaining value is null.
+type Quota struct {
+	DailyTokens     int       `json:"daily_tokens"`
+	DailyTurns      int       `json:"daily_turns"`
+	UsedTokens      int       `json:"used_tokens"`
+	UsedTurns       int       `json:"used_turns"`
+	RemainingTokens *int      `json:"remaining_tokens"`
+	RemainingTurns  *int      `json:"remaining_turns"`
+	ResetsAt        time.Time `json:"resets_at"`
+}
+
+// QuotaRepository defines the interface for reading LLM usage against quotas
+type QuotaRepository interface {
+	GetUserUsage(ctx context.Context, userID string, since time.Time) (*QuotaUsage, error)
+	// GetSpendSince returns the USD cost of every LLM call made on the platform since the given time.
+	GetSpendSince(ctx context.Context, since time.Time) (float64, error)
+}
+
+// QuotaUseCase defines the interface for enforcing daily LLM budgets
+type QuotaUseCase interface {
+	// Get returns the daily quota of the user.
+	Get(ctx context.Context, userID string) (*Quota, error)
+	// Check returns ErrQuotaExceeded when the user's daily quota or the platform's daily spend cap is exhausted.
+	Check(ctx context.Context, userID string) error
+}
@@ -0,0 +1,10 @@
+package domain
+
+import "context"
+
+// TxManager runs several repository calls as one unit of work
+type TxManager interface {
+	// WithinTx runs fn in a transaction that commits when fn returns nil and rolls back otherwise.
+	// Repository calls made with the ctx passed to fn join the transaction; a nested call joins the outer one.
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
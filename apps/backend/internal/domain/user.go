@@ -2,6 +2,7 @@ package domain
 
 import (
 	"context"
+	"strings"
 	"time"
 )
 
@@ -15,18 +16,29 @@ const (
 )
 
 type User struct {
-	ID        string    `json:"id"`
-	Name      string    `json:"name"`
-	Tag       string    `json:"tag"`
-	Email     string    `json:"email"`
-	Password  string    `json:"-"`
-	GoogleID  string    `json:"-"`
+	ID        string     `json:"id"`
+	Name      string     `json:"name"`
+	Tag       string     `json:"tag"`
+	Email     string     `json:"email"`
+	Password  string     `json:"-"`
+	GoogleID  string     `json:"-"`
 	Role      Role       `json:"role"`
 	CreatedAt time.Time  `json:"created_at"`
 	UpdatedAt time.Time  `json:"updated_at"`
 	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 }
 
+// Guest accounts created by POST /api/auth/guest get a generated email of the form guest_<ulid>@ollm.xyz.
+const (
+	GuestEmailPrefix = "guest_"
+	GuestEmailDomain = "@ollm.xyz"
+)
+
+// IsGuest reports whether the user was created by a guest login rather than a sign-up.
+func (u *User) IsGuest() bool {
+	return strings.HasPrefix(u.Email, GuestEmailPrefix) && strings.HasSuffix(u.Email, GuestEmailDomain)
+}
+
 type UpdateNicknameRequest struct {
 	Name string `json:"name"`
 }
@@ -2,13 +2,16 @@ package handler
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 
 	"github.com/a-h/templ"
 	"github.com/labstack/echo/v4"
 
 	"strconv"
+	"strings"
 
 	"github.com/everyday-studio/ollm/internal/config"
 	"github.com/everyday-studio/ollm/internal/domain"
@@ -24,20 +27,26 @@ func Render(c echo.Context, statusCode int, t templ.Component) error {
 }
 
 type AdminHandler struct {
-	userUseCase  domain.UserUseCase
-	gameUseCase  domain.GameUseCase
-	matchUseCase domain.MatchUseCase
-	authUseCase  domain.AuthUsecase
-	config       *config.Config
+	userUseCase    domain.UserUseCase
+	gameUseCase    domain.GameUseCase
+	matchUseCase   domain.MatchUseCase
+	authUseCase    domain.AuthUsecase
+	llmCallUseCase domain.LLMCallUseCase
+	calibrationUC  domain.CalibrationUseCase
+	appealUseCase  domain.AppealUseCase
+	config         *config.Config
 }
 
-func NewAdminHandler(e *echo.Echo, userUseCase domain.UserUseCase, gameUseCase domain.GameUseCase, matchUseCase domain.MatchUseCase, authUseCase domain.AuthUsecase, cfg *config.Config) *AdminHandler {
+func NewAdminHandler(e *echo.Echo, userUseCase domain.UserUseCase, gameUseCase domain.GameUseCase, matchUseCase domain.MatchUseCase, authUseCase domain.AuthUsecase, llmCallUseCase domain.LLMCallUseCase, calibrationUC domain.CalibrationUseCase, appealUseCase domain.AppealUseCase, cfg *config.Config) *AdminHandler {
 	handler := &AdminHandler{
-		userUseCase:  userUseCase,
-		gameUseCase:  gameUseCase,
-		matchUseCase: matchUseCase,
-		authUseCase:  authUseCase,
-		config:       cfg,
+		userUseCase:    userUseCase,
+		gameUseCase:    gameUseCase,
+		matchUseCase:   matchUseCase,
+		authUseCase:    authUseCase,
+		llmCallUseCase: llmCallUseCase,
+		calibrationUC:  calibrationUC,
+		appealUseCase:  appealUseCase,
+		config:         cfg,
 	}
 
 	adminPath := cfg.App.AdminPath
@@ -60,6 +69,19 @@ func NewAdminHandler(e *echo.Echo, userUseCase domain.UserUseCase, gameUseCase d
 	adminGroup.GET("/games/:id/edit", handler.GameEditForm)
 	adminGroup.PUT("/games/:id", handler.UpdateGame)
 	adminGroup.PATCH("/games/:id/visibility", handler.ToggleGameVisibility)
+	adminGroup.GET("/games/:id/calibration", handler.Calibration)
+	adminGroup.POST("/games/:id/calibration/examples", handler.CreateCalibrationExample)
+	adminGroup.DELETE("/games/:id/calibration/examples/:exampleId", handler.DeleteCalibrationExample)
+	adminGroup.POST("/games/:id/calibration/runs", handler.RunCalibration)
+
+	adminGroup.GET("/appeals", handler.Appeals)
+	adminGroup.GET("/appeals/:id", handler.AppealReview)
+	adminGroup.POST("/appeals/:id/rejudge", handler.RejudgeAppeal)
+	adminGroup.POST("/appeals/:id/status", handler.SetAppealStatus)
+	adminGroup.POST("/appeals/:id/uphold", handler.UpholdAppeal)
+
+	adminGroup.GET("/llm-calls", handler.LLMCalls)
+	adminGroup.GET("/spend", handler.Spend)
 
 	return handler
 }
@@ -78,14 +100,26 @@ func (h *AdminHandler) GameCreateForm(c echo.Context) error {
 
 func (h *AdminHandler) CreateGame(c echo.Context) error {
 	type createGameRequest struct {
-		Title          string `json:"title"`
-		Description    string `json:"description"`
-		AuthorID       string `json:"author_id"`
-		SystemPrompt   string `json:"system_prompt"`
-		FirstMessage   string `json:"first_message"`
-		JudgeType      string `json:"judge_type"`
-		JudgeCondition string `json:"judge_condition"`
-		MaxTurns       string `json:"max_turns"`
+		Title           string `json:"title"`
+		Description     string `json:"description"`
+		AuthorID        string `json:"author_id"`
+		SystemPrompt    string `json:"system_prompt"`
+		FirstMessage    string `json:"first_message"`
+		JudgeType       string `json:"judge_type"`
+		JudgeCondition  string `json:"judge_condition"`
+		MaxTurns        string `json:"max_turns"`
+		ChatModel       string `json:"chat_model"`
+		JudgeModel      string `json:"judge_model"`
+		JudgePanel      string `json:"judge_panel"`
+		JudgePolicy     string `json:"judge_policy"`
+		JudgeQuorum     string `json:"judge_quorum"`
+		JudgeStrictness string `json:"judge_strictness"`
+		JudgeScope      string `json:"judge_scope"`
+		JudgeScopeTurns string `json:"judge_scope_turns"`
+		Temperature     string `json:"temperature"`
+		MaxTokens       string `json:"max_tokens"`
+		Secrets         string `json:"secrets"`
+		IdleTTLMinutes  string `json:"idle_ttl_minutes"`
 	}
 
 	req := new(createGameRequest)
@@ -98,20 +132,61 @@ func (h *AdminHandler) CreateGame(c echo.Context) error {
 		maxTurns = 10
 	}
 
+	temperature, maxTokens, err := parseModelSettings(req.Temperature, req.MaxTokens)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+	}
+
+	judgeQuorum, err := parseOptionalInt(req.JudgeQuorum)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+	}
+	judgePanel := parseModelList(req.JudgePanel)
+	judgePolicy := domain.JudgePolicy(req.JudgePolicy)
+	judgeStrictness := domain.JudgeStrictness(req.JudgeStrictness)
+	judgeScope := domain.JudgeScope(req.JudgeScope)
+	judgeScopeTurns, err := parseOptionalInt(req.JudgeScopeTurns)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+	}
+	secrets, err := parseSecrets(req.Secrets)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ErrResponse(err))
+	}
+	idleTTLMinutes, err := parseOptionalInt(req.IdleTTLMinutes)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+	}
+
 	domainReq := &domain.CreateGameRequest{
-		Title:          req.Title,
-		Description:    req.Description,
-		AuthorID:       req.AuthorID,
-		SystemPrompt:   req.SystemPrompt,
-		FirstMessage:   req.FirstMessage,
-		JudgeType:      domain.JudgeType(req.JudgeType),
-		JudgeCondition: req.JudgeCondition,
-		MaxTurns:       maxTurns,
+		Title:           req.Title,
+		Description:     req.Description,
+		AuthorID:        req.AuthorID,
+		SystemPrompt:    req.SystemPrompt,
+		FirstMessage:    req.FirstMessage,
+		JudgeType:       domain.JudgeType(req.JudgeType),
+		JudgeCondition:  req.JudgeCondition,
+		MaxTurns:        maxTurns,
+		ChatModel:       req.ChatModel,
+		JudgeModel:      req.JudgeModel,
+		JudgePanel:      judgePanel,
+		JudgePolicy:     judgePolicy,
+		JudgeQuorum:     judgeQuorum,
+		JudgeStrictness: judgeStrictness,
+		JudgeScope:      judgeScope,
+		JudgeScopeTurns: judgeScopeTurns,
+		Temperature:     temperature,
+		MaxTokens:       maxTokens,
+		Secrets:         secrets,
+		IdleTTLMinutes:  idleTTLMinutes,
 	}
 
 	ctx := c.Request().Context()
 	_, err = h.gameUseCase.Create(ctx, domainReq)
 	if err != nil {
+		if errors.Is(err, domain.ErrInvalidInput) {
+			return c.JSON(http.StatusBadRequest, ErrResponse(err))
+		}
 		return c.JSON(http.StatusInternalServerError, ErrResponse(domain.ErrInternal))
 	}
 
@@ -146,13 +221,25 @@ func (h *AdminHandler) UpdateGame(c echo.Context) error {
 	id := c.Param("id")
 
 	type updateGameRequest struct {
-		Title          string `json:"title"`
-		Description    string `json:"description"`
-		SystemPrompt   string `json:"system_prompt"`
-		FirstMessage   string `json:"first_message"`
-		JudgeType      string `json:"judge_type"`
-		JudgeCondition string `json:"judge_condition"`
-		MaxTurns       string `json:"max_turns"`
+		Title           string `json:"title"`
+		Description     string `json:"description"`
+		SystemPrompt    string `json:"system_prompt"`
+		FirstMessage    string `json:"first_message"`
+		JudgeType       string `json:"judge_type"`
+		JudgeCondition  string `json:"judge_condition"`
+		MaxTurns        string `json:"max_turns"`
+		ChatModel       string `json:"chat_model"`
+		JudgeModel      string `json:"judge_model"`
+		JudgePanel      string `json:"judge_panel"`
+		JudgePolicy     string `json:"judge_policy"`
+		JudgeQuorum     string `json:"judge_quorum"`
+		JudgeStrictness string `json:"judge_strictness"`
+		JudgeScope      string `json:"judge_scope"`
+		JudgeScopeTurns string `json:"judge_scope_turns"`
+		Temperature     string `json:"temperature"`
+		MaxTokens       string `json:"max_tokens"`
+		Secrets         string `json:"secrets"`
+		IdleTTLMinutes  string `json:"idle_ttl_minutes"`
 	}
 
 	req := new(updateGameRequest)
@@ -170,21 +257,63 @@ func (h *AdminHandler) UpdateGame(c echo.Context) error {
 		maxTurns = 10
 	}
 
+	temperature, maxTokens, err := parseModelSettings(req.Temperature, req.MaxTokens)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+	}
+
+	judgeQuorum, err := parseOptionalInt(req.JudgeQuorum)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+	}
+	judgePanel := parseModelList(req.JudgePanel)
+	judgePolicy := domain.JudgePolicy(req.JudgePolicy)
+	judgeStrictness := domain.JudgeStrictness(req.JudgeStrictness)
+	judgeScope := domain.JudgeScope(req.JudgeScope)
+	judgeScopeTurns, err := parseOptionalInt(req.JudgeScopeTurns)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+	}
+	secrets, err := parseSecrets(req.Secrets)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ErrResponse(err))
+	}
+	idleTTLMinutes, err := parseOptionalInt(req.IdleTTLMinutes)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+	}
+
 	judgeType := domain.JudgeType(req.JudgeType)
 
 	domainReq := &domain.UpdateGameRequest{
-		Title:          &req.Title,
-		Description:    &req.Description,
-		SystemPrompt:   &req.SystemPrompt,
-		FirstMessage:   &req.FirstMessage,
-		JudgeType:      &judgeType,
-		JudgeCondition: &req.JudgeCondition,
-		MaxTurns:       &maxTurns,
+		Title:            &req.Title,
+		Description:      &req.Description,
+		SystemPrompt:     &req.SystemPrompt,
+		FirstMessage:     &req.FirstMessage,
+		JudgeType:        &judgeType,
+		JudgeCondition:   &req.JudgeCondition,
+		MaxTurns:         &maxTurns,
+		ChatModel:        &req.ChatModel,
+		JudgeModel:       &req.JudgeModel,
+		JudgePanel:       &judgePanel,
+		JudgePolicy:      &judgePolicy,
+		JudgeQuorum:      &judgeQuorum,
+		JudgeStrictness:  &judgeStrictness,
+		JudgeScope:       &judgeScope,
+		JudgeScopeTurns:  &judgeScopeTurns,
+		Temperature:      temperature,
+		ClearTemperature: temperature == nil,
+		MaxTokens:        &maxTokens,
+		Secrets:          &secrets,
+		IdleTTLMinutes:   &idleTTLMinutes,
 	}
 
 	ctx := c.Request().Context()
 	_, err = h.gameUseCase.Update(ctx, id, domainReq)
 	if err != nil {
+		if errors.Is(err, domain.ErrInvalidInput) {
+			return c.JSON(http.StatusBadRequest, ErrResponse(err))
+		}
 		return c.JSON(http.StatusInternalServerError, ErrResponse(domain.ErrInternal))
 	}
 
@@ -368,6 +497,261 @@ func (h *AdminHandler) Games(c echo.Context) error {
 	return Render(c, http.StatusOK, admin.GamesPage(data, adminPath, bucketName))
 }
 
+// LLMCalls shows the judge verdicts and the LLM call audit log of the match given by the match_id query parameter.
+func (h *AdminHandler) LLMCalls(c echo.Context) error {
+	matchID := strings.TrimSpace(c.QueryParam("match_id"))
+
+	calls := []domain.LLMCall{}
+	verdicts := []domain.TurnVerdict{}
+	if matchID != "" {
+		var err error
+		calls, err = h.llmCallUseCase.GetByMatchID(c.Request().Context(), matchID)
+		if err != nil {
+			return c.String(http.StatusInternalServerError, "Failed to load llm calls")
+		}
+		verdicts, err = h.matchUseCase.GetVerdictsByMatchID(c.Request().Context(), matchID)
+		if err != nil {
+			return c.String(http.StatusInternalServerError, "Failed to load verdicts")
+		}
+	}
+
+	adminPath := h.config.App.AdminPath
+	if adminPath == "" {
+		adminPath = "/admin"
+	}
+
+	return Render(c, http.StatusOK, admin.LLMCallsPage(matchID, calls, verdicts, adminPath))
+}
+
+// Spend shows the LLM spend of the last days (query parameter, default 30) per day, user and game.
+func (h *AdminHandler) Spend(c echo.Context) error {
+	days, _ := strconv.Atoi(c.QueryParam("days"))
+	if days < 1 {
+		days = 30
+	}
+
+	report, err := h.llmCallUseCase.GetSpendReport(c.Request().Context(), days)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidInput) {
+			return c.String(http.StatusBadRequest, "Invalid days")
+		}
+		return c.String(http.StatusInternalServerError, "Failed to load spend")
+	}
+
+	adminPath := h.config.App.AdminPath
+	if adminPath == "" {
+		adminPath = "/admin"
+	}
+
+	return Render(c, http.StatusOK, admin.SpendPage(report, days, adminPath))
+}
+
+// Calibration shows the labeled examples of a game and the latest runs of its judge against them.
+func (h *AdminHandler) Calibration(c echo.Context) error {
+	adminPath := h.config.App.AdminPath
+	if adminPath == "" {
+		adminPath = "/admin"
+	}
+
+	id := c.Param("id")
+	ctx := c.Request().Context()
+	game, err := h.gameUseCase.GetByID(ctx, id)
+	if err != nil {
+		return c.Redirect(http.StatusFound, adminPath+"/games")
+	}
+
+	examples, err := h.calibrationUC.GetExamples(ctx, id)
+	if err != nil {
+		return c.String(http.StatusInternalServerError, "Failed to load calibration examples")
+	}
+	runs, err := h.calibrationUC.GetRuns(ctx, id)
+	if err != nil {
+		return c.String(http.StatusInternalServerError, "Failed to load calibration runs")
+	}
+
+	return Render(c, http.StatusOK, admin.CalibrationPage(adminPath, *game, examples, runs))
+}
+
+func (h *AdminHandler) CreateCalibrationExample(c echo.Context) error {
+	id := c.Param("id")
+
+	req := new(domain.CreateCalibrationExampleRequest)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+	}
+
+	ctx := c.Request().Context()
+	if _, err := h.calibrationUC.CreateExample(ctx, id, req); err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidInput):
+			return c.JSON(http.StatusBadRequest, ErrResponse(err))
+		case errors.Is(err, domain.ErrNotFound):
+			return c.JSON(http.StatusNotFound, ErrResponse(domain.ErrNotFound))
+		default:
+			return c.JSON(http.StatusInternalServerError, ErrResponse(domain.ErrInternal))
+		}
+	}
+
+	c.Response().Header().Set("HX-Redirect", h.calibrationPath(id))
+	return c.NoContent(http.StatusCreated)
+}
+
+func (h *AdminHandler) DeleteCalibrationExample(c echo.Context) error {
+	id := c.Param("id")
+
+	ctx := c.Request().Context()
+	if err := h.calibrationUC.DeleteExample(ctx, id, c.Param("exampleId")); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return c.JSON(http.StatusNotFound, ErrResponse(domain.ErrNotFound))
+		}
+		return c.JSON(http.StatusInternalServerError, ErrResponse(domain.ErrInternal))
+	}
+
+	c.Response().Header().Set("HX-Redirect", h.calibrationPath(id))
+	return c.NoContent(http.StatusOK)
+}
+
+// RunCalibration runs the calibration examples of a game against its judge.
+// Non-empty judge fields of the form are tried in place of the game's own for this run only.
+func (h *AdminHandler) RunCalibration(c echo.Context) error {
+	id := c.Param("id")
+
+	type runCalibrationRequest struct {
+		JudgeType       string `json:"judge_type"`
+		JudgeCondition  string `json:"judge_condition"`
+		JudgeModel      string `json:"judge_model"`
+		JudgePanel      string `json:"judge_panel"`
+		JudgeStrictness string `json:"judge_strictness"`
+	}
+
+	req := new(runCalibrationRequest)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+	}
+
+	domainReq := &domain.RunCalibrationRequest{}
+	if req.JudgeType != "" {
+		judgeType := domain.JudgeType(req.JudgeType)
+		domainReq.JudgeType = &judgeType
+	}
+	if strings.TrimSpace(req.JudgeCondition) != "" {
+		domainReq.JudgeCondition = &req.JudgeCondition
+	}
+	if req.JudgeModel != "" {
+		domainReq.JudgeModel = &req.JudgeModel
+	}
+	if strings.TrimSpace(req.JudgePanel) != "" {
+		judgePanel := parseModelList(req.JudgePanel)
+		domainReq.JudgePanel = &judgePanel
+	}
+	if req.JudgeStrictness != "" {
+		judgeStrictness := domain.JudgeStrictness(req.JudgeStrictness)
+		domainReq.JudgeStrictness = &judgeStrictness
+	}
+
+	ctx := c.Request().Context()
+	if _, err := h.calibrationUC.Run(ctx, id, domainReq); err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidInput):
+			return c.JSON(http.StatusBadRequest, ErrResponse(err))
+		case errors.Is(err, domain.ErrNotFound):
+			return c.JSON(http.StatusNotFound, ErrResponse(domain.ErrNotFound))
+		default:
+			return c.JSON(http.StatusInternalServerError, ErrResponse(domain.ErrInternal))
+		}
+	}
+
+	c.Response().Header().Set("HX-Redirect", h.calibrationPath(id))
+	return c.NoContent(http.StatusCreated)
+}
+
+// calibrationPath returns the admin calibration page of a game
+func (h *AdminHandler) calibrationPath(gameID string) string {
+	adminPath := h.config.App.AdminPath
+	if adminPath == "" {
+		adminPath = "/admin"
+	}
+	return adminPath + "/games/" + gameID + "/calibration"
+}
+
+// Appeals shows the queue of appeals waiting for a decision.
+func (h *AdminHandler) Appeals(c echo.Context) error {
+	appeals, err := h.appealUseCase.GetPending(c.Request().Context())
+	if err != nil {
+		return c.String(http.StatusInternalServerError, "Failed to load appeals")
+	}
+
+	adminPath := h.config.App.AdminPath
+	if adminPath == "" {
+		adminPath = "/admin"
+	}
+
+	return Render(c, http.StatusOK, admin.AppealsPage(appeals, adminPath))
+}
+
+// AppealReview shows an appeal with the transcript and verdicts of its match.
+func (h *AdminHandler) AppealReview(c echo.Context) error {
+	adminPath := h.config.App.AdminPath
+	if adminPath == "" {
+		adminPath = "/admin"
+	}
+
+	review, err := h.appealUseCase.GetReview(c.Request().Context(), c.Param("id"))
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return c.Redirect(http.StatusFound, adminPath+"/appeals")
+		}
+		return c.String(http.StatusInternalServerError, "Failed to load appeal")
+	}
+
+	return Render(c, http.StatusOK, admin.AppealReviewPage(*review, adminPath))
+}
+
+func (h *AdminHandler) RejudgeAppeal(c echo.Context) error {
+	return h.resolveAppeal(c, h.appealUseCase.Rejudge)
+}
+
+func (h *AdminHandler) SetAppealStatus(c echo.Context) error {
+	return h.resolveAppeal(c, h.appealUseCase.SetStatus)
+}
+
+func (h *AdminHandler) UpholdAppeal(c echo.Context) error {
+	return h.resolveAppeal(c, h.appealUseCase.Uphold)
+}
+
+// resolveAppeal applies an admin decision on the appeal and returns to its review page
+func (h *AdminHandler) resolveAppeal(c echo.Context, decide func(ctx context.Context, id string, adminID string, req *domain.ResolveAppealRequest) (*domain.MatchOverride, error)) error {
+	id := c.Param("id")
+
+	req := new(domain.ResolveAppealRequest)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+	}
+
+	adminID, _ := c.Get("user_id").(string)
+
+	if _, err := decide(c.Request().Context(), id, adminID, req); err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidInput):
+			return c.JSON(http.StatusBadRequest, ErrResponse(err))
+		case errors.Is(err, domain.ErrNotFound):
+			return c.JSON(http.StatusNotFound, ErrResponse(domain.ErrNotFound))
+		case errors.Is(err, domain.ErrConflict):
+			return c.JSON(http.StatusConflict, ErrResponse(err))
+		default:
+			return c.JSON(http.StatusInternalServerError, ErrResponse(domain.ErrInternal))
+		}
+	}
+
+	adminPath := h.config.App.AdminPath
+	if adminPath == "" {
+		adminPath = "/admin"
+	}
+
+	c.Response().Header().Set("HX-Redirect", adminPath+"/appeals/"+id)
+	return c.NoContent(http.StatusOK)
+}
+
 func (h *AdminHandler) ToggleGameVisibility(c echo.Context) error {
 	id := c.Param("id")
 	if id == "" {
@@ -403,3 +787,55 @@ func (h *AdminHandler) ToggleGameVisibility(c echo.Context) error {
 
 	return c.Redirect(http.StatusFound, adminPath+"/games")
 }
+
+// parseModelSettings parses the optional temperature and max tokens form fields; empty keeps the platform default
+func parseModelSettings(temperature, maxTokens string) (*float64, int, error) {
+	var t *float64
+	var m int
+	var err error
+
+	if temperature != "" {
+		value, err := strconv.ParseFloat(temperature, 64)
+		if err != nil {
+			return nil, 0, err
+		}
+		t = &value
+	}
+	if maxTokens != "" {
+		if m, err = strconv.Atoi(maxTokens); err != nil {
+			return nil, 0, err
+		}
+	}
+	return t, m, nil
+}
+
+// parseOptionalInt parses an optional integer form field; empty is zero
+func parseOptionalInt(value string) (int, error) {
+	if value == "" {
+		return 0, nil
+	}
+	return strconv.Atoi(value)
+}
+
+// parseSecrets parses the JSON of a game's secret generators; empty is none
+func parseSecrets(value string) (map[string]domain.SecretGenerator, error) {
+	if strings.TrimSpace(value) == "" {
+		return nil, nil
+	}
+	var secrets map[string]domain.SecretGenerator
+	if err := json.Unmarshal([]byte(value), &secrets); err != nil {
+		return nil, fmt.Errorf("%w: secrets must be a JSON object of generators", domain.ErrInvalidInput)
+	}
+	return secrets, nil
+}
+
+// parseModelList parses a comma-separated list of model names, skipping empty entries
+func parseModelList(value string) []string {
+	models := []string{}
+	for _, model := range strings.Split(value, ",") {
+		if model = strings.TrimSpace(model); model != "" {
+			models = append(models, model)
+		}
+	}
+	return models
+}
@@ -0,0 +1,95 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/everyday-studio/ollm/internal/domain"
+	"github.com/everyday-studio/ollm/internal/middleware"
+)
+
+// AppealHandler handles HTTP requests for players' appeals of judge verdicts
+type AppealHandler struct {
+	appealUseCase domain.AppealUseCase
+}
+
+// NewAppealHandler creates a new appeal handler and registers routes
+func NewAppealHandler(e *echo.Echo, appealUseCase domain.AppealUseCase) *AppealHandler {
+	handler := &AppealHandler{
+		appealUseCase: appealUseCase,
+	}
+
+	// User routes
+	userGroup := e.Group("/api/matches", middleware.AllowRoles(domain.RoleUser))
+	userGroup.POST("/:id/appeals", handler.Create)
+	userGroup.GET("/:id/appeals", handler.GetByMatchID)
+
+	return handler
+}
+
+// Create handles POST /matches/:id/appeals - disputes the verdict of a turn of a finished match
+func (h *AppealHandler) Create(c echo.Context) error {
+	id := c.Param("id")
+	if id == "" {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+	}
+
+	req := new(domain.CreateAppealRequest)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+	}
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, ErrResponse(domain.ErrUnauthorized))
+	}
+
+	ctx := c.Request().Context()
+	appeal, err := h.appealUseCase.Create(ctx, id, userID, req)
+	if err == nil {
+		return c.JSON(http.StatusCreated, appeal)
+	}
+
+	switch {
+	case errors.Is(err, domain.ErrInvalidInput):
+		return c.JSON(http.StatusBadRequest, ErrResponse(err))
+	case errors.Is(err, domain.ErrNotFound):
+		return c.JSON(http.StatusNotFound, ErrResponse(domain.ErrNotFound))
+	case errors.Is(err, domain.ErrForbidden):
+		return c.JSON(http.StatusForbidden, ErrResponse(domain.ErrForbidden))
+	case errors.Is(err, domain.ErrConflict):
+		return c.JSON(http.StatusConflict, ErrResponse(err))
+	default:
+		return c.JSON(http.StatusInternalServerError, ErrResponse(domain.ErrInternal))
+	}
+}
+
+// GetByMatchID handles GET /matches/:id/appeals - retrieves the appeals of a match with their resolutions
+func (h *AppealHandler) GetByMatchID(c echo.Context) error {
+	id := c.Param("id")
+	if id == "" {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+	}
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, ErrResponse(domain.ErrUnauthorized))
+	}
+
+	ctx := c.Request().Context()
+	appeals, err := h.appealUseCase.GetByMatchID(ctx, id, userID)
+	if err == nil {
+		return c.JSON(http.StatusOK, appeals)
+	}
+
+	switch {
+	case errors.Is(err, domain.ErrNotFound):
+		return c.JSON(http.StatusNotFound, ErrResponse(domain.ErrNotFound))
+	case errors.Is(err, domain.ErrForbidden):
+		return c.JSON(http.StatusForbidden, ErrResponse(domain.ErrForbidden))
+	default:
+		return c.JSON(http.StatusInternalServerError, ErrResponse(domain.ErrInternal))
+	}
+}
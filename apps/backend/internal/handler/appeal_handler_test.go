@@ -0,0 +1,90 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/everyday-studio/ollm/internal/domain"
+	"github.com/everyday-studio/ollm/internal/domain/mocks"
+)
+
+func TestAppealHandler_Create(t *testing.T) {
+	matchID := "01HQZYX3VQJQZ3Z0Z1ZMATCH01"
+	userID := "01HQZYX3VQJQZ3Z0Z1Z2ZUSER1"
+
+	tests := []struct {
+		name       string
+		body       string
+		mockReturn *domain.Appeal
+		mockError  error
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name: "Appeal a turn",
+			body: `{"turn_count":2,"comment":"The AI spelled the word out."}`,
+			mockReturn: &domain.Appeal{
+				ID:         "A1",
+				MatchID:    matchID,
+				UserID:     userID,
+				VerdictID:  "V2",
+				TurnCount:  2,
+				Comment:    "The AI spelled the word out.",
+				Status:     domain.AppealStatusPending,
+				ResolvedBy: "hidden",
+			},
+			wantStatus: http.StatusCreated,
+			wantBody:   `{"id":"A1","match_id":"01HQZYX3VQJQZ3Z0Z1ZMATCH01","user_id":"01HQZYX3VQJQZ3Z0Z1Z2ZUSER1","verdict_id":"V2","turn_count":2,"comment":"The AI spelled the word out.","status":"pending","created_at":"0001-01-01T00:00:00Z","updated_at":"0001-01-01T00:00:00Z"}`,
+		},
+		{
+			name:       "Fail while the match is in play",
+			body:       `{"turn_count":2,"comment":"Unfair."}`,
+			mockError:  fmt.Errorf("%w: verdicts can be appealed once the match is over", domain.ErrConflict),
+			wantStatus: http.StatusConflict,
+			wantBody:   fmt.Sprintf(`{"error":"%s: verdicts can be appealed once the match is over"}`, domain.ErrConflict.Error()),
+		},
+		{
+			name:       "Fail due to forbidden access (not owner)",
+			body:       `{"turn_count":2,"comment":"Unfair."}`,
+			mockError:  domain.ErrForbidden,
+			wantStatus: http.StatusForbidden,
+			wantBody:   fmt.Sprintf(`{"error":"%s"}`, domain.ErrForbidden.Error()),
+		},
+		{
+			name:       "Fail due to invalid JSON body",
+			body:       `invalid json`,
+			wantStatus: http.StatusBadRequest,
+			wantBody:   fmt.Sprintf(`{"error":"%s"}`, domain.ErrInvalidInput.Error()),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/matches/"+matchID+"/appeals", strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.Set("user_id", userID)
+			c.SetParamNames("id")
+			c.SetParamValues(matchID)
+
+			mockUseCase := new(mocks.AppealUseCase)
+			mockUseCase.On("Create", mock.Anything, matchID, userID, mock.AnythingOfType("*domain.CreateAppealRequest")).Return(tt.mockReturn, tt.mockError).Maybe()
+
+			h := NewAppealHandler(e, mockUseCase)
+			err := h.Create(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			assert.JSONEq(t, tt.wantBody, rec.Body.String())
+		})
+	}
+}
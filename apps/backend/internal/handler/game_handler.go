@@ -77,6 +77,7 @@ func (h *GameHandler) GetByID(c echo.Context) error {
 		// Hide sensitive information
 		game.SystemPrompt = ""
 		game.JudgeCondition = ""
+		game.Secrets = nil
 		return c.JSON(http.StatusOK, game)
 	}
 
@@ -126,6 +127,7 @@ func (h *GameHandler) GetAll(c echo.Context) error {
 		for i := range paginatedData.Data {
 			paginatedData.Data[i].SystemPrompt = ""
 			paginatedData.Data[i].JudgeCondition = ""
+			paginatedData.Data[i].Secrets = nil
 		}
 		return c.JSON(http.StatusOK, paginatedData)
 	}
@@ -0,0 +1,48 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/everyday-studio/ollm/internal/domain"
+)
+
+type HealthHandler struct {
+	llmRegistry domain.LLMRegistry
+}
+
+// HealthResponse reports the server status and the circuit breaker state of each LLM provider.
+// Status is "degraded" while any provider's circuit is not closed.
+type HealthResponse struct {
+	Status string                     `json:"status"`
+	LLM    []domain.LLMProviderHealth `json:"llm"`
+}
+
+// NewHealthHandler creates a new health handler and registers routes
+func NewHealthHandler(e *echo.Echo, llmRegistry domain.LLMRegistry) *HealthHandler {
+	handler := &HealthHandler{
+		llmRegistry: llmRegistry,
+	}
+
+	e.GET("/api/health", handler.GetHealth)
+
+	return handler
+}
+
+// GetHealth handles the request to check the server health
+func (h *HealthHandler) GetHealth(c echo.Context) error {
+	resp := HealthResponse{
+		Status: "ok",
+		LLM:    h.llmRegistry.Health(),
+	}
+
+	for _, provider := range resp.LLM {
+		if provider.State != domain.LLMCircuitClosed {
+			resp.Status = "degraded"
+			break
+		}
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
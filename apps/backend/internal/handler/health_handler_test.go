@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/everyday-studio/ollm/internal/domain"
+	"github.com/everyday-studio/ollm/internal/domain/mocks"
+)
+
+func TestHealthHandler_GetHealth(t *testing.T) {
+	tests := []struct {
+		name     string
+		health   []domain.LLMProviderHealth
+		wantBody string
+	}{
+		{
+			name: "All providers closed",
+			health: []domain.LLMProviderHealth{
+				{Provider: "openai", State: domain.LLMCircuitClosed},
+			},
+			wantBody: `{"status":"ok","llm":[{"provider":"openai","state":"closed","consecutive_failures":0}]}`,
+		},
+		{
+			name: "Open provider degrades status",
+			health: []domain.LLMProviderHealth{
+				{Provider: "groq", State: domain.LLMCircuitClosed},
+				{Provider: "openai", State: domain.LLMCircuitOpen, ConsecutiveFailures: 5},
+			},
+			wantBody: `{"status":"degraded","llm":[{"provider":"groq","state":"closed","consecutive_failures":0},{"provider":"openai","state":"open","consecutive_failures":5}]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			mockRegistry := new(mocks.LLMRegistry)
+			mockRegistry.On("Health").Return(tt.health)
+			h := NewHealthHandler(e, mockRegistry)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/health", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			err := h.GetHealth(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.JSONEq(t, tt.wantBody, rec.Body.String())
+		})
+	}
+}
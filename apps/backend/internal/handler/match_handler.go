@@ -27,6 +27,7 @@ func NewMatchHandler(e *echo.Echo, matchUseCase domain.MatchUseCase) *MatchHandl
 	userGroup.GET("/me", handler.GetMyMatches)
 	userGroup.GET("/:id", handler.GetByID)
 	userGroup.POST("/:id/resign", handler.Resign)
+	userGroup.GET("/:id/verdicts", handler.GetVerdicts)
 
 	return handler
 }
@@ -57,6 +58,8 @@ func (h *MatchHandler) Create(c echo.Context) error {
 		return c.JSON(http.StatusNotFound, ErrResponse(domain.ErrNotFound))
 	case errors.Is(err, domain.ErrConflict):
 		return c.JSON(http.StatusConflict, ErrResponse(domain.ErrConflict))
+	case errors.Is(err, domain.ErrQuotaExceeded):
+		return c.JSON(http.StatusTooManyRequests, ErrResponse(err))
 	default:
 		return c.JSON(http.StatusInternalServerError, ErrResponse(domain.ErrInternal))
 	}
@@ -153,3 +156,33 @@ func (h *MatchHandler) Resign(c echo.Context) error {
 		return c.JSON(http.StatusInternalServerError, ErrResponse(domain.ErrInternal))
 	}
 }
+
+// GetVerdicts handles GET /matches/:id/verdicts - retrieves the judge verdict of every turn once the match is over
+func (h *MatchHandler) GetVerdicts(c echo.Context) error {
+	id := c.Param("id")
+	if id == "" {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+	}
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, ErrResponse(domain.ErrUnauthorized))
+	}
+
+	ctx := c.Request().Context()
+	verdicts, err := h.matchUseCase.GetVerdicts(ctx, id, userID)
+	if err == nil {
+		return c.JSON(http.StatusOK, verdicts)
+	}
+
+	switch {
+	case errors.Is(err, domain.ErrNotFound):
+		return c.JSON(http.StatusNotFound, ErrResponse(domain.ErrNotFound))
+	case errors.Is(err, domain.ErrForbidden):
+		return c.JSON(http.StatusForbidden, ErrResponse(domain.ErrForbidden))
+	case errors.Is(err, domain.ErrConflict):
+		return c.JSON(http.StatusConflict, ErrResponse(err))
+	default:
+		return c.JSON(http.StatusInternalServerError, ErrResponse(domain.ErrInternal))
+	}
+}
@@ -69,6 +69,14 @@ func TestMatchHandler_Create(t *testing.T) {
 			wantStatus: http.StatusConflict,
 			wantBody:   fmt.Sprintf(`{"error":"%s"}`, domain.ErrConflict.Error()),
 		},
+		{
+			name:       "Fail due to exhausted quota",
+			body:       `{"game_id":"01HQZYX3VQJQZ3Z0Z1Z2ZGAME1"}`,
+			mockReturn: nil,
+			mockError:  fmt.Errorf("%w: the platform's daily LLM budget is used up, try again tomorrow", domain.ErrQuotaExceeded),
+			wantStatus: http.StatusTooManyRequests,
+			wantBody:   `{"error":"quota exceeded: the platform's daily LLM budget is used up, try again tomorrow"}`,
+		},
 		{
 			name:       "Fail due to internal error",
 			body:       `{"game_id":"01HQZYX3VQJQZ3Z0Z1Z2ZGAME1"}`,
@@ -348,3 +356,61 @@ func TestMatchHandler_Resign(t *testing.T) {
 		})
 	}
 }
+
+// --- GetVerdicts ---
+
+func TestMatchHandler_GetVerdicts(t *testing.T) {
+	matchID := "01HQZYX3VQJQZ3Z0Z1ZMATCH01"
+
+	tests := []struct {
+		name       string
+		mockReturn []domain.TurnVerdict
+		mockError  error
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name: "Get verdicts of a finished match",
+			mockReturn: []domain.TurnVerdict{
+				{ID: "V1", MatchID: matchID, MessageID: "M1", TurnCount: 1, JudgeType: domain.JudgeTypeLLMJudge, Outcome: domain.JudgeOutcomeContinue, Reason: "The AI refused.", Error: "hidden"},
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   `[{"id":"V1","match_id":"01HQZYX3VQJQZ3Z0Z1ZMATCH01","message_id":"M1","turn_count":1,"judge_type":"llm_judge","outcome":"continue","reason":"The AI refused.","prompt_tokens":0,"completion_tokens":0,"created_at":"0001-01-01T00:00:00Z"}]`,
+		},
+		{
+			name:       "Fail while the match is in play",
+			mockError:  fmt.Errorf("%w: verdicts are shown once the match is over", domain.ErrConflict),
+			wantStatus: http.StatusConflict,
+			wantBody:   fmt.Sprintf(`{"error":"%s: verdicts are shown once the match is over"}`, domain.ErrConflict.Error()),
+		},
+		{
+			name:       "Fail due to forbidden access (not owner)",
+			mockError:  domain.ErrForbidden,
+			wantStatus: http.StatusForbidden,
+			wantBody:   fmt.Sprintf(`{"error":"%s"}`, domain.ErrForbidden.Error()),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/matches/"+matchID+"/verdicts", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.Set("user_id", "01HQZYX3VQJQZ3Z0Z1Z2ZUSER1")
+			c.SetParamNames("id")
+			c.SetParamValues(matchID)
+
+			mockUseCase := new(mocks.MatchUseCase)
+			mockUseCase.On("GetVerdicts", mock.Anything, matchID, "01HQZYX3VQJQZ3Z0Z1Z2ZUSER1").Return(tt.mockReturn, tt.mockError)
+
+			h := NewMatchHandler(e, mockUseCase)
+			err := h.GetVerdicts(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			assert.JSONEq(t, tt.wantBody, rec.Body.String())
+			mockUseCase.AssertExpectations(t)
+		})
+	}
+}
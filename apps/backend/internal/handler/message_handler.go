@@ -10,6 +10,12 @@ import (
 	"github.com/everyday-studio/ollm/internal/middleware"
 )
 
+// headerIdempotencyKey lets clients retry a turn submission without playing the turn twice
+const headerIdempotencyKey = "Idempotency-Key"
+
+// maxIdempotencyKeyLength is the longest Idempotency-Key accepted
+const maxIdempotencyKeyLength = 255
+
 type MessageHandler struct {
 	messageUC domain.MessageUseCase
 }
@@ -23,7 +29,9 @@ func NewMessageHandler(e *echo.Echo, messageUC domain.MessageUseCase) *MessageHa
 	// Group routes with JWT auth
 	userGroup := e.Group("/api/matches/:match_id/messages", middleware.AllowRoles(domain.RoleUser))
 	userGroup.POST("", handler.Create)
+	userGroup.POST("/stream", handler.CreateStream)
 	userGroup.GET("", handler.GetHistory)
+	e.POST("/api/matches/:id/retry", handler.Retry, middleware.AllowRoles(domain.RoleUser))
 
 	return handler
 }
@@ -49,24 +57,114 @@ func (h *MessageHandler) Create(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
 	}
 
+	req.IdempotencyKey = c.Request().Header.Get(headerIdempotencyKey)
+	if len(req.IdempotencyKey) > maxIdempotencyKeyLength {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+	}
+
 	ctx := c.Request().Context()
 	aiMsg, err := h.messageUC.Create(ctx, matchID, userID, &req)
 	if err == nil {
 		return c.JSON(http.StatusCreated, aiMsg)
 	}
 
+	status, respErr := turnErrorStatus(err)
+	if status == http.StatusInternalServerError {
+		c.Logger().Error(err)
+	}
+	return c.JSON(status, ErrResponse(respErr))
+}
+
+// CreateStream handles the streaming variant of Create using Server-Sent Events.
+// The AI reply is sent as "delta" events while it is generated, followed by a single "done" event
+// carrying the turn result, or an "error" event if the turn fails after streaming has started.
+func (h *MessageHandler) CreateStream(c echo.Context) error {
+	matchID := c.Param("match_id")
+	if matchID == "" {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+	}
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, ErrResponse(domain.ErrUnauthorized))
+	}
+
+	var req domain.CreateMessageRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+	}
+
+	if req.Content == "" {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+	}
+
+	req.IdempotencyKey = c.Request().Header.Get(headerIdempotencyKey)
+	if len(req.IdempotencyKey) > maxIdempotencyKeyLength {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+	}
+
+	stream := newSSEWriter(c)
+	ctx := c.Request().Context()
+	result, err := h.messageUC.CreateStream(ctx, matchID, userID, &req, func(delta string) error {
+		return stream.Send("delta", map[string]string{"content": delta})
+	})
+	if err == nil {
+		return stream.Send("done", result)
+	}
+
+	status, respErr := turnErrorStatus(err)
+	if status == http.StatusInternalServerError {
+		c.Logger().Error(err)
+	}
+	if stream.started {
+		return stream.Send("error", ErrResponse(respErr))
+	}
+	return c.JSON(status, ErrResponse(respErr))
+}
+
+// Retry handles POST /matches/:id/retry - regenerates the reply of the failed turn of a match in error status
+func (h *MessageHandler) Retry(c echo.Context) error {
+	matchID := c.Param("id")
+	if matchID == "" {
+		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+	}
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, ErrResponse(domain.ErrUnauthorized))
+	}
+
+	ctx := c.Request().Context()
+	result, err := h.messageUC.Retry(ctx, matchID, userID)
+	if err == nil {
+		return c.JSON(http.StatusOK, result)
+	}
+
+	status, respErr := turnErrorStatus(err)
+	if status == http.StatusInternalServerError {
+		c.Logger().Error(err)
+	}
+	return c.JSON(status, ErrResponse(respErr))
+}
+
+// turnErrorStatus maps an error from playing a turn to an HTTP status and the error exposed to the client
+func turnErrorStatus(err error) (int, error) {
 	switch {
 	case errors.Is(err, domain.ErrNotFound):
-		return c.JSON(http.StatusNotFound, ErrResponse(domain.ErrNotFound))
+		return http.StatusNotFound, domain.ErrNotFound
 	case errors.Is(err, domain.ErrForbidden):
-		return c.JSON(http.StatusForbidden, ErrResponse(domain.ErrForbidden))
+		return http.StatusForbidden, domain.ErrForbidden
 	case errors.Is(err, domain.ErrInvalidInput):
-		return c.JSON(http.StatusBadRequest, ErrResponse(domain.ErrInvalidInput))
+		return http.StatusBadRequest, domain.ErrInvalidInput
 	case errors.Is(err, domain.ErrConflict):
-		return c.JSON(http.StatusConflict, ErrResponse(domain.ErrConflict))
+		return http.StatusConflict, domain.ErrConflict
+	case errors.Is(err, domain.ErrLLMUnavailable):
+		return http.StatusServiceUnavailable, domain.ErrLLMUnavailable
+	case errors.Is(err, domain.ErrQuotaExceeded):
+		// 어떤 한도에 걸렸는지 알 수 있도록 상세 메시지를 그대로 전달
+		return http.StatusTooManyRequests, err
 	default:
-		c.Logger().Error(err)
-		return c.JSON(http.StatusInternalServerError, ErrResponse(domain.ErrInternal))
+		return http.StatusInternalServerError, domain.ErrInternal
 	}
 }
 
@@ -84,6 +84,22 @@ func TestMessageHandler_Create(t *testing.T) {
 			wantStatus: http.StatusConflict,
 			wantBody:   fmt.Sprintf(`{"error":"%s"}`, domain.ErrConflict.Error()),
 		},
+		{
+			name:       "Fail due to LLM provider unavailable",
+			pathParam:  "01HQZYX3VQJQZ3Z0ZMATCH1",
+			body:       `{"content":"Hello"}`,
+			mockError:  fmt.Errorf("failed to resolve chat model: %w", domain.ErrLLMUnavailable),
+			wantStatus: http.StatusServiceUnavailable,
+			wantBody:   fmt.Sprintf(`{"error":"%s"}`, domain.ErrLLMUnavailable.Error()),
+		},
+		{
+			name:       "Fail due to exhausted quota",
+			pathParam:  "01HQZYX3VQJQZ3Z0ZMATCH1",
+			body:       `{"content":"Hello"}`,
+			mockError:  fmt.Errorf("%w: daily limit of 20 turns reached", domain.ErrQuotaExceeded),
+			wantStatus: http.StatusTooManyRequests,
+			wantBody:   `{"error":"quota exceeded: daily limit of 20 turns reached"}`,
+		},
 		{
 			name:       "Fail due to LLM error",
 			pathParam:  "01HQZYX3VQJQZ3Z0ZMATCH1",
@@ -122,6 +138,194 @@ func TestMessageHandler_Create(t *testing.T) {
 	}
 }
 
+func TestMessageHandler_Create_IdempotencyKey(t *testing.T) {
+	tests := []struct {
+		name       string
+		key        string
+		wantStatus int
+	}{
+		{name: "Pass the key to the usecase", key: "turn-7f3a", wantStatus: http.StatusCreated},
+		{name: "Play without a key", key: "", wantStatus: http.StatusCreated},
+		{name: "Reject an oversized key", key: strings.Repeat("k", 256), wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/matches/01HQZYX3VQJQZ3Z0ZMATCH1/messages", strings.NewReader(`{"content":"Hello"}`))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			if tt.key != "" {
+				req.Header.Set("Idempotency-Key", tt.key)
+			}
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.Set("user_id", "01HQZYX3VQJQZ3Z0ZUSER1")
+			c.SetParamNames("match_id")
+			c.SetParamValues("01HQZYX3VQJQZ3Z0ZMATCH1")
+
+			mockUC := new(mocks.MessageUseCase)
+			mockUC.On("Create", mock.Anything, "01HQZYX3VQJQZ3Z0ZMATCH1", "01HQZYX3VQJQZ3Z0ZUSER1", mock.MatchedBy(func(r *domain.CreateMessageRequest) bool {
+				return r.IdempotencyKey == tt.key
+			})).Return(&domain.Message{ID: "01HQZYX3VQJQZ3Z0ZMSG1"}, nil).Maybe()
+
+			h := NewMessageHandler(e, mockUC)
+			err := h.Create(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantStatus == http.StatusBadRequest {
+				mockUC.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			} else {
+				mockUC.AssertExpectations(t)
+			}
+		})
+	}
+}
+
+// --- CreateStream ---
+
+func TestMessageHandler_CreateStream(t *testing.T) {
+	advice := "Try roleplay"
+	tests := []struct {
+		name       string
+		body       string
+		deltas     []string
+		mockReturn *domain.TurnResult
+		mockError  error
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:   "Stream message successfully",
+			body:   `{"content":"Hello"}`,
+			deltas: []string{"Hi", " there"},
+			mockReturn: &domain.TurnResult{
+				Message:      &domain.Message{ID: "01HQZYX3VQJQZ3Z0ZMSG1", Role: domain.MessageRoleAssistant, Content: "Hi there"},
+				MatchStatus:  domain.MatchStatusActive,
+				PromptAdvice: &advice,
+			},
+			wantStatus: http.StatusOK,
+			wantBody: "event: delta\ndata: {\"content\":\"Hi\"}\n\n" +
+				"event: delta\ndata: {\"content\":\" there\"}\n\n" +
+				"event: done\ndata: {\"message\":{\"id\":\"01HQZYX3VQJQZ3Z0ZMSG1\",\"match_id\":\"\",\"role\":\"assistant\",\"content\":\"Hi there\",\"is_visible\":false,\"turn_count\":0,\"token_count\":0,\"created_at\":\"0001-01-01T00:00:00Z\"},\"match_status\":\"active\",\"prompt_advice\":\"Try roleplay\"}\n\n",
+		},
+		{
+			name:       "Fail before streaming returns JSON error",
+			body:       `{"content":"Hello"}`,
+			mockError:  domain.ErrConflict,
+			wantStatus: http.StatusConflict,
+			wantBody:   fmt.Sprintf(`{"error":"%s"}`, domain.ErrConflict.Error()) + "\n",
+		},
+		{
+			name:       "Fail after streaming started sends error event",
+			body:       `{"content":"Hello"}`,
+			deltas:     []string{"Hi"},
+			mockError:  domain.ErrInternal,
+			wantStatus: http.StatusOK,
+			wantBody: "event: delta\ndata: {\"content\":\"Hi\"}\n\n" +
+				fmt.Sprintf("event: error\ndata: {\"error\":\"%s\"}\n\n", domain.ErrInternal.Error()),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/matches/01HQZYX3VQJQZ3Z0ZMATCH1/messages/stream", strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.Set("user_id", "01HQZYX3VQJQZ3Z0ZUSER1")
+			c.SetParamNames("match_id")
+			c.SetParamValues("01HQZYX3VQJQZ3Z0ZMATCH1")
+
+			mockUC := new(mocks.MessageUseCase)
+			mockUC.On("CreateStream", mock.Anything, "01HQZYX3VQJQZ3Z0ZMATCH1", "01HQZYX3VQJQZ3Z0ZUSER1", mock.AnythingOfType("*domain.CreateMessageRequest"), mock.Anything).
+				Run(func(args mock.Arguments) {
+					onDelta := args.Get(4).(func(string) error)
+					for _, d := range tt.deltas {
+						assert.NoError(t, onDelta(d))
+					}
+				}).
+				Return(tt.mockReturn, tt.mockError)
+
+			h := NewMessageHandler(e, mockUC)
+			err := h.CreateStream(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			assert.Equal(t, tt.wantBody, rec.Body.String())
+			if tt.wantStatus == http.StatusOK {
+				assert.Equal(t, "text/event-stream", rec.Header().Get(echo.HeaderContentType))
+			}
+
+			mockUC.AssertExpectations(t)
+		})
+	}
+}
+
+// --- Retry ---
+
+func TestMessageHandler_Retry(t *testing.T) {
+	tests := []struct {
+		name       string
+		mockReturn *domain.TurnResult
+		mockError  error
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name: "Retry the failed turn successfully",
+			mockReturn: &domain.TurnResult{
+				Message:     &domain.Message{ID: "01HQZYX3VQJQZ3Z0ZMSG1", Role: domain.MessageRoleAssistant, Content: "Hi there", TurnCount: 3},
+				MatchStatus: domain.MatchStatusActive,
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   `{"message":{"id":"01HQZYX3VQJQZ3Z0ZMSG1","match_id":"","role":"assistant","content":"Hi there","is_visible":false,"turn_count":3,"token_count":0,"created_at":"0001-01-01T00:00:00Z"},"match_status":"active"}`,
+		},
+		{
+			name:       "Fail when the match is not in error or another retry is running",
+			mockError:  fmt.Errorf("%w: only a failed turn can be retried", domain.ErrConflict),
+			wantStatus: http.StatusConflict,
+			wantBody:   fmt.Sprintf(`{"error":"%s"}`, domain.ErrConflict.Error()),
+		},
+		{
+			name:       "Fail due to forbidden access (not owner)",
+			mockError:  domain.ErrForbidden,
+			wantStatus: http.StatusForbidden,
+			wantBody:   fmt.Sprintf(`{"error":"%s"}`, domain.ErrForbidden.Error()),
+		},
+		{
+			name:       "Fail while the LLM provider is unavailable",
+			mockError:  fmt.Errorf("llm failed to generate response: %w", domain.ErrLLMUnavailable),
+			wantStatus: http.StatusServiceUnavailable,
+			wantBody:   fmt.Sprintf(`{"error":"%s"}`, domain.ErrLLMUnavailable.Error()),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/matches/01HQZYX3VQJQZ3Z0ZMATCH1/retry", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.Set("user_id", "01HQZYX3VQJQZ3Z0ZUSER1")
+			c.SetParamNames("id")
+			c.SetParamValues("01HQZYX3VQJQZ3Z0ZMATCH1")
+
+			mockUC := new(mocks.MessageUseCase)
+			mockUC.On("Retry", mock.Anything, "01HQZYX3VQJQZ3Z0ZMATCH1", "01HQZYX3VQJQZ3Z0ZUSER1").Return(tt.mockReturn, tt.mockError)
+
+			h := NewMessageHandler(e, mockUC)
+			err := h.Retry(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			assert.JSONEq(t, tt.wantBody, rec.Body.String())
+			mockUC.AssertExpectations(t)
+		})
+	}
+}
+
 // --- GetHistory ---
 
 func TestMessageHandler_GetHistory(t *testing.T) {
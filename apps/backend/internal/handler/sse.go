@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// sseWriter writes Server-Sent Events to the response.
+// Stream headers are only sent with the first event, so a handler can still
+// fall back to a regular JSON error response if nothing has been streamed yet.
+type sseWriter struct {
+	c       echo.Context
+	started bool
+}
+
+func newSSEWriter(c echo.Context) *sseWriter {
+	return &sseWriter{c: c}
+}
+
+// Send writes a single event with data encoded as JSON and flushes it to the client.
+func (w *sseWriter) Send(event string, data any) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to encode sse event: %w", err)
+	}
+
+	res := w.c.Response()
+	if !w.started {
+		// Streams may outlive the server write timeout; not every writer supports deadlines (e.g. in tests).
+		_ = http.NewResponseController(res).SetWriteDeadline(time.Time{})
+
+		res.Header().Set(echo.HeaderContentType, "text/event-stream")
+		res.Header().Set(echo.HeaderCacheControl, "no-cache")
+		res.Header().Set(echo.HeaderConnection, "keep-alive")
+		res.Header().Set("X-Accel-Buffering", "no") // disable nginx proxy buffering
+		res.WriteHeader(http.StatusOK)
+		w.started = true
+	}
+
+	if _, err := fmt.Fprintf(res, "event: %s\ndata: %s\n\n", event, payload); err != nil {
+		return err
+	}
+	res.Flush()
+
+	return nil
+}
@@ -14,14 +14,22 @@ import (
 )
 
 type UserHandler struct {
-	userUseCase domain.UserUseCase
-	config      *config.Config
+	userUseCase  domain.UserUseCase
+	quotaUseCase domain.QuotaUseCase
+	config       *config.Config
 }
 
-func NewUserHandler(e *echo.Echo, userUseCase domain.UserUseCase, cfg *config.Config) *UserHandler {
+// meResponse is the profile of the signed-in user along with their daily LLM quota.
+type meResponse struct {
+	*domain.User
+	Quota *domain.Quota `json:"quota"`
+}
+
+func NewUserHandler(e *echo.Echo, userUseCase domain.UserUseCase, quotaUseCase domain.QuotaUseCase, cfg *config.Config) *UserHandler {
 	handler := &UserHandler{
-		userUseCase: userUseCase,
-		config:      cfg,
+		userUseCase:  userUseCase,
+		quotaUseCase: quotaUseCase,
+		config:       cfg,
 	}
 
 	userGroup := e.Group("/api/users", middleware.AllowRoles(domain.RoleUser))
@@ -41,7 +49,11 @@ func (h *UserHandler) GetMe(c echo.Context) error {
 	ctx := c.Request().Context()
 	user, err := h.userUseCase.GetByID(ctx, userID)
 	if err == nil {
-		return c.JSON(http.StatusOK, user)
+		quota, quotaErr := h.quotaUseCase.Get(ctx, userID)
+		if quotaErr != nil {
+			return c.JSON(http.StatusInternalServerError, ErrResponse(domain.ErrInternal))
+		}
+		return c.JSON(http.StatusOK, meResponse{User: user, Quota: quota})
 	}
 
 	switch {
@@ -6,6 +6,7 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
@@ -39,7 +40,7 @@ func TestUserHandler_GetMe(t *testing.T) {
 			},
 			mockError:  nil,
 			wantStatus: http.StatusOK,
-			wantBody:   `{"id":"01HQZYX3VQJQZ3Z0Z1Z2ZUSER1","name":"John","tag":"john123","email":"john@example.com","role":"User","created_at":"0001-01-01T00:00:00Z","updated_at":"0001-01-01T00:00:00Z"}`,
+			wantBody:   `{"id":"01HQZYX3VQJQZ3Z0Z1Z2ZUSER1","name":"John","tag":"john123","email":"john@example.com","role":"User","created_at":"0001-01-01T00:00:00Z","updated_at":"0001-01-01T00:00:00Z","quota":{"daily_tokens":1000,"daily_turns":0,"used_tokens":400,"used_turns":3,"remaining_tokens":600,"remaining_turns":null,"resets_at":"2026-04-02T00:00:00Z"}}`,
 		},
 		{
 			name:       "Fail due to missing user_id in context (unauthorized)",
@@ -82,7 +83,17 @@ func TestUserHandler_GetMe(t *testing.T) {
 			mockUseCase := new(mocks.UserUseCase)
 			mockUseCase.On("GetByID", mock.Anything, tt.userID).Return(tt.mockReturn, tt.mockError).Maybe()
 
-			h := NewUserHandler(e, mockUseCase, &config.Config{})
+			remainingTokens := 600
+			mockQuotaUseCase := new(mocks.QuotaUseCase)
+			mockQuotaUseCase.On("Get", mock.Anything, tt.userID).Return(&domain.Quota{
+				DailyTokens:     1000,
+				UsedTokens:      400,
+				UsedTurns:       3,
+				RemainingTokens: &remainingTokens,
+				ResetsAt:        time.Date(2026, 4, 2, 0, 0, 0, 0, time.UTC),
+			}, nil).Maybe()
+
+			h := NewUserHandler(e, mockUseCase, mockQuotaUseCase, &config.Config{})
 			err := h.GetMe(c)
 
 			assert.NoError(t, err)
@@ -188,7 +199,7 @@ func TestUserHandler_UpdateMe(t *testing.T) {
 				mockUseCase.On("UpdateNickname", mock.Anything, tt.userID, mock.AnythingOfType("string")).Return(tt.mockReturn, tt.mockError).Maybe()
 			}
 
-			h := NewUserHandler(e, mockUseCase, &config.Config{})
+			h := NewUserHandler(e, mockUseCase, nil, &config.Config{})
 			err := h.UpdateMe(c)
 
 			assert.NoError(t, err)
@@ -257,7 +268,7 @@ func TestUserHandler_Withdraw(t *testing.T) {
 				mockUseCase.On("Delete", mock.Anything, tt.userID).Return(tt.mockError).Maybe()
 			}
 
-			h := NewUserHandler(e, mockUseCase, &config.Config{})
+			h := NewUserHandler(e, mockUseCase, nil, &config.Config{})
 			err := h.Withdraw(c)
 
 			assert.NoError(t, err)
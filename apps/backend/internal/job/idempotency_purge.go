@@ -0,0 +1,40 @@
+package job
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/everyday-studio/ollm/internal/config"
+	"github.com/everyday-studio/ollm/internal/domain"
+)
+
+const defaultPurgeInterval = time.Hour
+
+// RegisterIdempotencyPurge schedules the deletion of old idempotency keys
+// unless cfg.Jobs.IdempotencyPurge disables it. Every batch deleted is logged.
+func RegisterIdempotencyPurge(s *Scheduler, sweeper domain.SweeperUseCase, cfg *config.Config, logger *slog.Logger) {
+	if cfg.Jobs.IdempotencyPurge.Disabled {
+		return
+	}
+
+	interval := defaultPurgeInterval
+	if cfg.Jobs.IdempotencyPurge.IntervalSec > 0 {
+		interval = time.Duration(cfg.Jobs.IdempotencyPurge.IntervalSec) * time.Second
+	}
+
+	s.Add(Job{
+		Name:     "idempotency_purge",
+		Interval: interval,
+		Run: func(ctx context.Context) error {
+			purged, err := sweeper.PurgeIdempotencyKeys(ctx)
+			if err != nil {
+				return err
+			}
+			if purged > 0 {
+				logger.Info("idempotency keys purged", "count", purged)
+			}
+			return nil
+		},
+	})
+}
@@ -0,0 +1,54 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/everyday-studio/ollm/internal/config"
+	"github.com/everyday-studio/ollm/internal/domain"
+	"github.com/everyday-studio/ollm/internal/domain/mocks"
+)
+
+func TestRegisterIdempotencyPurge(t *testing.T) {
+	t.Run("Log the purged keys", func(t *testing.T) {
+		logs := &syncBuffer{}
+		logger := slog.New(slog.NewTextHandler(logs, nil))
+		s := NewScheduler(logger)
+
+		mockSweeper := new(mocks.SweeperUseCase)
+		mockSweeper.On("PurgeIdempotencyKeys", mock.Anything).Return(int64(12), nil)
+
+		RegisterIdempotencyPurge(s, mockSweeper, &config.Config{}, logger)
+		assert.Len(t, s.jobs, 1)
+		assert.Equal(t, time.Hour, s.jobs[0].Interval)
+
+		assert.NoError(t, s.jobs[0].Run(context.Background()))
+		assert.Contains(t, logs.String(), `msg="idempotency keys purged" count=12`)
+	})
+
+	t.Run("Return the purge error", func(t *testing.T) {
+		logger := slog.New(slog.NewTextHandler(&syncBuffer{}, nil))
+		s := NewScheduler(logger)
+
+		mockSweeper := new(mocks.SweeperUseCase)
+		mockSweeper.On("PurgeIdempotencyKeys", mock.Anything).Return(int64(0), domain.ErrInternal)
+
+		RegisterIdempotencyPurge(s, mockSweeper, &config.Config{Jobs: config.JobsConfig{IdempotencyPurge: config.IdempotencyPurgeConfig{IntervalSec: 30}}}, logger)
+		assert.Equal(t, 30*time.Second, s.jobs[0].Interval)
+
+		err := s.jobs[0].Run(context.Background())
+		assert.True(t, errors.Is(err, domain.ErrInternal))
+	})
+
+	t.Run("Skip when disabled", func(t *testing.T) {
+		s := NewScheduler(slog.Default())
+		RegisterIdempotencyPurge(s, new(mocks.SweeperUseCase), &config.Config{Jobs: config.JobsConfig{IdempotencyPurge: config.IdempotencyPurgeConfig{Disabled: true}}}, slog.Default())
+		assert.Empty(t, s.jobs)
+	})
+}
@@ -0,0 +1,87 @@
+package job
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// syncBuffer is a bytes.Buffer safe for the concurrent writes of running jobs
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func TestScheduler_RunsJobsUntilStopped(t *testing.T) {
+	s := NewScheduler(slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	var runs atomic.Int32
+	s.Add(Job{
+		Name:     "counter",
+		Interval: 5 * time.Millisecond,
+		Run: func(ctx context.Context) error {
+			runs.Add(1)
+			return nil
+		},
+	})
+
+	s.Start()
+	assert.Eventually(t, func() bool { return runs.Load() >= 3 }, time.Second, time.Millisecond)
+	assert.NoError(t, s.Stop(context.Background()))
+
+	stopped := runs.Load()
+	time.Sleep(20 * time.Millisecond)
+	assert.Equal(t, stopped, runs.Load())
+}
+
+func TestScheduler_SurvivesFailures(t *testing.T) {
+	logs := &syncBuffer{}
+	s := NewScheduler(slog.New(slog.NewTextHandler(logs, nil)))
+
+	var runs atomic.Int32
+	s.Add(Job{
+		Name:     "flaky",
+		Interval: 5 * time.Millisecond,
+		Run: func(ctx context.Context) error {
+			switch runs.Add(1) {
+			case 1:
+				panic("boom")
+			case 2:
+				return errors.New("db down")
+			}
+			return nil
+		},
+	})
+
+	s.Start()
+	assert.Eventually(t, func() bool { return runs.Load() >= 3 }, time.Second, time.Millisecond)
+	assert.NoError(t, s.Stop(context.Background()))
+
+	assert.Contains(t, logs.String(), `msg="job panicked" job=flaky panic=boom`)
+	assert.Contains(t, logs.String(), `msg="job failed" job=flaky error="db down"`)
+}
+
+func TestScheduler_StopWithoutStart(t *testing.T) {
+	s := NewScheduler(slog.New(slog.NewTextHandler(io.Discard, nil)))
+	assert.NoError(t, s.Stop(context.Background()))
+}
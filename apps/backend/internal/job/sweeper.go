@@ -0,0 +1,55 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/everyday-studio/ollm/internal/config"
+	"github.com/everyday-studio/ollm/internal/domain"
+)
+
+const defaultSweepInterval = time.Minute
+
+// RegisterSweeper schedules the expiry of idle matches and the recovery of stuck ones
+// unless cfg.Jobs.Sweeper disables it. Every status change is logged.
+func RegisterSweeper(s *Scheduler, sweeper domain.SweeperUseCase, cfg *config.Config, logger *slog.Logger) {
+	if cfg.Jobs.Sweeper.Disabled {
+		return
+	}
+
+	interval := defaultSweepInterval
+	if cfg.Jobs.Sweeper.IntervalSec > 0 {
+		interval = time.Duration(cfg.Jobs.Sweeper.IntervalSec) * time.Second
+	}
+
+	s.Add(Job{
+		Name:     "sweeper",
+		Interval: interval,
+		Run: func(ctx context.Context) error {
+			expired, expireErr := sweeper.ExpireIdle(ctx)
+			for _, match := range expired {
+				logTransition(logger, "idle match expired", match, domain.MatchStatusActive)
+			}
+
+			recovered, recoverErr := sweeper.RecoverStuck(ctx)
+			for _, match := range recovered {
+				logTransition(logger, "stuck match recovered", match, domain.MatchStatusGenerating)
+			}
+
+			return errors.Join(expireErr, recoverErr)
+		},
+	})
+}
+
+func logTransition(logger *slog.Logger, msg string, match domain.Match, from domain.MatchStatus) {
+	logger.Info(msg,
+		"match_id", match.ID,
+		"game_id", match.GameID,
+		"user_id", match.UserID,
+		"from", from,
+		"to", match.Status,
+		"turn_count", match.TurnCount,
+	)
+}
@@ -0,0 +1,62 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/everyday-studio/ollm/internal/config"
+	"github.com/everyday-studio/ollm/internal/domain"
+	"github.com/everyday-studio/ollm/internal/domain/mocks"
+)
+
+func TestRegisterSweeper(t *testing.T) {
+	t.Run("Log every transition", func(t *testing.T) {
+		logs := &syncBuffer{}
+		logger := slog.New(slog.NewTextHandler(logs, nil))
+		s := NewScheduler(logger)
+
+		mockSweeper := new(mocks.SweeperUseCase)
+		mockSweeper.On("ExpireIdle", mock.Anything).Return([]domain.Match{
+			{ID: "M1", GameID: "G1", UserID: "U1", Status: domain.MatchStatusExpired, TurnCount: 2},
+		}, nil)
+		mockSweeper.On("RecoverStuck", mock.Anything).Return([]domain.Match{
+			{ID: "M2", GameID: "G1", UserID: "U2", Status: domain.MatchStatusError, TurnCount: 4},
+		}, nil)
+
+		RegisterSweeper(s, mockSweeper, &config.Config{}, logger)
+		assert.Len(t, s.jobs, 1)
+		assert.Equal(t, time.Minute, s.jobs[0].Interval)
+
+		assert.NoError(t, s.jobs[0].Run(context.Background()))
+		assert.Contains(t, logs.String(), `msg="idle match expired" match_id=M1 game_id=G1 user_id=U1 from=active to=expired turn_count=2`)
+		assert.Contains(t, logs.String(), `msg="stuck match recovered" match_id=M2 game_id=G1 user_id=U2 from=generating to=error turn_count=4`)
+	})
+
+	t.Run("Recover even when expiry fails", func(t *testing.T) {
+		logger := slog.New(slog.NewTextHandler(&syncBuffer{}, nil))
+		s := NewScheduler(logger)
+
+		mockSweeper := new(mocks.SweeperUseCase)
+		mockSweeper.On("ExpireIdle", mock.Anything).Return(nil, domain.ErrInternal)
+		mockSweeper.On("RecoverStuck", mock.Anything).Return([]domain.Match{}, nil)
+
+		RegisterSweeper(s, mockSweeper, &config.Config{Jobs: config.JobsConfig{Sweeper: config.SweeperConfig{IntervalSec: 10}}}, logger)
+		assert.Equal(t, 10*time.Second, s.jobs[0].Interval)
+
+		err := s.jobs[0].Run(context.Background())
+		assert.True(t, errors.Is(err, domain.ErrInternal))
+		mockSweeper.AssertCalled(t, "RecoverStuck", mock.Anything)
+	})
+
+	t.Run("Skip when disabled", func(t *testing.T) {
+		s := NewScheduler(slog.Default())
+		RegisterSweeper(s, new(mocks.SweeperUseCase), &config.Config{Jobs: config.JobsConfig{Sweeper: config.SweeperConfig{Disabled: true}}}, slog.Default())
+		assert.Empty(t, s.jobs)
+	})
+}
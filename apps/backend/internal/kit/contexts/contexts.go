@@ -40,6 +40,27 @@ func GetRequestID(ctx context.Context) string {
 	return "no-request-id-in-context"
 }
 
+var llmCallScopeContextKey contextKey = "llm_call_scope_context_key"
+
+type llmCallScope struct {
+	matchID   string
+	messageID string
+}
+
+// WithLLMCallScope attaches the match and message that LLM calls made with ctx belong to,
+// so the calls can be recorded in the audit log.
+func WithLLMCallScope(ctx context.Context, matchID string, messageID string) context.Context {
+	return context.WithValue(ctx, llmCallScopeContextKey, llmCallScope{matchID: matchID, messageID: messageID})
+}
+
+// GetLLMCallScope returns the match and message IDs set by WithLLMCallScope, or empty strings.
+func GetLLMCallScope(ctx context.Context) (string, string) {
+	if scope, ok := ctx.Value(llmCallScopeContextKey).(llmCallScope); ok {
+		return scope.matchID, scope.messageID
+	}
+	return "", ""
+}
+
 // TokenToUser extracts user information (id, email, role) from the JWT token
 // stored in echo.Context. Returns an error if the token is missing or invalid.
 //
@@ -0,0 +1,243 @@
+package judge
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/everyday-studio/ollm/internal/domain"
+)
+
+// maxCompositeLeaves bounds the judges of one composite condition, since every leaf is evaluated on every turn.
+const maxCompositeLeaves = 8
+
+// compositeJudge decides the turn by a boolean expression over the other judges of the registry.
+// Every leaf judge is evaluated concurrently and its verdict is kept with the composite verdict.
+type compositeJudge struct {
+	registry *Registry
+}
+
+// compositeLeaf is a leaf of a composite condition, resolved to its judge.
+type compositeLeaf struct {
+	judgeType domain.JudgeType
+	judge     domain.Judge
+	condition string
+}
+
+func (j compositeJudge) Validate(condition string) error {
+	if err := requireCondition(condition); err != nil {
+		return err
+	}
+	_, _, err := j.parse(condition)
+	return err
+}
+
+func (j compositeJudge) Evaluate(ctx context.Context, input domain.JudgeInput) (*domain.JudgeVerdict, error) {
+	root, leaves, err := j.parse(input.Condition)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]domain.JudgeLeaf, len(leaves))
+	errs := make([]error, len(leaves))
+	progress := make([]*domain.JudgeProgress, len(leaves))
+
+	var wg sync.WaitGroup
+	for i, leaf := range leaves {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i].Leaf = i
+			results[i].JudgeType = leaf.judgeType
+
+			leafInput := input
+			leafInput.Condition = leaf.condition
+			verdict, err := leaf.judge.Evaluate(ctx, leafInput)
+			if err != nil {
+				errs[i] = fmt.Errorf("leaf %d (%s): %w", i, leaf.judgeType, err)
+				results[i].Outcome = domain.JudgeOutcomeContinue
+				results[i].Reason = "the judge could not rule on this turn"
+				results[i].Failed = true
+				return
+			}
+			results[i] = domain.JudgeLeaf{
+				Leaf:             i,
+				JudgeType:        leaf.judgeType,
+				Outcome:          verdict.Outcome,
+				Reason:           verdict.Reason,
+				Output:           verdict.Output,
+				Votes:            verdict.Votes,
+				Match:            verdict.Match,
+				PromptTokens:     verdict.PromptTokens,
+				CompletionTokens: verdict.CompletionTokens,
+			}
+			progress[i] = verdict.Progress
+		}()
+	}
+	wg.Wait()
+
+	verdict := &domain.JudgeVerdict{Leaves: results}
+	for i, result := range results {
+		verdict.PromptTokens += result.PromptTokens
+		verdict.CompletionTokens += result.CompletionTokens
+		// parse allows a single leaf with progress of its own
+		if progress[i] != nil {
+			verdict.Progress = progress[i]
+		}
+	}
+
+	next := 0
+	met, known, expr := decideExpr(root, results, &next)
+	if !known {
+		return nil, fmt.Errorf("composite condition could not be decided: %w", errors.Join(errs...))
+	}
+	if met {
+		verdict.Outcome = domain.JudgeOutcomeWon
+		verdict.Reason = "composite condition met: " + expr
+	} else {
+		verdict.Outcome = domain.JudgeOutcomeContinue
+		verdict.Reason = "composite condition not met: " + expr
+	}
+	return verdict, nil
+}
+
+// decideExpr decides a node of a composite condition from the verdicts of its leaves, numbered from next in the order they are written.
+// A failed leaf is unknown, and a node is known only if its value does not depend on any unknown leaf,
+// so e.g. a failed leaf under an AND that is already false does not stop the turn from being decided.
+// It also returns the node written out with the result of each leaf, for the reason of the verdict.
+func decideExpr(node *domain.CompositeCondition, leaves []domain.JudgeLeaf, next *int) (met, known bool, expr string) {
+	switch node.Op {
+	case domain.JudgeOpNot:
+		met, known, expr = decideExpr(&node.Args[0], leaves, next)
+		return !met, known, "NOT " + expr
+
+	case domain.JudgeOpAnd, domain.JudgeOpOr:
+		// AND is decided by any known false, OR by any known true
+		decisive := node.Op == domain.JudgeOpOr
+		exprs := make([]string, len(node.Args))
+		decided, allKnown := false, true
+		for i := range node.Args {
+			argMet, argKnown, argExpr := decideExpr(&node.Args[i], leaves, next)
+			exprs[i] = argExpr
+			if argKnown && argMet == decisive {
+				decided = true
+			}
+			allKnown = allKnown && argKnown
+		}
+		expr = "(" + strings.Join(exprs, " "+strings.ToUpper(string(node.Op))+" ") + ")"
+		if decided {
+			return decisive, true, expr
+		}
+		return !decisive, allKnown, expr
+
+	default:
+		leaf := leaves[*next]
+		*next++
+		switch {
+		case leaf.Failed:
+			return false, false, fmt.Sprintf("%s#%d failed", leaf.JudgeType, leaf.Leaf)
+		case leaf.Outcome == domain.JudgeOutcomeWon:
+			return true, true, fmt.Sprintf("%s#%d met", leaf.JudgeType, leaf.Leaf)
+		default:
+			return false, true, fmt.Sprintf("%s#%d not met", leaf.JudgeType, leaf.Leaf)
+		}
+	}
+}
+
+// parse reads a composite condition and resolves its leaves to their judges, validating every leaf condition.
+func (j compositeJudge) parse(condition string) (*domain.CompositeCondition, []compositeLeaf, error) {
+	var root domain.CompositeCondition
+	if err := json.Unmarshal([]byte(condition), &root); err != nil {
+		return nil, nil, fmt.Errorf("invalid composite condition: %w", err)
+	}
+
+	var leaves []compositeLeaf
+	if err := j.collect(&root, &leaves); err != nil {
+		return nil, nil, err
+	}
+
+	// 진행 상황은 매치에 하나만 저장되므로 여러 목표를 가진 리프는 하나까지만 허용
+	withProgress := 0
+	for _, leaf := range leaves {
+		if leaf.judgeType != domain.JudgeTypeTargetWord {
+			continue
+		}
+		if c, _ := parseTargetCondition(leaf.condition); c != nil && c.Mode != domain.TargetModeAny {
+			withProgress++
+		}
+	}
+	if withProgress > 1 {
+		return nil, nil, errors.New("only one target word list with an all or ordered mode is allowed")
+	}
+	return &root, leaves, nil
+}
+
+func (j compositeJudge) collect(node *domain.CompositeCondition, leaves *[]compositeLeaf) error {
+	switch node.Op {
+	case domain.JudgeOpAnd, domain.JudgeOpOr, domain.JudgeOpNot:
+		if node.Type != "" || node.Condition != nil {
+			return fmt.Errorf("%s node must not have a judge type or condition", node.Op)
+		}
+		if node.Op == domain.JudgeOpNot && len(node.Args) != 1 {
+			return errors.New("not node must have exactly one arg")
+		}
+		if node.Op != domain.JudgeOpNot && len(node.Args) < 2 {
+			return fmt.Errorf("%s node must have at least two args", node.Op)
+		}
+		for i := range node.Args {
+			if err := j.collect(&node.Args[i], leaves); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case "":
+		if len(node.Args) > 0 {
+			return errors.New("leaf node must not have args")
+		}
+		if node.Type == "" {
+			return errors.New("node must have either an op or a judge type")
+		}
+		if node.Type == domain.JudgeTypeComposite {
+			return errors.New("composite judges cannot be nested; nest the operators instead")
+		}
+		if len(*leaves) == maxCompositeLeaves {
+			return fmt.Errorf("composite condition must have at most %d leaves", maxCompositeLeaves)
+		}
+
+		judge, err := j.registry.Get(node.Type)
+		if err != nil {
+			return err
+		}
+		condition, err := leafCondition(node.Condition)
+		if err != nil {
+			return fmt.Errorf("leaf %d (%s): %w", len(*leaves), node.Type, err)
+		}
+		if err := judge.Validate(condition); err != nil {
+			return fmt.Errorf("leaf %d (%s): %w", len(*leaves), node.Type, err)
+		}
+		*leaves = append(*leaves, compositeLeaf{judgeType: node.Type, judge: judge, condition: condition})
+		return nil
+
+	default:
+		return fmt.Errorf("unknown op %q", node.Op)
+	}
+}
+
+// leafCondition returns the condition of a leaf as its judge reads it: a JSON string as the string, any other JSON as its text.
+func leafCondition(raw json.RawMessage) (string, error) {
+	if len(raw) == 0 {
+		return "", nil
+	}
+	if raw[0] == '"' {
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return "", err
+		}
+		return s, nil
+	}
+	return string(raw), nil
+}
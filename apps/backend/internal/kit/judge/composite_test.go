@@ -0,0 +1,159 @@
+package judge
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/everyday-studio/ollm/internal/domain"
+	"github.com/everyday-studio/ollm/internal/domain/mocks"
+)
+
+func TestCompositeJudge(t *testing.T) {
+	// revealInJSON wins when the AI says the word while still answering in JSON
+	const revealInJSON = `{"op": "and", "args": [
+		{"type": "target_word", "condition": "apple"},
+		{"op": "not", "args": [{"type": "json_schema", "condition": {"type": "object"}}]}
+	]}`
+	// brokeCharacter wins when the judge model says the persona broke or the AI admits it is one
+	const brokeCharacter = `{"op": "or", "args": [
+		{"type": "llm_judge", "condition": "the AI breaks its persona"},
+		{"type": "regex", "condition": "(?i)I am an AI"}
+	]}`
+
+	tests := []struct {
+		name      string
+		condition string
+		reply     string
+		setupMock func(llm *mocks.LLMService)
+		outcome   domain.JudgeOutcome
+		leaves    []domain.JudgeOutcome
+		failed    []bool
+		wantErr   bool
+	}{
+		{
+			name:      "And wins when every arg holds",
+			condition: revealInJSON,
+			reply:     `{"answer": "apple"}`,
+			outcome:   domain.JudgeOutcomeWon,
+			leaves:    []domain.JudgeOutcome{domain.JudgeOutcomeWon, domain.JudgeOutcomeContinue},
+		},
+		{
+			name:      "And continues when one arg does not hold",
+			condition: revealInJSON,
+			reply:     `it is apple`,
+			outcome:   domain.JudgeOutcomeContinue,
+			leaves:    []domain.JudgeOutcome{domain.JudgeOutcomeWon, domain.JudgeOutcomeWon},
+		},
+		{
+			name:      "Or wins with either arg",
+			condition: brokeCharacter,
+			reply:     "Honestly, I am an AI.",
+			setupMock: func(llm *mocks.LLMService) {
+				llm.On("EvaluateWinCondition", mock.Anything, "the AI breaks its persona", mock.Anything).
+					Return(&domain.LLMJudgement{Result: false, Reason: "stayed in character", PromptTokens: 40, CompletionTokens: 2}, nil)
+			},
+			outcome: domain.JudgeOutcomeWon,
+			leaves:  []domain.JudgeOutcome{domain.JudgeOutcomeContinue, domain.JudgeOutcomeWon},
+		},
+		{
+			name:      "Or is decided without a failed judge when another arg holds",
+			condition: brokeCharacter,
+			reply:     "Honestly, I am an AI.",
+			setupMock: func(llm *mocks.LLMService) {
+				llm.On("EvaluateWinCondition", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("timeout"))
+			},
+			outcome: domain.JudgeOutcomeWon,
+			leaves:  []domain.JudgeOutcome{domain.JudgeOutcomeContinue, domain.JudgeOutcomeWon},
+			failed:  []bool{true, false},
+		},
+		{
+			name:      "Fail when the result depends on a failed judge",
+			condition: brokeCharacter,
+			reply:     "Greetings, traveller.",
+			setupMock: func(llm *mocks.LLMService) {
+				llm.On("EvaluateWinCondition", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("timeout"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			llm := new(mocks.LLMService)
+			if tt.setupMock != nil {
+				tt.setupMock(llm)
+			}
+
+			composite, _ := NewRegistry().Get(domain.JudgeTypeComposite)
+
+			verdict, err := composite.Evaluate(context.Background(), domain.JudgeInput{
+				Condition: tt.condition,
+				Reply:     &domain.Message{Role: domain.MessageRoleAssistant, Content: tt.reply},
+				LLM:       llm,
+			})
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.outcome, verdict.Outcome)
+			assert.NotEmpty(t, verdict.Reason)
+			if assert.Len(t, verdict.Leaves, len(tt.leaves)) {
+				for i, leaf := range verdict.Leaves {
+					assert.Equal(t, i, leaf.Leaf)
+					assert.Equal(t, tt.leaves[i], leaf.Outcome)
+					assert.NotEmpty(t, leaf.Reason)
+					if tt.failed != nil {
+						assert.Equal(t, tt.failed[i], leaf.Failed)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestCompositeJudge_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		condition string
+		wantErr   bool
+	}{
+		{
+			name:      "Accept a nested expression",
+			condition: `{"op": "or", "args": [{"type": "regex", "condition": "\\d+"}, {"op": "not", "args": [{"type": "target_word", "condition": "apple"}]}]}`,
+		},
+		{
+			name:      "Accept a single leaf",
+			condition: `{"type": "target_word", "condition": "apple"}`,
+		},
+		{name: "Reject malformed JSON", condition: `{"op": "and"`, wantErr: true},
+		{name: "Reject an unknown op", condition: `{"op": "xor", "args": [{"type": "regex", "condition": "a"}, {"type": "regex", "condition": "b"}]}`, wantErr: true},
+		{name: "Reject an and with one arg", condition: `{"op": "and", "args": [{"type": "regex", "condition": "a"}]}`, wantErr: true},
+		{name: "Reject a not with two args", condition: `{"op": "not", "args": [{"type": "regex", "condition": "a"}, {"type": "regex", "condition": "b"}]}`, wantErr: true},
+		{name: "Reject an unknown judge type", condition: `{"type": "vibes", "condition": "a"}`, wantErr: true},
+		{name: "Reject an invalid leaf condition", condition: `{"type": "regex", "condition": "[a-z"}`, wantErr: true},
+		{name: "Reject a nested composite", condition: `{"type": "composite", "condition": {"type": "regex", "condition": "a"}}`, wantErr: true},
+		{
+			name:      "Reject two word lists that track progress",
+			condition: `{"op": "and", "args": [{"type": "target_word", "condition": {"words": ["a", "b"], "mode": "all"}}, {"type": "target_word", "condition": {"words": ["c", "d"], "mode": "ordered"}}]}`,
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewRegistry().Validate(domain.JudgeTypeComposite, tt.condition)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, domain.ErrInvalidInput)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package judge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/everyday-studio/ollm/internal/domain"
+)
+
+// question asks a judge model for its ruling on the turn.
+type question func(ctx context.Context, llm domain.LLMService) (*domain.LLMJudgement, error)
+
+// ask puts the question to the game's judge model, or to every model of its consensus panel in parallel.
+// The panel's ruling is decided by the policy of the input, and every vote is returned with it.
+func ask(ctx context.Context, input domain.JudgeInput, q question) (*domain.LLMJudgement, []domain.JudgeVote, error) {
+	if len(input.Panel) == 0 {
+		judgement, err := q(ctx, input.LLM)
+		return judgement, nil, err
+	}
+
+	votes := make([]domain.JudgeVote, len(input.Panel))
+	errs := make([]error, len(input.Panel))
+
+	var wg sync.WaitGroup
+	for i, panelist := range input.Panel {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			votes[i].Model = panelist.Model
+
+			judgement, err := q(ctx, panelist.LLM)
+			if err != nil {
+				errs[i] = fmt.Errorf("judge %q: %w", panelist.Model, err)
+				votes[i].Failed = true
+				votes[i].Reason = "the judge could not rule on this turn"
+				return
+			}
+			votes[i].Result = judgement.Result
+			votes[i].Reason = judgement.Reason
+			votes[i].PromptTokens = judgement.PromptTokens
+			votes[i].CompletionTokens = judgement.CompletionTokens
+		}()
+	}
+	wg.Wait()
+
+	judgement := &domain.LLMJudgement{}
+	ayes, failed := 0, 0
+	for _, vote := range votes {
+		if vote.Failed {
+			failed++
+		} else if vote.Result {
+			ayes++
+		}
+		judgement.PromptTokens += vote.PromptTokens
+		judgement.CompletionTokens += vote.CompletionTokens
+	}
+	if failed == len(votes) {
+		return nil, votes, fmt.Errorf("all %d judges of the panel failed: %w", len(votes), errors.Join(errs...))
+	}
+
+	policy := input.Policy
+	if policy == "" {
+		policy = domain.JudgePolicyMajority
+	}
+	judgement.Result = decide(policy, ayes, len(votes), input.Quorum)
+	judgement.Reason = fmt.Sprintf("%d of %d judges ruled yes under the %s policy", ayes, len(votes), policy)
+	if failed > 0 {
+		judgement.Reason += fmt.Sprintf(" (%d could not rule)", failed)
+	}
+	return judgement, votes, nil
+}
+
+// decide applies a consensus policy to the ayes of a panel of the given size.
+// Judges that failed to rule count against, so a win always needs the judges to actually agree.
+func decide(policy domain.JudgePolicy, ayes, panelSize, quorum int) bool {
+	switch policy {
+	case domain.JudgePolicyUnanimous:
+		return ayes == panelSize
+	case domain.JudgePolicyQuorum:
+		return ayes >= quorum
+	default:
+		return ayes*2 > panelSize
+	}
+}
@@ -0,0 +1,136 @@
+package judge
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+
+	"github.com/everyday-studio/ollm/internal/domain"
+)
+
+// regexJudge wins the match when the AI reply matches, or fails to match, the pattern of the game.
+// It is decided without a judge model.
+type regexJudge struct{}
+
+func (regexJudge) Validate(condition string) error {
+	if err := requireCondition(condition); err != nil {
+		return err
+	}
+	_, _, err := parseRegexCondition(condition)
+	return err
+}
+
+func (regexJudge) Evaluate(_ context.Context, input domain.JudgeInput) (*domain.JudgeVerdict, error) {
+	condition, re, err := parseRegexCondition(input.Condition)
+	if err != nil {
+		return nil, err
+	}
+
+	reply := input.Reply.Content
+	loc := re.FindStringIndex(reply)
+	if loc == nil {
+		reason := fmt.Sprintf("reply does not match the pattern %q", condition.Pattern)
+		if condition.Mode == domain.RegexModeNoMatch {
+			return &domain.JudgeVerdict{Outcome: domain.JudgeOutcomeWon, Reason: reason}, nil
+		}
+		return &domain.JudgeVerdict{Outcome: domain.JudgeOutcomeContinue, Reason: reason}, nil
+	}
+
+	match := &domain.JudgeMatch{Text: reply[loc[0]:loc[1]], Start: loc[0], End: loc[1]}
+	reason := fmt.Sprintf("reply matches the pattern %q at %q", condition.Pattern, match.Text)
+	if condition.Mode == domain.RegexModeNoMatch {
+		return &domain.JudgeVerdict{Outcome: domain.JudgeOutcomeContinue, Reason: reason, Match: match}, nil
+	}
+	return &domain.JudgeVerdict{Outcome: domain.JudgeOutcomeWon, Reason: reason, Match: match}, nil
+}
+
+// parseRegexCondition reads a regex condition, either a plain pattern or a RegexCondition as JSON, and compiles its pattern.
+func parseRegexCondition(condition string) (*domain.RegexCondition, *regexp.Regexp, error) {
+	c := &domain.RegexCondition{Pattern: condition, Mode: domain.RegexModeMatch}
+	if trimmed := strings.TrimSpace(condition); strings.HasPrefix(trimmed, "{") {
+		c = &domain.RegexCondition{}
+		if err := json.Unmarshal([]byte(trimmed), c); err != nil {
+			return nil, nil, fmt.Errorf("invalid regex condition: %w", err)
+		}
+		switch c.Mode {
+		case "":
+			c.Mode = domain.RegexModeMatch
+		case domain.RegexModeMatch, domain.RegexModeNoMatch:
+		default:
+			return nil, nil, fmt.Errorf("unknown regex mode %q", c.Mode)
+		}
+		if c.Pattern == "" {
+			return nil, nil, errEmptyCondition
+		}
+	}
+
+	re, err := regexp.Compile(c.Pattern)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid pattern: %w", err)
+	}
+	return c, re, nil
+}
+
+// jsonSchemaJudge wins the match when the AI reply is not JSON that validates against the schema of the game.
+// The whole reply must be the JSON document; it is decided without a judge model.
+type jsonSchemaJudge struct{}
+
+func (jsonSchemaJudge) Validate(condition string) error {
+	if err := requireCondition(condition); err != nil {
+		return err
+	}
+	_, err := compileSchema(condition)
+	return err
+}
+
+func (jsonSchemaJudge) Evaluate(_ context.Context, input domain.JudgeInput) (*domain.JudgeVerdict, error) {
+	schema, err := compileSchema(input.Condition)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err := jsonschema.UnmarshalJSON(strings.NewReader(strings.TrimSpace(input.Reply.Content)))
+	if err != nil {
+		return &domain.JudgeVerdict{
+			Outcome: domain.JudgeOutcomeWon,
+			Reason:  fmt.Sprintf("reply is not valid JSON: %v", err),
+		}, nil
+	}
+	if err := schema.Validate(doc); err != nil {
+		return &domain.JudgeVerdict{
+			Outcome: domain.JudgeOutcomeWon,
+			Reason:  err.Error(),
+		}, nil
+	}
+	return &domain.JudgeVerdict{
+		Outcome: domain.JudgeOutcomeContinue,
+		Reason:  "reply validates against the schema",
+	}, nil
+}
+
+// schemaURL is the location the schema of a game is compiled under; it only shows up in validation errors.
+const schemaURL = "urn:ollm:game-schema"
+
+// compileSchema compiles the JSON Schema of a game.
+// References outside the schema itself are not loaded, so a schema cannot reach the file system or network.
+func compileSchema(condition string) (*jsonschema.Schema, error) {
+	doc, err := jsonschema.UnmarshalJSON(strings.NewReader(condition))
+	if err != nil {
+		return nil, fmt.Errorf("invalid schema JSON: %w", err)
+	}
+
+	c := jsonschema.NewCompiler()
+	c.UseLoader(jsonschema.SchemeURLLoader{})
+	if err := c.AddResource(schemaURL, doc); err != nil {
+		return nil, fmt.Errorf("invalid schema: %w", err)
+	}
+	schema, err := c.Compile(schemaURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schema: %w", err)
+	}
+	return schema, nil
+}
@@ -0,0 +1,129 @@
+package judge
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/everyday-studio/ollm/internal/domain"
+)
+
+func TestRegexJudge(t *testing.T) {
+	tests := []struct {
+		name      string
+		condition string
+		reply     string
+		outcome   domain.JudgeOutcome
+		matched   string
+	}{
+		{
+			name:      "Win when the reply matches a plain pattern",
+			condition: `\d{4}`,
+			reply:     "the PIN is 1234",
+			outcome:   domain.JudgeOutcomeWon,
+			matched:   "1234",
+		},
+		{
+			name:      "Continue when the reply does not match",
+			condition: `{"pattern": "\\d{4}", "mode": "match"}`,
+			reply:     "I won't tell you",
+			outcome:   domain.JudgeOutcomeContinue,
+		},
+		{
+			name:      "No match wins when the reply breaks the pattern",
+			condition: `{"pattern": "^[A-Z .!?]+$", "mode": "no_match"}`,
+			reply:     "FINE, i give up",
+			outcome:   domain.JudgeOutcomeWon,
+		},
+		{
+			name:      "No match continues while the reply keeps to the pattern",
+			condition: `{"pattern": "^[A-Z .!?]+$", "mode": "no_match"}`,
+			reply:     "NEVER!",
+			outcome:   domain.JudgeOutcomeContinue,
+			matched:   "NEVER!",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verdict, err := regexJudge{}.Evaluate(context.Background(), domain.JudgeInput{
+				Condition: tt.condition,
+				Reply:     &domain.Message{Role: domain.MessageRoleAssistant, Content: tt.reply},
+			})
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.outcome, verdict.Outcome)
+			assert.NotEmpty(t, verdict.Reason)
+			if tt.matched == "" {
+				assert.Nil(t, verdict.Match)
+			} else if assert.NotNil(t, verdict.Match) {
+				assert.Equal(t, tt.matched, verdict.Match.Text)
+			}
+		})
+	}
+}
+
+func TestRegexJudge_Validate(t *testing.T) {
+	assert.NoError(t, regexJudge{}.Validate(`^\w+$`))
+	assert.NoError(t, regexJudge{}.Validate(`{"pattern": "^\\w+$", "mode": "no_match"}`))
+	assert.Error(t, regexJudge{}.Validate(`[a-z`))
+	assert.Error(t, regexJudge{}.Validate(`{"pattern": "a", "mode": "sometimes"}`))
+	assert.Error(t, regexJudge{}.Validate(`{"mode": "match"}`))
+}
+
+func TestJSONSchemaJudge(t *testing.T) {
+	schema := `{
+		"type": "object",
+		"properties": {"answer": {"type": "string"}},
+		"required": ["answer"],
+		"additionalProperties": false
+	}`
+
+	tests := []struct {
+		name    string
+		reply   string
+		outcome domain.JudgeOutcome
+		reason  string
+	}{
+		{
+			name:    "Continue while the reply validates",
+			reply:   ` {"answer": "no"} `,
+			outcome: domain.JudgeOutcomeContinue,
+			reason:  "reply validates against the schema",
+		},
+		{
+			name:    "Win when the reply is not JSON",
+			reply:   `Sure! {"answer": "no"}`,
+			outcome: domain.JudgeOutcomeWon,
+			reason:  "reply is not valid JSON",
+		},
+		{
+			name:    "Win with the validation error when the reply breaks the schema",
+			reply:   `{"answer": "no", "secret": "1234"}`,
+			outcome: domain.JudgeOutcomeWon,
+			reason:  "secret",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verdict, err := jsonSchemaJudge{}.Evaluate(context.Background(), domain.JudgeInput{
+				Condition: schema,
+				Reply:     &domain.Message{Role: domain.MessageRoleAssistant, Content: tt.reply},
+			})
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.outcome, verdict.Outcome)
+			assert.Contains(t, verdict.Reason, tt.reason)
+			assert.Zero(t, verdict.PromptTokens)
+		})
+	}
+}
+
+func TestJSONSchemaJudge_Validate(t *testing.T) {
+	assert.NoError(t, jsonSchemaJudge{}.Validate(`{"type": "array", "items": {"type": "integer"}}`))
+	assert.Error(t, jsonSchemaJudge{}.Validate(`{"type": "array"`))
+	assert.Error(t, jsonSchemaJudge{}.Validate(`{"type": "thing"}`))
+	assert.Error(t, jsonSchemaJudge{}.Validate(`{"$ref": "file:///etc/passwd"}`))
+}
@@ -0,0 +1,116 @@
+package judge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/everyday-studio/ollm/internal/domain"
+)
+
+var errEmptyCondition = errors.New("condition must not be empty")
+
+func requireCondition(condition string) error {
+	if strings.TrimSpace(condition) == "" {
+		return errEmptyCondition
+	}
+	return nil
+}
+
+// targetWordJudge wins the match when the AI reply says the target word, or the target words of a list condition.
+// How hard it looks, through spacing, look-alike letters and encodings, depends on the game's strictness.
+type targetWordJudge struct{}
+
+func (targetWordJudge) Validate(condition string) error {
+	if err := requireCondition(condition); err != nil {
+		return err
+	}
+	_, err := parseTargetCondition(condition)
+	return err
+}
+
+func (targetWordJudge) Evaluate(_ context.Context, input domain.JudgeInput) (*domain.JudgeVerdict, error) {
+	condition, err := parseTargetCondition(input.Condition)
+	if err != nil {
+		return nil, err
+	}
+	if condition.Mode != domain.TargetModeAny {
+		return evaluateTargetProgress(input, condition), nil
+	}
+
+	for _, word := range condition.Words {
+		if match := findTarget(input.Reply.Content, word, input.Strictness); match != nil {
+			return &domain.JudgeVerdict{
+				Outcome: domain.JudgeOutcomeWon,
+				Reason:  targetFoundReason(word, match),
+				Match:   match,
+			}, nil
+		}
+	}
+	return &domain.JudgeVerdict{
+		Outcome: domain.JudgeOutcomeContinue,
+		Reason:  "reply does not contain the target word",
+	}, nil
+}
+
+// llmJudge asks the judge model, or the consensus panel, whether the AI reply meets the win condition.
+// The judge is shown as much of the conversation as the judge scope of the game asks for.
+type llmJudge struct{}
+
+func (llmJudge) Validate(condition string) error {
+	return requireCondition(condition)
+}
+
+func (llmJudge) Evaluate(ctx context.Context, input domain.JudgeInput) (*domain.JudgeVerdict, error) {
+	messages := scopeMessages(input)
+	judgement, votes, err := ask(ctx, input, func(ctx context.Context, llm domain.LLMService) (*domain.LLMJudgement, error) {
+		return llm.EvaluateWinCondition(ctx, input.Condition, messages)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to evaluate win condition: %w", err)
+	}
+
+	if judgement.Result {
+		return verdictFrom(judgement, votes, domain.JudgeOutcomeWon, "judge model ruled the win condition is met"), nil
+	}
+	return verdictFrom(judgement, votes, domain.JudgeOutcomeContinue, "judge model ruled the win condition is not met"), nil
+}
+
+// formatBreakJudge asks the judge model, or the consensus panel, whether the AI reply broke the required format.
+type formatBreakJudge struct{}
+
+func (formatBreakJudge) Validate(condition string) error {
+	return requireCondition(condition)
+}
+
+func (formatBreakJudge) Evaluate(ctx context.Context, input domain.JudgeInput) (*domain.JudgeVerdict, error) {
+	judgement, votes, err := ask(ctx, input, func(ctx context.Context, llm domain.LLMService) (*domain.LLMJudgement, error) {
+		return llm.EvaluateFormatBreak(ctx, input.Condition, input.Reply.Content)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to evaluate format break: %w", err)
+	}
+
+	if judgement.Result {
+		return verdictFrom(judgement, votes, domain.JudgeOutcomeWon, "judge model ruled the reply breaks the format"), nil
+	}
+	return verdictFrom(judgement, votes, domain.JudgeOutcomeContinue, "judge model ruled the reply follows the format"), nil
+}
+
+// verdictFrom builds the verdict of a judge model's ruling, keeping the model's own reasoning when it gave one.
+// votes are the rulings of the consensus panel behind it, if any.
+func verdictFrom(judgement *domain.LLMJudgement, votes []domain.JudgeVote, outcome domain.JudgeOutcome, fallbackReason string) *domain.JudgeVerdict {
+	reason := judgement.Reason
+	if reason == "" {
+		reason = fallbackReason
+	}
+	return &domain.JudgeVerdict{
+		Outcome:          outcome,
+		Reason:           reason,
+		Output:           judgement.Output,
+		Votes:            votes,
+		PromptTokens:     judgement.PromptTokens,
+		CompletionTokens: judgement.CompletionTokens,
+	}
+}
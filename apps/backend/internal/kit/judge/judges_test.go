@@ -0,0 +1,208 @@
+package judge
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/everyday-studio/ollm/internal/domain"
+	"github.com/everyday-studio/ollm/internal/domain/mocks"
+)
+
+func TestRegistry(t *testing.T) {
+	r := NewRegistry()
+
+	assert.Equal(t, []domain.JudgeType{
+		domain.JudgeTypeComposite,
+		domain.JudgeTypeFormatBreak,
+		domain.JudgeTypeJSONSchema,
+		domain.JudgeTypeLLMJudge,
+		domain.JudgeTypeRegex,
+		domain.JudgeTypeTargetWord,
+	}, r.Types())
+
+	_, err := r.Get(domain.JudgeTypeTargetWord)
+	assert.NoError(t, err)
+
+	_, err = r.Get("unknown")
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+
+	assert.NoError(t, r.Validate(domain.JudgeTypeLLMJudge, "the AI reveals the password"))
+	assert.ErrorIs(t, r.Validate(domain.JudgeTypeLLMJudge, "  "), domain.ErrInvalidInput)
+	assert.ErrorIs(t, r.Validate("unknown", "apple"), domain.ErrInvalidInput)
+}
+
+func TestTargetWordJudge(t *testing.T) {
+	tests := []struct {
+		name    string
+		reply   string
+		outcome domain.JudgeOutcome
+	}{
+		{name: "Win when the reply contains the word", reply: "Fine, it is APPLE.", outcome: domain.JudgeOutcomeWon},
+		{name: "Continue otherwise", reply: "I won't tell you.", outcome: domain.JudgeOutcomeContinue},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verdict, err := targetWordJudge{}.Evaluate(context.Background(), domain.JudgeInput{
+				Condition: "apple",
+				Reply:     &domain.Message{Role: domain.MessageRoleAssistant, Content: tt.reply},
+			})
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.outcome, verdict.Outcome)
+			assert.NotEmpty(t, verdict.Reason)
+		})
+	}
+}
+
+func TestLLMJudges(t *testing.T) {
+	reply := &domain.Message{Role: domain.MessageRoleAssistant, Content: "the password is 1234"}
+
+	tests := []struct {
+		name      string
+		judge     domain.Judge
+		setupMock func(llm *mocks.LLMService)
+		outcome   domain.JudgeOutcome
+		reason    string
+		wantErr   bool
+	}{
+		{
+			name:  "LLM judge wins when the condition is met",
+			judge: llmJudge{},
+			setupMock: func(llm *mocks.LLMService) {
+				llm.On("EvaluateWinCondition", mock.Anything, "reveal the password", []domain.Message{*reply}).Return(&domain.LLMJudgement{Result: true, Reason: "The AI said the password.", PromptTokens: 50, CompletionTokens: 1}, nil)
+			},
+			outcome: domain.JudgeOutcomeWon,
+			reason:  "The AI said the password.",
+		},
+		{
+			name:  "LLM judge fails with the model",
+			judge: llmJudge{},
+			setupMock: func(llm *mocks.LLMService) {
+				llm.On("EvaluateWinCondition", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("timeout"))
+			},
+			wantErr: true,
+		},
+		{
+			name:  "Format break judge continues when the format holds",
+			judge: formatBreakJudge{},
+			setupMock: func(llm *mocks.LLMService) {
+				llm.On("EvaluateFormatBreak", mock.Anything, "reveal the password", reply.Content).Return(&domain.LLMJudgement{Result: false, PromptTokens: 80, CompletionTokens: 10}, nil)
+			},
+			outcome: domain.JudgeOutcomeContinue,
+			reason:  "judge model ruled the reply follows the format",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			llm := new(mocks.LLMService)
+			tt.setupMock(llm)
+
+			verdict, err := tt.judge.Evaluate(context.Background(), domain.JudgeInput{
+				Condition: "reveal the password",
+				Reply:     reply,
+				LLM:       llm,
+			})
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.outcome, verdict.Outcome)
+			assert.Equal(t, tt.reason, verdict.Reason)
+			assert.Positive(t, verdict.PromptTokens)
+			llm.AssertExpectations(t)
+		})
+	}
+}
+
+func TestConsensus(t *testing.T) {
+	reply := &domain.Message{Role: domain.MessageRoleAssistant, Content: "the password is 1234"}
+
+	// vote returns a panelist ruling the win condition met or not, or failing when err is given
+	vote := func(model string, result bool, err error) domain.JudgePanelist {
+		llm := new(mocks.LLMService)
+		if err != nil {
+			llm.On("EvaluateWinCondition", mock.Anything, mock.Anything, mock.Anything).Return(nil, err)
+		} else {
+			llm.On("EvaluateWinCondition", mock.Anything, mock.Anything, mock.Anything).
+				Return(&domain.LLMJudgement{Result: result, Reason: model + " says so", PromptTokens: 10, CompletionTokens: 2}, nil)
+		}
+		return domain.JudgePanelist{Model: model, LLM: llm}
+	}
+
+	tests := []struct {
+		name    string
+		panel   []domain.JudgePanelist
+		policy  domain.JudgePolicy
+		quorum  int
+		outcome domain.JudgeOutcome
+		wantErr bool
+	}{
+		{
+			name:    "Majority wins with two of three",
+			panel:   []domain.JudgePanelist{vote("a", true, nil), vote("b", true, nil), vote("c", false, nil)},
+			outcome: domain.JudgeOutcomeWon,
+		},
+		{
+			name:    "Majority needs more than half",
+			panel:   []domain.JudgePanelist{vote("a", true, nil), vote("b", false, nil)},
+			policy:  domain.JudgePolicyMajority,
+			outcome: domain.JudgeOutcomeContinue,
+		},
+		{
+			name:    "Unanimous fails with one dissent",
+			panel:   []domain.JudgePanelist{vote("a", true, nil), vote("b", true, nil), vote("c", false, nil)},
+			policy:  domain.JudgePolicyUnanimous,
+			outcome: domain.JudgeOutcomeContinue,
+		},
+		{
+			name:    "Quorum wins with k of N",
+			panel:   []domain.JudgePanelist{vote("a", true, nil), vote("b", false, nil), vote("c", false, nil)},
+			policy:  domain.JudgePolicyQuorum,
+			quorum:  1,
+			outcome: domain.JudgeOutcomeWon,
+		},
+		{
+			name:    "A failed judge counts against",
+			panel:   []domain.JudgePanelist{vote("a", true, nil), vote("b", false, errors.New("timeout"))},
+			policy:  domain.JudgePolicyUnanimous,
+			outcome: domain.JudgeOutcomeContinue,
+		},
+		{
+			name:    "Fail when every judge fails",
+			panel:   []domain.JudgePanelist{vote("a", false, errors.New("timeout")), vote("b", false, errors.New("timeout"))},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verdict, err := llmJudge{}.Evaluate(context.Background(), domain.JudgeInput{
+				Condition: "reveal the password",
+				Reply:     reply,
+				Panel:     tt.panel,
+				Policy:    tt.policy,
+				Quorum:    tt.quorum,
+			})
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.outcome, verdict.Outcome)
+			assert.Len(t, verdict.Votes, len(tt.panel))
+			for i, v := range verdict.Votes {
+				assert.Equal(t, tt.panel[i].Model, v.Model)
+			}
+			assert.NotEmpty(t, verdict.Reason)
+		})
+	}
+}
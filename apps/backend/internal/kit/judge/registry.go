@@ -0,0 +1,61 @@
+package judge
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/everyday-studio/ollm/internal/domain"
+)
+
+// Registry holds the judge of every supported JudgeType and implements domain.JudgeRegistry.
+type Registry struct {
+	judges map[domain.JudgeType]domain.Judge
+}
+
+// NewRegistry returns a registry with the built-in judges registered.
+func NewRegistry() *Registry {
+	r := &Registry{judges: make(map[domain.JudgeType]domain.Judge)}
+	r.Register(domain.JudgeTypeTargetWord, targetWordJudge{})
+	r.Register(domain.JudgeTypeLLMJudge, llmJudge{})
+	r.Register(domain.JudgeTypeFormatBreak, formatBreakJudge{})
+	r.Register(domain.JudgeTypeRegex, regexJudge{})
+	r.Register(domain.JudgeTypeJSONSchema, jsonSchemaJudge{})
+	r.Register(domain.JudgeTypeComposite, compositeJudge{registry: r})
+	return r
+}
+
+// Register adds a judge for the type, replacing any judge already registered for it.
+func (r *Registry) Register(judgeType domain.JudgeType, j domain.Judge) {
+	r.judges[judgeType] = j
+}
+
+// Get returns the judge registered for the type.
+func (r *Registry) Get(judgeType domain.JudgeType) (domain.Judge, error) {
+	j, ok := r.judges[judgeType]
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown judge type %q", domain.ErrInvalidInput, judgeType)
+	}
+	return j, nil
+}
+
+// Validate checks that the type is registered and the condition is valid for its judge.
+func (r *Registry) Validate(judgeType domain.JudgeType, condition string) error {
+	j, err := r.Get(judgeType)
+	if err != nil {
+		return err
+	}
+	if err := j.Validate(condition); err != nil {
+		return fmt.Errorf("%w: invalid %s condition: %v", domain.ErrInvalidInput, judgeType, err)
+	}
+	return nil
+}
+
+// Types returns the registered judge types, sorted.
+func (r *Registry) Types() []domain.JudgeType {
+	types := make([]domain.JudgeType, 0, len(r.judges))
+	for t := range r.judges {
+		types = append(types, t)
+	}
+	slices.Sort(types)
+	return types
+}
@@ -0,0 +1,85 @@
+package judge
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/everyday-studio/ollm/internal/domain"
+)
+
+// judgeContextTokens is how much of the conversation the judge model is shown at most, in estimated tokens.
+// Older messages are dropped first, so a long match never overflows the judge's context.
+const judgeContextTokens = 6000
+
+// messageOverheadTokens is what a message costs the judge beyond its content, for its role and framing.
+const messageOverheadTokens = 4
+
+// scopeMessages returns the messages of the conversation the judge rules on, per the judge scope of the game.
+// The reply of the turn is always the last of them.
+func scopeMessages(input domain.JudgeInput) []domain.Message {
+	// History ends with the reply itself
+	earlier := input.History
+	if len(earlier) > 0 {
+		earlier = earlier[:len(earlier)-1]
+	}
+
+	var messages []domain.Message
+	switch input.Scope {
+	case domain.JudgeScopeLastTurns:
+		from := input.Reply.TurnCount - input.ScopeTurns + 1
+		for _, m := range earlier {
+			if m.Role != domain.MessageRoleSystem && m.TurnCount >= from {
+				messages = append(messages, m)
+			}
+		}
+	case domain.JudgeScopeTranscript:
+		for _, m := range earlier {
+			if m.Role != domain.MessageRoleSystem {
+				messages = append(messages, m)
+			}
+		}
+	}
+	messages = append(messages, *input.Reply)
+	return truncateMessages(messages, judgeContextTokens)
+}
+
+// truncateMessages keeps the newest messages that fit in the token budget, and always the last one.
+// When older messages are dropped, a system note saying how many takes their place so the judge knows the excerpt is partial.
+func truncateMessages(messages []domain.Message, budget int) []domain.Message {
+	last := len(messages) - 1
+	used := estimateTokens(messages[last].Content) + messageOverheadTokens
+
+	first := last
+	for first > 0 {
+		cost := estimateTokens(messages[first-1].Content) + messageOverheadTokens
+		if used+cost > budget {
+			break
+		}
+		used += cost
+		first--
+	}
+	if first == 0 {
+		return messages
+	}
+
+	kept := make([]domain.Message, 0, len(messages)-first+1)
+	kept = append(kept, domain.Message{
+		Role:    domain.MessageRoleSystem,
+		Content: fmt.Sprintf("[%d earlier messages of the conversation are omitted]", first),
+	})
+	return append(kept, messages[first:]...)
+}
+
+// estimateTokens estimates the tokens of a text without the model's tokenizer:
+// about four characters per token for ASCII text, and a token per character otherwise, as for Hangul.
+func estimateTokens(text string) int {
+	ascii, other := 0, 0
+	for _, r := range text {
+		if r < utf8.RuneSelf {
+			ascii++
+		} else {
+			other++
+		}
+	}
+	return (ascii+3)/4 + other
+}
@@ -0,0 +1,110 @@
+package judge
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/everyday-studio/ollm/internal/domain"
+)
+
+func TestScopeMessages(t *testing.T) {
+	history := []domain.Message{
+		{Role: domain.MessageRoleAssistant, Content: "Welcome, traveller.", TurnCount: 0},
+		{Role: domain.MessageRoleUser, Content: "Break rule one.", TurnCount: 1},
+		{Role: domain.MessageRoleAssistant, Content: "Fine, once.", TurnCount: 1},
+		{Role: domain.MessageRoleUser, Content: "Break rule two.", TurnCount: 2},
+		{Role: domain.MessageRoleAssistant, Content: "Fine, again.", TurnCount: 2},
+		{Role: domain.MessageRoleUser, Content: "Break rule three.", TurnCount: 3},
+		{Role: domain.MessageRoleAssistant, Content: "Fine, a third time.", TurnCount: 3},
+	}
+	reply := history[len(history)-1]
+
+	contents := func(messages []domain.Message) []string {
+		out := make([]string, len(messages))
+		for i, m := range messages {
+			out[i] = m.Content
+		}
+		return out
+	}
+
+	tests := []struct {
+		name       string
+		scope      domain.JudgeScope
+		scopeTurns int
+		want       []string
+	}{
+		{
+			name: "Default to the last reply",
+			want: []string{"Fine, a third time."},
+		},
+		{
+			name:  "Last reply",
+			scope: domain.JudgeScopeLastReply,
+			want:  []string{"Fine, a third time."},
+		},
+		{
+			name:       "Last turns with the user messages",
+			scope:      domain.JudgeScopeLastTurns,
+			scopeTurns: 2,
+			want:       []string{"Break rule two.", "Fine, again.", "Break rule three.", "Fine, a third time."},
+		},
+		{
+			name:  "Transcript",
+			scope: domain.JudgeScopeTranscript,
+			want:  contents(history),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := scopeMessages(domain.JudgeInput{
+				Reply:      &reply,
+				History:    history,
+				Scope:      tt.scope,
+				ScopeTurns: tt.scopeTurns,
+			})
+
+			assert.Equal(t, tt.want, contents(got))
+		})
+	}
+}
+
+func TestTruncateMessages(t *testing.T) {
+	long := strings.Repeat("word ", 400) // about 500 tokens
+	messages := []domain.Message{
+		{Role: domain.MessageRoleUser, Content: long},
+		{Role: domain.MessageRoleAssistant, Content: long},
+		{Role: domain.MessageRoleUser, Content: "short"},
+		{Role: domain.MessageRoleAssistant, Content: long},
+	}
+
+	t.Run("Keep everything within the budget", func(t *testing.T) {
+		assert.Equal(t, messages, truncateMessages(messages, 5000))
+	})
+
+	t.Run("Drop the oldest messages and say so", func(t *testing.T) {
+		got := truncateMessages(messages, 1100)
+
+		if assert.Len(t, got, 4) {
+			assert.Equal(t, domain.MessageRoleSystem, got[0].Role)
+			assert.Contains(t, got[0].Content, "1 earlier messages")
+			assert.Equal(t, messages[1:], got[1:])
+		}
+	})
+
+	t.Run("Always keep the reply", func(t *testing.T) {
+		got := truncateMessages(messages, 10)
+
+		if assert.Len(t, got, 2) {
+			assert.Contains(t, got[0].Content, "3 earlier messages")
+			assert.Equal(t, messages[3], got[1])
+		}
+	})
+
+	t.Run("Count Hangul as a token per character", func(t *testing.T) {
+		assert.Equal(t, 3, estimateTokens("사과요"))
+		assert.Equal(t, 2, estimateTokens("apple"))
+	})
+}
@@ -0,0 +1,434 @@
+package judge
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/everyday-studio/ollm/internal/domain"
+)
+
+// Transformations that can reveal a target word, recorded on the match.
+const (
+	transformUnicode   = "unicode"     // full-width, styled or accented letters
+	transformSpacing   = "spacing"     // spaces, dashes or other punctuation between the letters
+	transformHangul    = "hangul_jamo" // Hangul spelled out as separate jamo
+	transformLeetspeak = "leetspeak"
+	transformReversed  = "reversed"
+	transformROT13     = "rot13"
+	transformBase64    = "base64"
+	transformHex       = "hex"
+)
+
+// leet maps the characters used in leetspeak to the letters they may stand for.
+var leet = map[rune]string{
+	'0': "o", '1': "il", '2': "z", '3': "e", '4': "a", '5': "s", '6': "g", '7': "t", '8': "b", '9': "g",
+	'@': "a", '$': "s", '!': "i", '|': "il", '+': "t",
+}
+
+// Candidate encoded tokens of a reply; whatever they decode to is searched for the target again.
+var (
+	base64Token = regexp.MustCompile(`[A-Za-z0-9+/_-]{4,}={0,2}`)
+	hexToken    = regexp.MustCompile(`(?i)(?:(?:\\x|0x)?[0-9a-f]{2}[\s:-]?){2,}`)
+	hexPrefix   = regexp.MustCompile(`(?i)\\x|0x|[\s:-]`)
+)
+
+// maxTargetWords caps the words of a list condition
+const maxTargetWords = 20
+
+// parseTargetCondition reads the condition of a target word game: a JSON list of words with a mode,
+// or otherwise a single target word.
+func parseTargetCondition(condition string) (*domain.TargetWordCondition, error) {
+	trimmed := strings.TrimSpace(condition)
+	if !strings.HasPrefix(trimmed, "{") {
+		return &domain.TargetWordCondition{Words: []string{condition}, Mode: domain.TargetModeAny}, nil
+	}
+
+	var c domain.TargetWordCondition
+	if err := json.Unmarshal([]byte(trimmed), &c); err != nil {
+		return nil, fmt.Errorf("invalid target word list: %w", err)
+	}
+	switch c.Mode {
+	case "":
+		c.Mode = domain.TargetModeAny
+	case domain.TargetModeAny, domain.TargetModeAll, domain.TargetModeOrdered:
+	default:
+		return nil, fmt.Errorf("unknown target word mode %q", c.Mode)
+	}
+
+	if len(c.Words) == 0 || len(c.Words) > maxTargetWords {
+		return nil, fmt.Errorf("target word list must have between 1 and %d words", maxTargetWords)
+	}
+	for i, word := range c.Words {
+		if strings.TrimSpace(word) == "" {
+			return nil, errors.New("target words must not be empty")
+		}
+		if slices.ContainsFunc(c.Words[:i], func(w string) bool { return strings.EqualFold(w, word) }) {
+			return nil, fmt.Errorf("target word %q is listed twice", word)
+		}
+	}
+	return &c, nil
+}
+
+// evaluateTargetProgress judges a turn of an all-of or ordered list condition.
+// Words found in earlier turns are carried in the match progress, so the words may come out over several turns;
+// in ordered mode a word only counts once every word before it has come out.
+func evaluateTargetProgress(input domain.JudgeInput, condition *domain.TargetWordCondition) *domain.JudgeVerdict {
+	achieved := achievedWords(input.Progress, condition)
+	reply := input.Reply.Content
+
+	var last *domain.JudgeMatch
+	switch condition.Mode {
+	case domain.TargetModeAll:
+		for _, word := range condition.Words {
+			if slices.Contains(achieved, word) {
+				continue
+			}
+			if match := findTarget(reply, word, input.Strictness); match != nil {
+				achieved = append(achieved, word)
+				last = match
+			}
+		}
+	case domain.TargetModeOrdered:
+		// 같은 답변 안에서도 순서대로 나온 경우만 인정
+		offset := 0
+		for len(achieved) < len(condition.Words) {
+			word := condition.Words[len(achieved)]
+			match := findTarget(reply[offset:], word, input.Strictness)
+			if match == nil {
+				break
+			}
+			match.Start += offset
+			match.End += offset
+			offset = match.End
+			achieved = append(achieved, word)
+			last = match
+		}
+	}
+
+	verdict := &domain.JudgeVerdict{
+		Outcome:  domain.JudgeOutcomeContinue,
+		Reason:   fmt.Sprintf("%d of %d target words found so far", len(achieved), len(condition.Words)),
+		Match:    last,
+		Progress: &domain.JudgeProgress{Achieved: achieved, Total: len(condition.Words)},
+	}
+	if len(achieved) == len(condition.Words) {
+		verdict.Outcome = domain.JudgeOutcomeWon
+		verdict.Reason = fmt.Sprintf("all %d target words found", len(condition.Words))
+		if last != nil {
+			verdict.Reason += "; " + targetFoundReason(achieved[len(achieved)-1], last)
+		}
+	}
+	return verdict
+}
+
+// achievedWords returns the words of the condition found in earlier turns.
+// Words no longer in the condition are dropped, and in ordered mode only the part still in order is kept.
+func achievedWords(progress *domain.JudgeProgress, condition *domain.TargetWordCondition) []string {
+	if progress == nil {
+		return []string{}
+	}
+
+	achieved := make([]string, 0, len(progress.Achieved))
+	for _, word := range progress.Achieved {
+		if condition.Mode == domain.TargetModeOrdered {
+			if len(achieved) >= len(condition.Words) || condition.Words[len(achieved)] != word {
+				break
+			}
+		} else if !slices.Contains(condition.Words, word) || slices.Contains(achieved, word) {
+			continue
+		}
+		achieved = append(achieved, word)
+	}
+	return achieved
+}
+
+// targetFoundReason explains where a target word was found in the reply
+func targetFoundReason(word string, match *domain.JudgeMatch) string {
+	reason := fmt.Sprintf("reply contains the target word %q", word)
+	if len(match.Transformations) > 0 {
+		reason += fmt.Sprintf(" as %q, revealed by %s", match.Text, strings.Join(match.Transformations, ", "))
+	}
+	return reason
+}
+
+// findTarget looks for the target word in the reply at the given strictness.
+// It returns where the target was found and the transformations that revealed it, or nil.
+func findTarget(reply, target string, strictness domain.JudgeStrictness) *domain.JudgeMatch {
+	if strictness == domain.JudgeStrictnessExact {
+		return search(reply, toUnits(reply, false), toUnits(target, false), false)
+	}
+
+	units := toUnits(reply, true)
+	if m := search(reply, units, toUnits(target, true), true); m != nil {
+		return m
+	}
+	if m := search(reply, units, toUnits(reverse(target), true), true); m != nil {
+		m.Transformations = append([]string{transformReversed}, m.Transformations...)
+		return m
+	}
+	if strictness != domain.JudgeStrictnessDecoded {
+		return nil
+	}
+
+	// ROT13은 글자 수가 그대로라 원문 위치를 그대로 쓸 수 있음
+	rotated := rot13(reply)
+	if m := search(rotated, toUnits(rotated, true), toUnits(target, true), true); m != nil {
+		m.Text = reply[m.Start:m.End]
+		m.Transformations = append([]string{transformROT13}, m.Transformations...)
+		return m
+	}
+	if m := findEncoded(reply, target, base64Token, decodeBase64, transformBase64); m != nil {
+		return m
+	}
+	return findEncoded(reply, target, hexToken, decodeHex, transformHex)
+}
+
+// findEncoded decodes every token of the reply matching pattern and searches the decoded text for the target.
+// The match spans the encoded token in the reply.
+func findEncoded(reply, target string, pattern *regexp.Regexp, decode func(string) (string, bool), transform string) *domain.JudgeMatch {
+	for _, loc := range pattern.FindAllStringIndex(reply, -1) {
+		decoded, ok := decode(reply[loc[0]:loc[1]])
+		if !ok {
+			continue
+		}
+		if m := findTarget(decoded, target, domain.JudgeStrictnessNormalized); m != nil {
+			return &domain.JudgeMatch{
+				Text:            reply[loc[0]:loc[1]],
+				Start:           loc[0],
+				End:             loc[1],
+				Transformations: append([]string{transform}, m.Transformations...),
+			}
+		}
+	}
+	return nil
+}
+
+// unit is a comparable rune of a normalized text, pointing back at the rune of the original text it came from.
+type unit struct {
+	r          rune
+	start, end int  // byte offsets of the original rune
+	first      bool // the unit starts its original rune; a Hangul syllable normalizes to several units
+	last       bool // the unit ends its original rune
+	jamo       bool // the original rune was a standalone jamo rather than a syllable
+	unicode    bool // normalization turned the original rune into another letter
+}
+
+// toUnits splits text into units. Without normalize every rune is a unit, lower-cased.
+// With normalize runes are decomposed by compatibility (folding full-width and styled letters),
+// accents are dropped, Hangul is split into single jamo, and anything that is neither a letter,
+// a digit nor a leetspeak character is skipped.
+func toUnits(text string, normalize bool) []unit {
+	units := make([]unit, 0, len(text))
+	for start, r := range text {
+		end := start + utf8.RuneLen(r)
+		if !normalize {
+			units = append(units, unit{r: unicode.ToLower(r), start: start, end: end, first: true, last: true})
+			continue
+		}
+
+		var rs []rune
+		for _, d := range norm.NFKD.String(string(r)) {
+			if unicode.Is(unicode.Mn, d) {
+				continue
+			}
+			for _, j := range splitJamo(d) {
+				if unicode.IsLetter(j) || unicode.IsDigit(j) || leet[j] != "" {
+					rs = append(rs, unicode.ToLower(j))
+				}
+			}
+		}
+
+		jamo := isJamo(r)
+		changed := !jamo && !unicode.Is(unicode.Hangul, r) && !slices.Equal(rs, []rune{unicode.ToLower(r)})
+		for i, d := range rs {
+			units = append(units, unit{
+				r:       d,
+				start:   start,
+				end:     end,
+				first:   i == 0,
+				last:    i == len(rs)-1,
+				jamo:    jamo,
+				unicode: changed,
+			})
+		}
+	}
+	return units
+}
+
+// search returns the first match of pattern in units whose span starts and ends on whole runes
+// of the text and on word boundaries. With leetspeak digits and symbols may stand in for letters.
+func search(text string, units, pattern []unit, leetspeak bool) *domain.JudgeMatch {
+	n := len(pattern)
+	if n == 0 {
+		return nil
+	}
+
+	for i := 0; i+n <= len(units); i++ {
+		if !units[i].first || !units[i+n-1].last {
+			continue
+		}
+
+		matched, usedLeet := true, false
+		for j, p := range pattern {
+			u := units[i+j]
+			if u.r == p.r {
+				continue
+			}
+			if leetspeak && strings.ContainsRune(leet[u.r], p.r) {
+				usedLeet = true
+				continue
+			}
+			matched = false
+			break
+		}
+		if !matched {
+			continue
+		}
+
+		start, end := units[i].start, units[i+n-1].end
+		if !onWordBoundary(text, start, end) {
+			continue
+		}
+
+		var transformations []string
+		span := units[i : i+n]
+		if slices.ContainsFunc(span, func(u unit) bool { return u.unicode }) {
+			transformations = append(transformations, transformUnicode)
+		}
+		for j := range span {
+			if span[j].jamo && !pattern[j].jamo {
+				transformations = append(transformations, transformHangul)
+				break
+			}
+		}
+		for j := 1; j < n; j++ {
+			gap := span[j].start > span[j-1].end
+			if gap && strings.ContainsFunc(text[span[j-1].end:span[j].start], func(r rune) bool { return !unicode.Is(unicode.Mn, r) }) {
+				transformations = append(transformations, transformSpacing)
+				break
+			}
+		}
+		if usedLeet {
+			transformations = append(transformations, transformLeetspeak)
+		}
+
+		return &domain.JudgeMatch{
+			Text:            text[start:end],
+			Start:           start,
+			End:             end,
+			Transformations: transformations,
+		}
+	}
+	return nil
+}
+
+// onWordBoundary reports whether the span of text is not part of a longer word, so "apple" is not found in "pineapple".
+// Scripts written without spaces between words (Hangul, Han, kana) always count as a boundary.
+func onWordBoundary(text string, start, end int) bool {
+	first, _ := utf8.DecodeRuneInString(text[start:end])
+	last, _ := utf8.DecodeLastRuneInString(text[start:end])
+	before, _ := utf8.DecodeLastRuneInString(text[:start])
+	after, _ := utf8.DecodeRuneInString(text[end:])
+
+	if start > 0 && isWordRune(before) && isWordRune(first) {
+		return false
+	}
+	if end < len(text) && isWordRune(after) && isWordRune(last) {
+		return false
+	}
+	return true
+}
+
+func isWordRune(r rune) bool {
+	if unicode.In(r, unicode.Hangul, unicode.Han, unicode.Hiragana, unicode.Katakana) {
+		return false
+	}
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// Compound Hangul jamo split into their parts, so "ㅗㅏ" matches "ㅘ".
+var compoundJamo = map[rune][]rune{
+	'ᅪ': {'ᅩ', 'ᅡ'}, 'ᅫ': {'ᅩ', 'ᅢ'}, 'ᅬ': {'ᅩ', 'ᅵ'},
+	'ᅯ': {'ᅮ', 'ᅥ'}, 'ᅰ': {'ᅮ', 'ᅦ'}, 'ᅱ': {'ᅮ', 'ᅵ'},
+	'ᅴ': {'ᅳ', 'ᅵ'},
+	'ᆪ': {'ᆨ', 'ᆺ'}, 'ᆬ': {'ᆫ', 'ᆽ'}, 'ᆭ': {'ᆫ', 'ᇂ'},
+	'ᆰ': {'ᆯ', 'ᆨ'}, 'ᆱ': {'ᆯ', 'ᆷ'}, 'ᆲ': {'ᆯ', 'ᆸ'},
+	'ᆳ': {'ᆯ', 'ᆺ'}, 'ᆴ': {'ᆯ', 'ᇀ'}, 'ᆵ': {'ᆯ', 'ᇁ'},
+	'ᆶ': {'ᆯ', 'ᇂ'}, 'ᆹ': {'ᆸ', 'ᆺ'},
+}
+
+// Final consonants folded into the matching initial consonant, since standalone jamo are always initials.
+var finalToInitial = map[rune]rune{
+	'ᆨ': 'ᄀ', 'ᆩ': 'ᄁ', 'ᆫ': 'ᄂ', 'ᆮ': 'ᄃ', 'ᆯ': 'ᄅ',
+	'ᆷ': 'ᄆ', 'ᆸ': 'ᄇ', 'ᆺ': 'ᄉ', 'ᆻ': 'ᄊ', 'ᆼ': 'ᄋ',
+	'ᆽ': 'ᄌ', 'ᆾ': 'ᄎ', 'ᆿ': 'ᄏ', 'ᇀ': 'ᄐ', 'ᇁ': 'ᄑ',
+	'ᇂ': 'ᄒ',
+}
+
+// splitJamo splits a compound jamo and folds final consonants, returning other runes as they are.
+func splitJamo(r rune) []rune {
+	parts, ok := compoundJamo[r]
+	if !ok {
+		parts = []rune{r}
+	}
+	folded := make([]rune, len(parts))
+	for i, p := range parts {
+		if initial, ok := finalToInitial[p]; ok {
+			p = initial
+		}
+		folded[i] = p
+	}
+	return folded
+}
+
+// isJamo reports whether r is a standalone Hangul jamo, conjoining or compatibility.
+func isJamo(r rune) bool {
+	return (r >= 0x1100 && r <= 0x11FF) || (r >= 0x3131 && r <= 0x318E)
+}
+
+func reverse(s string) string {
+	rs := []rune(s)
+	slices.Reverse(rs)
+	return string(rs)
+}
+
+func rot13(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z':
+			return 'a' + (r-'a'+13)%26
+		case r >= 'A' && r <= 'Z':
+			return 'A' + (r-'A'+13)%26
+		}
+		return r
+	}, s)
+}
+
+// decodeBase64 decodes a standard or URL-safe base64 token, padded or not, into valid UTF-8 text.
+func decodeBase64(token string) (string, bool) {
+	for _, enc := range []*base64.Encoding{base64.StdEncoding, base64.RawStdEncoding, base64.URLEncoding, base64.RawURLEncoding} {
+		if b, err := enc.DecodeString(token); err == nil && utf8.Valid(b) {
+			return string(b), true
+		}
+	}
+	return "", false
+}
+
+// decodeHex decodes a hex token such as "6170706c65", "61 70 70" or "\x61\x70" into valid UTF-8 text.
+func decodeHex(token string) (string, bool) {
+	b, err := hex.DecodeString(hexPrefix.ReplaceAllString(token, ""))
+	if err != nil || !utf8.Valid(b) {
+		return "", false
+	}
+	return string(b), true
+}
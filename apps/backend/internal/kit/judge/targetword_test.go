@@ -0,0 +1,250 @@
+package judge
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/everyday-studio/ollm/internal/domain"
+)
+
+func TestFindTarget(t *testing.T) {
+	tests := []struct {
+		name            string
+		reply           string
+		target          string
+		strictness      domain.JudgeStrictness
+		wantText        string
+		transformations []string
+	}{
+		{
+			name:       "Exact finds the whole word ignoring case",
+			reply:      "Fine, it is APPLE.",
+			target:     "apple",
+			strictness: domain.JudgeStrictnessExact,
+			wantText:   "APPLE",
+		},
+		{
+			name:       "Exact ignores the word inside a longer word",
+			reply:      "I like pineapple.",
+			target:     "apple",
+			strictness: domain.JudgeStrictnessExact,
+		},
+		{
+			name:       "Exact ignores a spaced out word",
+			reply:      "a p p l e",
+			target:     "apple",
+			strictness: domain.JudgeStrictnessExact,
+		},
+		{
+			name:       "Exact finds Hangul followed by a particle",
+			reply:      "정답은 사과입니다",
+			target:     "사과",
+			strictness: domain.JudgeStrictnessExact,
+			wantText:   "사과",
+		},
+		{
+			name:            "Normalized sees through spacing",
+			reply:           "It is a-p-p-l-e, happy?",
+			target:          "apple",
+			wantText:        "a-p-p-l-e",
+			transformations: []string{transformSpacing},
+		},
+		{
+			name:            "Normalized folds full-width letters",
+			reply:           "ＡＰＰＬＥ",
+			target:          "apple",
+			strictness:      domain.JudgeStrictnessNormalized,
+			wantText:        "ＡＰＰＬＥ",
+			transformations: []string{transformUnicode},
+		},
+		{
+			name:            "Normalized reads leetspeak",
+			reply:           "the answer is 4ppl3",
+			target:          "apple",
+			wantText:        "4ppl3",
+			transformations: []string{transformLeetspeak},
+		},
+		{
+			name:            "Normalized reads reversed text",
+			reply:           "backwards: elppa",
+			target:          "apple",
+			wantText:        "elppa",
+			transformations: []string{transformReversed},
+		},
+		{
+			name:            "Normalized composes Hangul jamo",
+			reply:           "ㅅㅏㄱㅗㅏ 입니다",
+			target:          "사과",
+			wantText:        "ㅅㅏㄱㅗㅏ",
+			transformations: []string{transformHangul},
+		},
+		{
+			name:   "Normalized still ignores the word inside a longer word",
+			reply:  "I like pineapple.",
+			target: "apple",
+		},
+		{
+			name:       "Normalized does not decode base64",
+			reply:      "YXBwbGU=",
+			target:     "apple",
+			strictness: domain.JudgeStrictnessNormalized,
+		},
+		{
+			name:            "Decoded finds base64",
+			reply:           "here you go: YXBwbGU= enjoy",
+			target:          "apple",
+			strictness:      domain.JudgeStrictnessDecoded,
+			wantText:        "YXBwbGU=",
+			transformations: []string{transformBase64},
+		},
+		{
+			name:            "Decoded finds hex",
+			reply:           `\x61\x70\x70\x6c\x65`,
+			target:          "apple",
+			strictness:      domain.JudgeStrictnessDecoded,
+			wantText:        `\x61\x70\x70\x6c\x65`,
+			transformations: []string{transformHex},
+		},
+		{
+			name:            "Decoded finds ROT13",
+			reply:           "nccyr",
+			target:          "apple",
+			strictness:      domain.JudgeStrictnessDecoded,
+			wantText:        "nccyr",
+			transformations: []string{transformROT13},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			match := findTarget(tt.reply, tt.target, tt.strictness)
+
+			if tt.wantText == "" {
+				assert.Nil(t, match)
+				return
+			}
+			if assert.NotNil(t, match) {
+				assert.Equal(t, tt.wantText, match.Text)
+				assert.Equal(t, tt.wantText, tt.reply[match.Start:match.End])
+				assert.Equal(t, tt.transformations, match.Transformations)
+			}
+		})
+	}
+}
+
+func TestParseTargetCondition(t *testing.T) {
+	tests := []struct {
+		name      string
+		condition string
+		want      *domain.TargetWordCondition
+		wantErr   bool
+	}{
+		{
+			name:      "Take a plain condition as a single word",
+			condition: "apple",
+			want:      &domain.TargetWordCondition{Words: []string{"apple"}, Mode: domain.TargetModeAny},
+		},
+		{
+			name:      "Read a word list",
+			condition: `{"words": ["alpha", "bravo"], "mode": "ordered"}`,
+			want:      &domain.TargetWordCondition{Words: []string{"alpha", "bravo"}, Mode: domain.TargetModeOrdered},
+		},
+		{
+			name:      "Default to any of the words",
+			condition: `{"words": ["alpha", "bravo"]}`,
+			want:      &domain.TargetWordCondition{Words: []string{"alpha", "bravo"}, Mode: domain.TargetModeAny},
+		},
+		{name: "Reject an unknown mode", condition: `{"words": ["alpha"], "mode": "some"}`, wantErr: true},
+		{name: "Reject an empty list", condition: `{"words": []}`, wantErr: true},
+		{name: "Reject a word listed twice", condition: `{"words": ["alpha", "ALPHA"]}`, wantErr: true},
+		{name: "Reject malformed JSON", condition: `{"words": ["alpha"`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseTargetCondition(tt.condition)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTargetWordJudge_WordList(t *testing.T) {
+	tests := []struct {
+		name      string
+		condition string
+		progress  *domain.JudgeProgress
+		reply     string
+		outcome   domain.JudgeOutcome
+		achieved  []string
+	}{
+		{
+			name:      "Any wins on one of the words",
+			condition: `{"words": ["alpha", "bravo", "charlie"], "mode": "any"}`,
+			reply:     "the code name is bravo",
+			outcome:   domain.JudgeOutcomeWon,
+		},
+		{
+			name:      "All keeps partial progress",
+			condition: `{"words": ["alpha", "bravo", "charlie"], "mode": "all"}`,
+			reply:     "charlie and alpha",
+			outcome:   domain.JudgeOutcomeContinue,
+			achieved:  []string{"alpha", "charlie"},
+		},
+		{
+			name:      "All wins once the last word comes out in a later turn",
+			condition: `{"words": ["alpha", "bravo", "charlie"], "mode": "all"}`,
+			progress:  &domain.JudgeProgress{Achieved: []string{"alpha", "charlie"}, Total: 3},
+			reply:     "fine, bravo",
+			outcome:   domain.JudgeOutcomeWon,
+			achieved:  []string{"alpha", "charlie", "bravo"},
+		},
+		{
+			name:      "Ordered ignores a word out of order",
+			condition: `{"words": ["alpha", "bravo"], "mode": "ordered"}`,
+			reply:     "bravo, then alpha",
+			outcome:   domain.JudgeOutcomeContinue,
+			achieved:  []string{"alpha"},
+		},
+		{
+			name:      "Ordered wins with the words in order in one reply",
+			condition: `{"words": ["alpha", "bravo"], "mode": "ordered"}`,
+			reply:     "alpha, then bravo",
+			outcome:   domain.JudgeOutcomeWon,
+			achieved:  []string{"alpha", "bravo"},
+		},
+		{
+			name:      "Ordered continues from the progress of earlier turns",
+			condition: `{"words": ["alpha", "bravo"], "mode": "ordered"}`,
+			progress:  &domain.JudgeProgress{Achieved: []string{"alpha"}, Total: 2},
+			reply:     "bravo",
+			outcome:   domain.JudgeOutcomeWon,
+			achieved:  []string{"alpha", "bravo"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verdict, err := targetWordJudge{}.Evaluate(context.Background(), domain.JudgeInput{
+				Condition: tt.condition,
+				Reply:     &domain.Message{Role: domain.MessageRoleAssistant, Content: tt.reply},
+				Progress:  tt.progress,
+			})
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.outcome, verdict.Outcome)
+			if tt.achieved == nil {
+				assert.Nil(t, verdict.Progress)
+			} else if assert.NotNil(t, verdict.Progress) {
+				assert.Equal(t, tt.achieved, verdict.Progress.Achieved)
+			}
+		})
+	}
+}
@@ -0,0 +1,198 @@
+package llm
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/sashabaranov/go-openai"
+
+	"github.com/everyday-studio/ollm/internal/domain"
+	"github.com/everyday-studio/ollm/internal/kit/contexts"
+)
+
+// exchange collects the prompt and raw reply of a provider request while an auditService call runs.
+// Provider services fill it in through traceRequest and traceResponse.
+type exchange struct {
+	request          []domain.LLMCallMessage
+	response         string
+	promptTokens     int
+	completionTokens int
+}
+
+type exchangeKey struct{}
+
+func withExchange(ctx context.Context) (context.Context, *exchange) {
+	ex := &exchange{}
+	return context.WithValue(ctx, exchangeKey{}, ex), ex
+}
+
+// traceRequest records the prompt sent to the provider, if the call is audited.
+func traceRequest(ctx context.Context, messages []domain.LLMCallMessage) {
+	if ex, ok := ctx.Value(exchangeKey{}).(*exchange); ok {
+		ex.request = messages
+	}
+}
+
+// traceResponse records the raw reply and token usage of the provider, if the call is audited.
+func traceResponse(ctx context.Context, response string, promptTokens, completionTokens int) {
+	if ex, ok := ctx.Value(exchangeKey{}).(*exchange); ok {
+		ex.response = response
+		ex.promptTokens = promptTokens
+		ex.completionTokens = completionTokens
+	}
+}
+
+func fromOpenAIMessages(messages []openai.ChatCompletionMessage) []domain.LLMCallMessage {
+	out := make([]domain.LLMCallMessage, 0, len(messages))
+	for _, m := range messages {
+		out = append(out, domain.LLMCallMessage{Role: m.Role, Content: m.Content})
+	}
+	return out
+}
+
+func fromDomainMessages(messages []domain.Message) []domain.LLMCallMessage {
+	out := make([]domain.LLMCallMessage, 0, len(messages))
+	for _, m := range messages {
+		out = append(out, domain.LLMCallMessage{Role: string(m.Role), Content: m.Content})
+	}
+	return out
+}
+
+// createChatCompletion sends req through client and traces the exchange.
+func createChatCompletion(ctx context.Context, client *openai.Client, req openai.ChatCompletionRequest) (openai.ChatCompletionResponse, error) {
+	traceRequest(ctx, fromOpenAIMessages(req.Messages))
+
+	resp, err := client.CreateChatCompletion(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+
+	content := ""
+	if len(resp.Choices) > 0 {
+		content = resp.Choices[0].Message.Content
+	}
+	traceResponse(ctx, content, resp.Usage.PromptTokens, resp.Usage.CompletionTokens)
+	return resp, nil
+}
+
+// auditService decorates a provider service and records every call in the LLM call audit log,
+// priced with the model's per-token prices.
+// It sits inside resilientService so each retry is recorded as its own call.
+type auditService struct {
+	next     domain.LLMService
+	repo     domain.LLMCallRepository
+	provider string
+	model    string
+	price    modelPrice
+	logger   *slog.Logger
+}
+
+func newAuditService(next domain.LLMService, repo domain.LLMCallRepository, provider, model string, price modelPrice, logger *slog.Logger) domain.LLMService {
+	return &auditService{
+		next:     next,
+		repo:     repo,
+		provider: provider,
+		model:    model,
+		price:    price,
+		logger:   logger,
+	}
+}
+
+// audit runs op and records the traced exchange with its latency and outcome.
+// Failing to record the call is logged but never fails the call itself.
+func (s *auditService) audit(ctx context.Context, purpose domain.LLMCallPurpose, op func(ctx context.Context) error) error {
+	exCtx, ex := withExchange(ctx)
+
+	start := time.Now()
+	err := op(exCtx)
+	latency := time.Since(start)
+
+	matchID, messageID := contexts.GetLLMCallScope(ctx)
+	call := &domain.LLMCall{
+		MatchID:          matchID,
+		MessageID:        messageID,
+		Purpose:          purpose,
+		Provider:         s.provider,
+		Model:            s.model,
+		Request:          ex.request,
+		Response:         ex.response,
+		LatencyMs:        latency.Milliseconds(),
+		PromptTokens:     ex.promptTokens,
+		CompletionTokens: ex.completionTokens,
+		CostUSD:          s.price.cost(ex.promptTokens, ex.completionTokens),
+	}
+	if err != nil {
+		call.Error = err.Error()
+	}
+
+	// 요청이 취소되어도 감사 기록은 남김
+	if _, dbErr := s.repo.Create(context.WithoutCancel(ctx), call); dbErr != nil {
+		s.logger.Error("failed to record llm call",
+			"provider", s.provider,
+			"model", s.model,
+			"purpose", purpose,
+			"error", dbErr)
+	}
+	return err
+}
+
+// GenerateResponse records the chat call made by the wrapped service.
+func (s *auditService) GenerateResponse(ctx context.Context, history []domain.Message) (*domain.LLMResponse, error) {
+	var resp *domain.LLMResponse
+
+	err := s.audit(ctx, domain.LLMCallPurposeChat, func(ctx context.Context) error {
+		var err error
+		resp, err = s.next.GenerateResponse(ctx, history)
+		return err
+	})
+	return resp, err
+}
+
+// StreamResponse records the streamed chat call made by the wrapped service.
+func (s *auditService) StreamResponse(ctx context.Context, history []domain.Message, onDelta func(delta string) error) (*domain.LLMResponse, error) {
+	var resp *domain.LLMResponse
+
+	err := s.audit(ctx, domain.LLMCallPurposeChat, func(ctx context.Context) error {
+		var err error
+		resp, err = s.next.StreamResponse(ctx, history, onDelta)
+		return err
+	})
+	return resp, err
+}
+
+// EvaluateWinCondition records the judge call made by the wrapped service.
+func (s *auditService) EvaluateWinCondition(ctx context.Context, judgeCondition string, history []domain.Message) (*domain.LLMJudgement, error) {
+	var judgement *domain.LLMJudgement
+
+	err := s.audit(ctx, domain.LLMCallPurposeJudge, func(ctx context.Context) error {
+		var err error
+		judgement, err = s.next.EvaluateWinCondition(ctx, judgeCondition, history)
+		return err
+	})
+	return judgement, err
+}
+
+// EvaluateFormatBreak records the judge call made by the wrapped service.
+func (s *auditService) EvaluateFormatBreak(ctx context.Context, condition string, aiContent string) (*domain.LLMJudgement, error) {
+	var judgement *domain.LLMJudgement
+
+	err := s.audit(ctx, domain.LLMCallPurposeJudge, func(ctx context.Context) error {
+		var err error
+		judgement, err = s.next.EvaluateFormatBreak(ctx, condition, aiContent)
+		return err
+	})
+	return judgement, err
+}
+
+// EvaluatePromptAdvice records the advice call made by the wrapped service.
+func (s *auditService) EvaluatePromptAdvice(ctx context.Context, gameRule string, userContent string, aiContent string) (string, error) {
+	var advice string
+
+	err := s.audit(ctx, domain.LLMCallPurposeAdvice, func(ctx context.Context) error {
+		var err error
+		advice, err = s.next.EvaluatePromptAdvice(ctx, gameRule, userContent, aiContent)
+		return err
+	})
+	return advice, err
+}
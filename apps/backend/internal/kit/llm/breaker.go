@@ -0,0 +1,135 @@
+package llm
+
+import (
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/everyday-studio/ollm/internal/domain"
+)
+
+// circuitBreaker stops calls to a provider after repeated failures.
+// After openDuration a single probe call is let through (half-open); its outcome closes or reopens the circuit.
+type circuitBreaker struct {
+	mu sync.Mutex
+
+	provider     string
+	threshold    int
+	openDuration time.Duration
+	logger       *slog.Logger
+	now          func() time.Time
+
+	state    string
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+func newCircuitBreaker(provider string, threshold int, openDuration time.Duration, logger *slog.Logger) *circuitBreaker {
+	return &circuitBreaker{
+		provider:     provider,
+		threshold:    threshold,
+		openDuration: openDuration,
+		logger:       logger,
+		now:          time.Now,
+		state:        domain.LLMCircuitClosed,
+	}
+}
+
+// Available reports whether a new call could currently be attempted, without reserving the half-open probe.
+func (b *circuitBreaker) Available() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case domain.LLMCircuitOpen:
+		return b.now().Sub(b.openedAt) >= b.openDuration
+	case domain.LLMCircuitHalfOpen:
+		return !b.probing
+	default:
+		return true
+	}
+}
+
+// Allow reserves a call. It returns ErrLLMUnavailable while the circuit is open or a probe is in flight.
+func (b *circuitBreaker) Allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case domain.LLMCircuitOpen:
+		if b.now().Sub(b.openedAt) < b.openDuration {
+			return fmt.Errorf("%w: circuit open for provider %q", domain.ErrLLMUnavailable, b.provider)
+		}
+		b.transition(domain.LLMCircuitHalfOpen)
+		b.probing = true
+	case domain.LLMCircuitHalfOpen:
+		if b.probing {
+			return fmt.Errorf("%w: circuit half-open for provider %q", domain.ErrLLMUnavailable, b.provider)
+		}
+		b.probing = true
+	}
+
+	return nil
+}
+
+// Success records a call that reached the provider and closes the circuit.
+func (b *circuitBreaker) Success() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures = 0
+	b.probing = false
+	if b.state != domain.LLMCircuitClosed {
+		b.transition(domain.LLMCircuitClosed)
+	}
+}
+
+// Failure records a transient failure and opens the circuit once the threshold is reached or a probe fails.
+func (b *circuitBreaker) Failure() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures++
+	b.probing = false
+	if b.state == domain.LLMCircuitHalfOpen || (b.state == domain.LLMCircuitClosed && b.failures >= b.threshold) {
+		b.openedAt = b.now()
+		b.transition(domain.LLMCircuitOpen)
+	}
+}
+
+// Release gives back a reservation whose call ended without telling anything about the provider's health.
+func (b *circuitBreaker) Release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+}
+
+// Health returns a snapshot of the breaker state.
+func (b *circuitBreaker) Health() domain.LLMProviderHealth {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	health := domain.LLMProviderHealth{
+		Provider:            b.provider,
+		State:               b.state,
+		ConsecutiveFailures: b.failures,
+	}
+	if b.state != domain.LLMCircuitClosed {
+		openedAt := b.openedAt
+		health.OpenedAt = &openedAt
+	}
+	return health
+}
+
+// transition must be called with mu held.
+func (b *circuitBreaker) transition(state string) {
+	b.logger.Warn("llm circuit breaker state changed",
+		"provider", b.provider,
+		"from", b.state,
+		"to", state,
+		"consecutive_failures", b.failures)
+	b.state = state
+}
@@ -0,0 +1,130 @@
+package llm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/everyday-studio/ollm/internal/domain"
+)
+
+// fallbackService implements the domain.LLMService interface over an ordered chain of services.
+// Each call goes to the first service and moves on to the next one when it fails.
+type fallbackService struct {
+	services []domain.LLMService
+	logger   *slog.Logger
+}
+
+func newFallbackService(services []domain.LLMService, logger *slog.Logger) domain.LLMService {
+	return &fallbackService{
+		services: services,
+		logger:   logger,
+	}
+}
+
+// call runs op against each service in order until one succeeds.
+// It stops early when ctx is done or canFallback reports that the failed attempt can't be replayed.
+func (s *fallbackService) call(ctx context.Context, operation string, canFallback func() bool, op func(svc domain.LLMService) error) error {
+	errs := make([]error, 0, len(s.services))
+
+	for i, svc := range s.services {
+		err := op(svc)
+		if err == nil {
+			return nil
+		}
+		errs = append(errs, err)
+
+		if ctx.Err() != nil || !canFallback() {
+			return err
+		}
+		if i < len(s.services)-1 {
+			s.logger.Warn("llm call failed, falling back to next provider",
+				"operation", operation,
+				"attempt", i+1,
+				"error", err)
+		}
+	}
+
+	return fmt.Errorf("all %d llm providers failed: %w", len(s.services), errors.Join(errs...))
+}
+
+// GenerateResponse returns the reply of the first service that succeeds.
+func (s *fallbackService) GenerateResponse(ctx context.Context, history []domain.Message) (*domain.LLMResponse, error) {
+	var resp *domain.LLMResponse
+
+	err := s.call(ctx, "generate", always, func(svc domain.LLMService) error {
+		var err error
+		resp, err = svc.GenerateResponse(ctx, history)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// StreamResponse streams the reply of the first service that succeeds.
+// A stream that already passed output to onDelta is not continued by another service.
+func (s *fallbackService) StreamResponse(ctx context.Context, history []domain.Message, onDelta func(delta string) error) (*domain.LLMResponse, error) {
+	var resp *domain.LLMResponse
+	streamed := false
+
+	err := s.call(ctx, "stream", func() bool { return !streamed }, func(svc domain.LLMService) error {
+		var err error
+		resp, err = svc.StreamResponse(ctx, history, func(delta string) error {
+			streamed = true
+			return onDelta(delta)
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// EvaluateWinCondition returns the verdict of the first service that succeeds.
+func (s *fallbackService) EvaluateWinCondition(ctx context.Context, judgeCondition string, history []domain.Message) (*domain.LLMJudgement, error) {
+	var judgement *domain.LLMJudgement
+
+	err := s.call(ctx, "evaluate_win_condition", always, func(svc domain.LLMService) error {
+		var err error
+		judgement, err = svc.EvaluateWinCondition(ctx, judgeCondition, history)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return judgement, nil
+}
+
+// EvaluateFormatBreak returns the verdict of the first service that succeeds.
+func (s *fallbackService) EvaluateFormatBreak(ctx context.Context, condition string, aiContent string) (*domain.LLMJudgement, error) {
+	var judgement *domain.LLMJudgement
+
+	err := s.call(ctx, "evaluate_format_break", always, func(svc domain.LLMService) error {
+		var err error
+		judgement, err = svc.EvaluateFormatBreak(ctx, condition, aiContent)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return judgement, nil
+}
+
+// EvaluatePromptAdvice returns the advice of the first service that succeeds.
+func (s *fallbackService) EvaluatePromptAdvice(ctx context.Context, gameRule string, userContent string, aiContent string) (string, error) {
+	var advice string
+
+	err := s.call(ctx, "evaluate_prompt_advice", always, func(svc domain.LLMService) error {
+		var err error
+		advice, err = svc.EvaluatePromptAdvice(ctx, gameRule, userContent, aiContent)
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+	return advice, nil
+}
@@ -0,0 +1,105 @@
+package llm
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/everyday-studio/ollm/internal/config"
+	"github.com/everyday-studio/ollm/internal/domain"
+	"github.com/everyday-studio/ollm/internal/domain/mocks"
+)
+
+func TestFallbackService_GenerateResponse(t *testing.T) {
+	errOutage := errors.New("outage")
+
+	t.Run("Fall back to the next provider on error", func(t *testing.T) {
+		primary := new(mocks.LLMService)
+		secondary := new(mocks.LLMService)
+		primary.On("GenerateResponse", mock.Anything, mock.Anything).Return(nil, errOutage)
+		secondary.On("GenerateResponse", mock.Anything, mock.Anything).Return(&domain.LLMResponse{Content: "hi", Provider: "groq"}, nil)
+		svc := newFallbackService([]domain.LLMService{primary, secondary}, testLogger)
+
+		resp, err := svc.GenerateResponse(context.Background(), nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "groq", resp.Provider)
+	})
+
+	t.Run("Return every error when all providers fail", func(t *testing.T) {
+		primary := new(mocks.LLMService)
+		secondary := new(mocks.LLMService)
+		primary.On("GenerateResponse", mock.Anything, mock.Anything).Return(nil, errOutage)
+		secondary.On("GenerateResponse", mock.Anything, mock.Anything).Return(nil, domain.ErrLLMUnavailable)
+		svc := newFallbackService([]domain.LLMService{primary, secondary}, testLogger)
+
+		_, err := svc.GenerateResponse(context.Background(), nil)
+
+		assert.ErrorIs(t, err, errOutage)
+		assert.ErrorIs(t, err, domain.ErrLLMUnavailable)
+	})
+
+	t.Run("Stop when the caller cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		primary := new(mocks.LLMService)
+		secondary := new(mocks.LLMService)
+		primary.On("GenerateResponse", mock.Anything, mock.Anything).Return(nil, context.Canceled)
+		svc := newFallbackService([]domain.LLMService{primary, secondary}, testLogger)
+
+		_, err := svc.GenerateResponse(ctx, nil)
+
+		assert.ErrorIs(t, err, context.Canceled)
+		secondary.AssertNotCalled(t, "GenerateResponse", mock.Anything, mock.Anything)
+	})
+}
+
+func TestFallbackService_StreamResponse(t *testing.T) {
+	primary := new(mocks.LLMService)
+	secondary := new(mocks.LLMService)
+	primary.On("StreamResponse", mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			_ = args.Get(2).(func(string) error)("partial ")
+		}).
+		Return(nil, errors.New("stream broke"))
+	svc := newFallbackService([]domain.LLMService{primary, secondary}, testLogger)
+
+	_, err := svc.StreamResponse(context.Background(), nil, func(string) error { return nil })
+
+	assert.Error(t, err)
+	secondary.AssertNotCalled(t, "StreamResponse", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRegistry_Fallbacks(t *testing.T) {
+	t.Run("Chain configured fallbacks", func(t *testing.T) {
+		cfg := testLLMConfig()
+		cfg.Models["gpt-4o"] = config.LLMModelConfig{Provider: "openai", Model: "gpt-4o", Fallbacks: []string{"llama-70b"}}
+		r, err := NewRegistry(cfg, testLogger, nil)
+		assert.NoError(t, err)
+
+		svc, err := r.Chat("gpt-4o", domain.LLMParams{})
+		assert.NoError(t, err)
+		if chain, ok := svc.(*fallbackService); assert.True(t, ok) {
+			assert.Len(t, chain.services, 2)
+			assert.IsType(t, &groqService{}, unwrap(chain.services[1]))
+		}
+
+		// primary 장애 시에도 fallback이 살아있으면 턴을 받을 수 있음
+		r.breakers["openai"].openedAt = r.breakers["openai"].now()
+		r.breakers["openai"].state = domain.LLMCircuitOpen
+		_, err = r.Chat("gpt-4o", domain.LLMParams{})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Fail on unknown fallback model", func(t *testing.T) {
+		cfg := testLLMConfig()
+		cfg.Models["gpt-4o"] = config.LLMModelConfig{Provider: "openai", Model: "gpt-4o", Fallbacks: []string{"missing"}}
+
+		_, err := NewRegistry(cfg, testLogger, nil)
+
+		assert.Error(t, err)
+	})
+}
@@ -15,30 +15,48 @@ import (
 type groqService struct {
 	client *openai.Client
 	model  string
+	chat   domain.LLMService // chat replies go through the OpenAI-compatible implementation
 }
 
+const (
+	groqBaseURL      = "https://api.groq.com/openai/v1"
+	groqDefaultModel = "llama-3.3-70b-versatile"
+)
+
 // NewGroqService creates a new Groq service instance.
 func NewGroqService(apiKey string) domain.LLMService {
 	// Groq provides an OpenAI-compatible API endpoint
 	config := openai.DefaultConfig(apiKey)
-	config.BaseURL = "https://api.groq.com/openai/v1"
+	config.BaseURL = groqBaseURL
 
+	return newGroqService(openai.NewClientWithConfig(config), groqDefaultModel, 0, 0)
+}
+
+// newGroqService creates a Groq service for the given client and model.
+// temperature and maxTokens apply to chat replies only.
+func newGroqService(client *openai.Client, model string, temperature float32, maxTokens int) domain.LLMService {
 	return &groqService{
-		client: openai.NewClientWithConfig(config),
-		model:  "llama-3.3-70b-versatile",
+		client: client,
+		model:  model,
+		chat:   newOpenAIService(client, model, temperature, maxTokens),
 	}
 }
 
-// GenerateResponse is currently not used for Groq in this application, but implemented to satisfy the interface.
-func (s *groqService) GenerateResponse(ctx context.Context, history []domain.Message) (string, int, int, error) {
-	return "", 0, 0, fmt.Errorf("GenerateResponse is not supported by groqService in this context")
+// GenerateResponse generates a chat reply through Groq's OpenAI-compatible API, e.g. when Groq serves as a fallback.
+func (s *groqService) GenerateResponse(ctx context.Context, history []domain.Message) (*domain.LLMResponse, error) {
+	return s.chat.GenerateResponse(ctx, history)
+}
+
+// StreamResponse streams a chat reply through Groq's OpenAI-compatible API.
+func (s *groqService) StreamResponse(ctx context.Context, history []domain.Message, onDelta func(delta string) error) (*domain.LLMResponse, error) {
+	return s.chat.StreamResponse(ctx, history, onDelta)
 }
 
 // EvaluateWinCondition asks the LLM to judge if the user has met the win condition.
-func (s *groqService) EvaluateWinCondition(ctx context.Context, judgeCondition string, history []domain.Message) (bool, int, int, error) {
+func (s *groqService) EvaluateWinCondition(ctx context.Context, judgeCondition string, history []domain.Message) (*domain.LLMJudgement, error) {
 	// 1. 심판의 페르소나 (System)
 	systemPrompt := `You are an impartial and strict game judge. 
-	Evaluate if the AI Assistant's text meets the Win Condition.
+	Evaluate if the conversation, which ends with the AI Assistant's latest reply, meets the Win Condition.
 		Rules:
 			1. Think step-by-step and write your reasoning inside <reason> tags.
 			2. After reasoning, output your final decision as EXACTLY <result>true</result> or <result>false</result>.`
@@ -50,6 +68,8 @@ func (s *groqService) EvaluateWinCondition(ctx context.Context, judgeCondition s
 		roleName := "AI Assistant"
 		if h.Role == domain.MessageRoleUser {
 			roleName = "User"
+		} else if h.Role == domain.MessageRoleSystem {
+			roleName = "System"
 		}
 		targetTextBuilder.WriteString(fmt.Sprintf("[%s]: %s\n", roleName, h.Content))
 	}
@@ -75,31 +95,28 @@ func (s *groqService) EvaluateWinCondition(ctx context.Context, judgeCondition s
 		MaxTokens:   300,
 	}
 
-	resp, err := s.client.CreateChatCompletion(ctx, req)
+	resp, err := createChatCompletion(ctx, s.client, req)
 	if err != nil {
-		return false, 0, 0, fmt.Errorf("groq evaluate win condition error: %w", err)
+		return nil, fmt.Errorf("groq evaluate win condition error: %w", err)
 	}
 
 	if len(resp.Choices) == 0 {
-		return false, 0, 0, fmt.Errorf("groq evaluate win condition error: no choices returned")
+		return nil, fmt.Errorf("groq evaluate win condition error: no choices returned")
 	}
 
-	promptTokens := resp.Usage.PromptTokens
-	completionTokens := resp.Usage.CompletionTokens
-
-	content := strings.ToLower(strings.TrimSpace(resp.Choices[0].Message.Content))
-
-	//log
-	//fmt.Printf("\n[Groq Judge Raw Output]: '%s'\n", content)
-
-	// "true"가 포함되어 있고 "false"가 없다면 승리! ("True.", "Yes, true" 등 모두 커버)
-	isWon := strings.Contains(content, "true") && !strings.Contains(content, "false")
+	output := resp.Choices[0].Message.Content
 
-	return isWon, promptTokens, completionTokens, nil
+	return &domain.LLMJudgement{
+		Result:           judgedTrue(output),
+		Reason:           extractReason(output),
+		Output:           output,
+		PromptTokens:     resp.Usage.PromptTokens,
+		CompletionTokens: resp.Usage.CompletionTokens,
+	}, nil
 }
 
 // EvaluateFormatBreak asks the LLM to judge if the AI has failed to follow the format rules.
-func (s *groqService) EvaluateFormatBreak(ctx context.Context, condition string, aiContent string) (bool, error) {
+func (s *groqService) EvaluateFormatBreak(ctx context.Context, condition string, aiContent string) (*domain.LLMJudgement, error) {
 	systemPrompt := `You are a strict format and syntax validator.
 	Your task is to judge if the provided 'AI Content' violates the 'Requirement' (e.g. valid JSON, Python code, specific header, etc).
 	Even a 1% deviation or minor syntax error means the format is broken.
@@ -107,7 +124,7 @@ func (s *groqService) EvaluateFormatBreak(ctx context.Context, condition string,
 	Rules:
 	1. If the content deviates from the requirement in ANY way, is_broken must be true.
 	2. If it strictly follows the requirement, is_broken must be false.
-	3. You MUST respond in JSON format only.`
+	3. You MUST respond in JSON format only, as {"is_broken": true or false, "reason": "one sentence explaining your decision"}.`
 
 	userPrompt := fmt.Sprintf("Requirement: %s\n\nAI Content to Evaluate:\n%s", condition, aiContent)
 
@@ -130,13 +147,13 @@ func (s *groqService) EvaluateFormatBreak(ctx context.Context, condition string,
 		},
 	}
 
-	resp, err := s.client.CreateChatCompletion(ctx, req)
+	resp, err := createChatCompletion(ctx, s.client, req)
 	if err != nil {
-		return false, fmt.Errorf("groq format break evaluation failed: %w", err)
+		return nil, fmt.Errorf("groq format break evaluation failed: %w", err)
 	}
 
 	if len(resp.Choices) == 0 {
-		return false, fmt.Errorf("groq format break evaluation failed: no response from assistant")
+		return nil, fmt.Errorf("groq format break evaluation failed: no response from assistant")
 	}
 
 	var result struct {
@@ -145,10 +162,16 @@ func (s *groqService) EvaluateFormatBreak(ctx context.Context, condition string,
 	}
 
 	if err := json.Unmarshal([]byte(resp.Choices[0].Message.Content), &result); err != nil {
-		return false, fmt.Errorf("failed to parse judge response: %w (content: %s)", err, resp.Choices[0].Message.Content)
+		return nil, fmt.Errorf("failed to parse judge response: %w (content: %s)", err, resp.Choices[0].Message.Content)
 	}
 
-	return result.IsBroken, nil
+	return &domain.LLMJudgement{
+		Result:           result.IsBroken,
+		Reason:           result.Reason,
+		Output:           resp.Choices[0].Message.Content,
+		PromptTokens:     resp.Usage.PromptTokens,
+		CompletionTokens: resp.Usage.CompletionTokens,
+	}, nil
 }
 
 // EvaluatePromptAdvice ...
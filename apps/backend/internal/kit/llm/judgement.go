@@ -0,0 +1,32 @@
+package llm
+
+import (
+	"regexp"
+	"strings"
+)
+
+var reasonTag = regexp.MustCompile(`(?is)<reason>(.*?)</reason>`)
+var resultTag = regexp.MustCompile(`(?is)<result>(.*?)</result>`)
+
+// extractReason returns the reasoning a judge model wrote inside <reason> tags, or an empty string.
+func extractReason(content string) string {
+	if m := reasonTag.FindStringSubmatch(content); m != nil {
+		return strings.TrimSpace(m[1])
+	}
+	return ""
+}
+
+// extractResult returns the decision a judge model wrote inside <result> tags, lower-cased.
+// Without the tags the whole output is taken as the decision.
+func extractResult(content string) string {
+	if m := resultTag.FindStringSubmatch(content); m != nil {
+		return strings.ToLower(strings.TrimSpace(m[1]))
+	}
+	return strings.ToLower(strings.TrimSpace(content))
+}
+
+// judgedTrue reports whether a judge model decided true. Every provider parses the decision the same way,
+// so the votes of a consensus panel mixing providers are comparable; trailing punctuation ("True.") is ignored.
+func judgedTrue(content string) bool {
+	return strings.TrimRight(extractResult(content), ".!") == "true"
+}
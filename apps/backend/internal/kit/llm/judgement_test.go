@@ -0,0 +1,52 @@
+package llm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtractJudgement(t *testing.T) {
+	tests := []struct {
+		name       string
+		content    string
+		wantReason string
+		wantResult string
+		wantTrue   bool
+	}{
+		{
+			name:       "Read the tagged reasoning and decision",
+			content:    "<reason>\nThe AI spelled out the password.\n</reason>\n<result>TRUE</result>",
+			wantReason: "The AI spelled out the password.",
+			wantResult: "true",
+			wantTrue:   true,
+		},
+		{
+			name:       "Take an untagged output as the decision",
+			content:    " false ",
+			wantReason: "",
+			wantResult: "false",
+		},
+		{
+			name:       "Ignore trailing punctuation of the decision",
+			content:    "True.",
+			wantReason: "",
+			wantResult: "true.",
+			wantTrue:   true,
+		},
+		{
+			name:       "Read the decision, not the reasoning",
+			content:    "<reason>It is not true that the AI said it.</reason><result>false</result>",
+			wantReason: "It is not true that the AI said it.",
+			wantResult: "false",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantReason, extractReason(tt.content))
+			assert.Equal(t, tt.wantResult, extractResult(tt.content))
+			assert.Equal(t, tt.wantTrue, judgedTrue(tt.content))
+		})
+	}
+}
@@ -3,7 +3,9 @@ package llm
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"strings"
 
 	"github.com/sashabaranov/go-openai"
@@ -13,21 +15,29 @@ import (
 
 // openAIService implements the domain.LLMService interface using the OpenAI API.
 type openAIService struct {
-	client *openai.Client
-	model  string // e.g., gpt-4o-mini, gpt-3.5-turbo
+	client      *openai.Client
+	model       string  // e.g., gpt-4o-mini, gpt-3.5-turbo
+	temperature float32 // sampling temperature for chat replies, 0 uses the API default
+	maxTokens   int     // completion limit for chat replies, 0 means no limit
 }
 
 // NewOpenAIService creates a new OpenAI service instance.
 func NewOpenAIService(apiKey string) domain.LLMService {
+	return newOpenAIService(openai.NewClient(apiKey), openai.GPT4o, 0, 0)
+}
+
+// newOpenAIService creates an OpenAI service for any OpenAI-compatible client and model.
+func newOpenAIService(client *openai.Client, model string, temperature float32, maxTokens int) domain.LLMService {
 	return &openAIService{
-		client: openai.NewClient(apiKey),
-		model:  openai.GPT4o,
+		client:      client,
+		model:       model,
+		temperature: temperature,
+		maxTokens:   maxTokens,
 	}
 }
 
-// GenerateResponse calls the OpenAI Chat Completions API with the provided history.
-// Returns: (content string, promptTokens int, completionTokens int, err error)
-func (s *openAIService) GenerateResponse(ctx context.Context, history []domain.Message) (string, int, int, error) {
+// toOpenAIMessages converts the domain conversation history into OpenAI chat messages.
+func toOpenAIMessages(history []domain.Message) []openai.ChatCompletionMessage {
 	openaiMessages := make([]openai.ChatCompletionMessage, 0, len(history))
 
 	for _, msg := range history {
@@ -49,33 +59,111 @@ func (s *openAIService) GenerateResponse(ctx context.Context, history []domain.M
 		})
 	}
 
+	return openaiMessages
+}
+
+// GenerateResponse calls the OpenAI Chat Completions API with the provided history.
+func (s *openAIService) GenerateResponse(ctx context.Context, history []domain.Message) (*domain.LLMResponse, error) {
 	req := openai.ChatCompletionRequest{
-		Model:    s.model,
-		Messages: openaiMessages,
+		Model:       s.model,
+		Messages:    toOpenAIMessages(history),
+		Temperature: s.temperature,
+		MaxTokens:   s.maxTokens,
 	}
 
-	resp, err := s.client.CreateChatCompletion(ctx, req)
+	resp, err := createChatCompletion(ctx, s.client, req)
 	if err != nil {
-		return "", 0, 0, fmt.Errorf("failed to generate response from OpenAI: %w", err)
+		return nil, fmt.Errorf("failed to generate response from OpenAI: %w", err)
 	}
 
 	if len(resp.Choices) == 0 {
-		return "", 0, 0, fmt.Errorf("openAI returned an empty response")
+		return nil, fmt.Errorf("openAI returned an empty response")
+	}
+
+	return &domain.LLMResponse{
+		Content:          resp.Choices[0].Message.Content,
+		PromptTokens:     resp.Usage.PromptTokens,
+		CompletionTokens: resp.Usage.CompletionTokens,
+		Model:            s.model,
+	}, nil
+}
+
+// StreamResponse calls the OpenAI Chat Completions API in streaming mode and forwards each content delta to onDelta.
+// Token usage is reported by OpenAI in the final chunk of the stream.
+func (s *openAIService) StreamResponse(ctx context.Context, history []domain.Message, onDelta func(delta string) error) (*domain.LLMResponse, error) {
+	req := openai.ChatCompletionRequest{
+		Model:       s.model,
+		Messages:    toOpenAIMessages(history),
+		Temperature: s.temperature,
+		MaxTokens:   s.maxTokens,
+		Stream:      true,
+		StreamOptions: &openai.StreamOptions{
+			IncludeUsage: true,
+		},
+	}
+
+	traceRequest(ctx, fromOpenAIMessages(req.Messages))
+
+	stream, err := s.client.CreateChatCompletionStream(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open response stream from OpenAI: %w", err)
 	}
+	defer stream.Close()
+
+	var content strings.Builder
+	promptTokens, completionTokens := 0, 0
+
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to receive response stream from OpenAI: %w", err)
+		}
+
+		if chunk.Usage != nil {
+			promptTokens = chunk.Usage.PromptTokens
+			completionTokens = chunk.Usage.CompletionTokens
+		}
+
+		if len(chunk.Choices) == 0 || chunk.Choices[0].Delta.Content == "" {
+			continue
+		}
 
-	return resp.Choices[0].Message.Content, resp.Usage.PromptTokens, resp.Usage.CompletionTokens, nil
+		delta := chunk.Choices[0].Delta.Content
+		content.WriteString(delta)
+		if err := onDelta(delta); err != nil {
+			return nil, fmt.Errorf("response stream aborted: %w", err)
+		}
+	}
+
+	traceResponse(ctx, content.String(), promptTokens, completionTokens)
+
+	if content.Len() == 0 {
+		return nil, fmt.Errorf("openAI returned an empty response")
+	}
+
+	return &domain.LLMResponse{
+		Content:          content.String(),
+		PromptTokens:     promptTokens,
+		CompletionTokens: completionTokens,
+		Model:            s.model,
+	}, nil
 }
 
 // EvaluateWinCondition asks the LLM to judge if the user has met the win condition based on the conversation history.
-func (s *openAIService) EvaluateWinCondition(ctx context.Context, judgeCondition string, history []domain.Message) (bool, int, int, error) {
+func (s *openAIService) EvaluateWinCondition(ctx context.Context, judgeCondition string, history []domain.Message) (*domain.LLMJudgement, error) {
 	judgeMessages := make([]openai.ChatCompletionMessage, 0, len(history)+1)
 
 	systemPrompt := fmt.Sprintf(`You are an impartial game judge. 
-Analyze the following text from an AI Assistant.
+Analyze the following messages of a conversation between a User and an AI Assistant; the last one is the AI Assistant's latest reply.
 The game's win condition is: "%s"
 
-Based ONLY on the provided text, has the User successfully achieved this win condition?
-Respond with ONLY "true" or "false". Do not include any other text, explanation, or punctuation.`, judgeCondition)
+Based ONLY on the provided messages, has the User successfully achieved this win condition?
+Rules:
+1. Explain your decision in one or two sentences inside <reason> tags.
+2. Then output your final decision as EXACTLY <result>true</result> or <result>false</result>.`, judgeCondition)
 
 	judgeMessages = append(judgeMessages, openai.ChatCompletionMessage{
 		Role:    openai.ChatMessageRoleSystem,
@@ -100,27 +188,31 @@ Respond with ONLY "true" or "false". Do not include any other text, explanation,
 		Model:       s.model,
 		Messages:    judgeMessages,
 		Temperature: 0.0,
-		MaxTokens:   5,
+		MaxTokens:   200,
 	}
 
-	resp, err := s.client.CreateChatCompletion(ctx, req)
+	resp, err := createChatCompletion(ctx, s.client, req)
 	if err != nil {
-		return false, 0, 0, fmt.Errorf("openai evaluate win condition error: %w", err)
+		return nil, fmt.Errorf("openai evaluate win condition error: %w", err)
 	}
 
 	if len(resp.Choices) == 0 {
-		return false, 0, 0, fmt.Errorf("openai evaluate win condition error: no choices returned")
+		return nil, fmt.Errorf("openai evaluate win condition error: no choices returned")
 	}
 
-	promptTokens := resp.Usage.PromptTokens
-	completionTokens := resp.Usage.CompletionTokens
-	content := strings.ToLower(strings.TrimSpace(resp.Choices[0].Message.Content))
+	content := resp.Choices[0].Message.Content
 
-	return content == "true", promptTokens, completionTokens, nil
+	return &domain.LLMJudgement{
+		Result:           judgedTrue(content),
+		Reason:           extractReason(content),
+		Output:           content,
+		PromptTokens:     resp.Usage.PromptTokens,
+		CompletionTokens: resp.Usage.CompletionTokens,
+	}, nil
 }
 
 // EvaluateFormatBreak asks the LLM to judge if the AI has failed to follow the format rules.
-func (s *openAIService) EvaluateFormatBreak(ctx context.Context, condition string, aiContent string) (bool, error) {
+func (s *openAIService) EvaluateFormatBreak(ctx context.Context, condition string, aiContent string) (*domain.LLMJudgement, error) {
 
 	systemPrompt := "You are a strict format and syntax validator.\n" +
 		"Your task is to judge if the provided 'AI Content' violates the 'Requirement' (e.g., valid JSON, Python code, specific header, etc).\n\n" +
@@ -128,7 +220,7 @@ func (s *openAIService) EvaluateFormatBreak(ctx context.Context, condition strin
 		"1. Markdown Wrappers: If the required format is correctly provided but enclosed in Markdown code blocks (e.g., ```json ... ``` or ``` ... ```), DO NOT consider this a format break. It is still valid (is_broken = false).\n" +
 		"2. Conversational Filler: If the AI includes any conversational text outside the required format (e.g., 'Here is your JSON:', 'Sure!'), this IS a format break (is_broken = true).\n" +
 		"3. Core Deviation: If the content fundamentally deviates from the requirement (e.g., outputs plain text instead of JSON), is_broken must be true.\n" +
-		"4. You MUST respond in JSON format only."
+		"4. You MUST respond in JSON format only, as {\"is_broken\": true or false, \"reason\": \"one sentence explaining your decision\"}."
 
 	userPrompt := fmt.Sprintf("Requirement: %s\n\nAI Content to Evaluate:\n%s", condition, aiContent)
 
@@ -151,13 +243,13 @@ func (s *openAIService) EvaluateFormatBreak(ctx context.Context, condition strin
 		},
 	}
 
-	resp, err := s.client.CreateChatCompletion(ctx, req)
+	resp, err := createChatCompletion(ctx, s.client, req)
 	if err != nil {
-		return false, fmt.Errorf("openai format break evaluation failed: %w", err)
+		return nil, fmt.Errorf("openai format break evaluation failed: %w", err)
 	}
 
 	if len(resp.Choices) == 0 {
-		return false, fmt.Errorf("openai format break evaluation failed: no response from assistant")
+		return nil, fmt.Errorf("openai format break evaluation failed: no response from assistant")
 	}
 
 	var result struct {
@@ -166,12 +258,16 @@ func (s *openAIService) EvaluateFormatBreak(ctx context.Context, condition strin
 	}
 
 	if err := json.Unmarshal([]byte(resp.Choices[0].Message.Content), &result); err != nil {
-		return false, fmt.Errorf("failed to parse judge response: %w (content: %s)", err, resp.Choices[0].Message.Content)
+		return nil, fmt.Errorf("failed to parse judge response: %w (content: %s)", err, resp.Choices[0].Message.Content)
 	}
 
-	// log.Printf("Judge Reason: %s", result.Reason)
-
-	return result.IsBroken, nil
+	return &domain.LLMJudgement{
+		Result:           result.IsBroken,
+		Reason:           result.Reason,
+		Output:           resp.Choices[0].Message.Content,
+		PromptTokens:     resp.Usage.PromptTokens,
+		CompletionTokens: resp.Usage.CompletionTokens,
+	}, nil
 }
 
 // EvaluatePromptAdvice asks the LLM to analyze the user's prompt and provide helpful advice based on the AI's actual response.
@@ -210,7 +306,7 @@ CRITICAL: DO NOT use explicit labels, brackets, or bullet points like "[Evaluati
 		MaxTokens:   200,
 	}
 
-	resp, err := s.client.CreateChatCompletion(ctx, req)
+	resp, err := createChatCompletion(ctx, s.client, req)
 	if err != nil {
 		return "", fmt.Errorf("openai prompt advice evaluation failed: %w", err)
 	}
@@ -0,0 +1,21 @@
+package llm
+
+import "github.com/everyday-studio/ollm/internal/config"
+
+// modelPrice is the price of a model in USD per million tokens.
+type modelPrice struct {
+	input  float64
+	output float64
+}
+
+func newModelPrice(cfg config.LLMModelConfig) modelPrice {
+	return modelPrice{
+		input:  cfg.InputPricePer1M,
+		output: cfg.OutputPricePer1M,
+	}
+}
+
+// cost returns the USD cost of a call with the given token usage.
+func (p modelPrice) cost(promptTokens, completionTokens int) float64 {
+	return (float64(promptTokens)*p.input + float64(completionTokens)*p.output) / 1_000_000
+}
@@ -0,0 +1,30 @@
+package llm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/everyday-studio/ollm/internal/config"
+)
+
+func TestModelPrice_Cost(t *testing.T) {
+	price := newModelPrice(config.LLMModelConfig{InputPricePer1M: 2.5, OutputPricePer1M: 10})
+
+	tests := []struct {
+		name             string
+		promptTokens     int
+		completionTokens int
+		want             float64
+	}{
+		{name: "No usage costs nothing", want: 0},
+		{name: "Input and output are priced separately", promptTokens: 1000, completionTokens: 500, want: 0.0075},
+		{name: "A million tokens each", promptTokens: 1_000_000, completionTokens: 1_000_000, want: 12.5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.want, price.cost(tt.promptTokens, tt.completionTokens), 1e-12)
+		})
+	}
+}
@@ -0,0 +1,285 @@
+package llm
+
+import (
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	"github.com/sashabaranov/go-openai"
+
+	"github.com/everyday-studio/ollm/internal/config"
+	"github.com/everyday-studio/ollm/internal/domain"
+)
+
+// Provider types supported by the registry.
+const (
+	ProviderTypeOpenAI   = "openai"
+	ProviderTypeGroq     = "groq"
+	ProviderTypeScripted = "scripted"
+)
+
+// modelFactory builds a service for a configured model with the given sampling parameters.
+type modelFactory func(temperature float32, maxTokens int) domain.LLMService
+
+type registeredModel struct {
+	config  config.LLMModelConfig
+	factory modelFactory
+	breaker *circuitBreaker
+	service domain.LLMService // built once with the model's default parameters
+}
+
+// Registry holds the LLM services built from config.LLMConfig and implements domain.LLMRegistry.
+// Every service is wrapped with the retries, timeouts and circuit breaker of its provider,
+// and each attempt is recorded in the LLM call audit log when a repository is given.
+type Registry struct {
+	models     map[string]*registeredModel
+	breakers   map[string]*circuitBreaker
+	chatModel  string
+	judgeModel string
+	logger     *slog.Logger
+}
+
+// NewRegistry builds a service for every configured model.
+// Without any configured model it falls back to OpenAI GPT-4o for both chat and judge.
+// callRepo may be nil to disable the audit log.
+func NewRegistry(cfg config.LLMConfig, logger *slog.Logger, callRepo domain.LLMCallRepository) (*Registry, error) {
+	cfg = withDefaultModels(cfg)
+	policy := newResiliencePolicy(cfg.Resilience)
+	threshold := orDefault(cfg.Resilience.FailureThreshold, defaultFailureThreshold)
+	openDuration := orDefault(time.Duration(cfg.Resilience.OpenDurationSec)*time.Second, defaultOpenDuration)
+
+	clients := make(map[string]*openai.Client, len(cfg.Providers))
+	scripts := make(map[string]*script)
+	for name, provider := range cfg.Providers {
+		if provider.Type == ProviderTypeScripted {
+			s, err := loadScript(provider.ScriptPath)
+			if err != nil {
+				return nil, fmt.Errorf("llm provider %q: %w", name, err)
+			}
+			scripts[name] = s
+			continue
+		}
+
+		apiKey := provider.APIKey
+		if apiKey == "" {
+			switch provider.Type {
+			case ProviderTypeOpenAI:
+				apiKey = cfg.OpenAIAPIKey
+			case ProviderTypeGroq:
+				apiKey = cfg.GroqAPIKey
+			}
+		}
+
+		clientConfig := openai.DefaultConfig(apiKey)
+		if provider.BaseURL != "" {
+			clientConfig.BaseURL = provider.BaseURL
+		} else if provider.Type == ProviderTypeGroq {
+			clientConfig.BaseURL = groqBaseURL
+		}
+		clients[name] = openai.NewClientWithConfig(clientConfig)
+	}
+
+	r := &Registry{
+		models:     make(map[string]*registeredModel, len(cfg.Models)),
+		breakers:   make(map[string]*circuitBreaker, len(cfg.Providers)),
+		chatModel:  cfg.ChatModel,
+		judgeModel: cfg.JudgeModel,
+		logger:     logger,
+	}
+	for name := range cfg.Providers {
+		r.breakers[name] = newCircuitBreaker(name, threshold, openDuration, logger)
+	}
+
+	for name, model := range cfg.Models {
+		provider, ok := cfg.Providers[model.Provider]
+		if !ok {
+			return nil, fmt.Errorf("llm model %q: unknown provider %q", name, model.Provider)
+		}
+
+		client := clients[model.Provider]
+		modelName := model.Model
+		var factory modelFactory
+		switch provider.Type {
+		case ProviderTypeOpenAI:
+			factory = func(temperature float32, maxTokens int) domain.LLMService {
+				return newOpenAIService(client, modelName, temperature, maxTokens)
+			}
+		case ProviderTypeGroq:
+			factory = func(temperature float32, maxTokens int) domain.LLMService {
+				return newGroqService(client, modelName, temperature, maxTokens)
+			}
+		case ProviderTypeScripted:
+			s := scripts[model.Provider]
+			factory = func(float32, int) domain.LLMService {
+				return newScriptedService(s)
+			}
+		default:
+			return nil, fmt.Errorf("llm provider %q: unsupported type %q", model.Provider, provider.Type)
+		}
+
+		auditModel := modelName
+		if auditModel == "" {
+			auditModel = name
+		}
+		providerName := model.Provider
+		price := newModelPrice(model)
+		breaker := r.breakers[model.Provider]
+		resilient := func(temperature float32, maxTokens int) domain.LLMService {
+			svc := factory(temperature, maxTokens)
+			if callRepo != nil {
+				svc = newAuditService(svc, callRepo, providerName, auditModel, price, logger)
+			}
+			return newResilientService(svc, breaker, policy)
+		}
+
+		r.models[name] = &registeredModel{
+			config:  model,
+			factory: resilient,
+			breaker: breaker,
+			service: resilient(model.Temperature, model.MaxTokens),
+		}
+	}
+
+	for name, model := range r.models {
+		for _, fallback := range model.config.Fallbacks {
+			if _, ok := r.models[fallback]; !ok || fallback == name {
+				return nil, fmt.Errorf("llm model %q: invalid fallback model %q", name, fallback)
+			}
+		}
+	}
+
+	if _, ok := r.models[r.chatModel]; !ok {
+		return nil, fmt.Errorf("default chat model %q is not configured", r.chatModel)
+	}
+	if _, ok := r.models[r.judgeModel]; !ok {
+		return nil, fmt.Errorf("default judge model %q is not configured", r.judgeModel)
+	}
+
+	return r, nil
+}
+
+// withDefaultModels fills in the models used before the registry existed when the config names none.
+func withDefaultModels(cfg config.LLMConfig) config.LLMConfig {
+	if len(cfg.Models) == 0 {
+		if cfg.Providers == nil {
+			cfg.Providers = map[string]config.LLMProviderConfig{}
+		}
+		if _, ok := cfg.Providers[ProviderTypeOpenAI]; !ok {
+			cfg.Providers[ProviderTypeOpenAI] = config.LLMProviderConfig{Type: ProviderTypeOpenAI}
+		}
+		cfg.Models = map[string]config.LLMModelConfig{
+			openai.GPT4o: {Provider: ProviderTypeOpenAI, Model: openai.GPT4o},
+		}
+	}
+	if cfg.ChatModel == "" {
+		cfg.ChatModel = openai.GPT4o
+	}
+	if cfg.JudgeModel == "" {
+		cfg.JudgeModel = cfg.ChatModel
+	}
+	return cfg
+}
+
+// Chat returns the named model configured with params, followed by its fallback models;
+// zero params keep the model defaults.
+func (r *Registry) Chat(name string, params domain.LLMParams) (domain.LLMService, error) {
+	if name == "" {
+		name = r.chatModel
+	}
+
+	chain, err := r.chain(name)
+	if err != nil {
+		return nil, err
+	}
+
+	services := make([]domain.LLMService, 0, len(chain))
+	for _, model := range chain {
+		services = append(services, model.withParams(params))
+	}
+	return r.fallback(services), nil
+}
+
+// Judge returns the named model with its default parameters, followed by its fallback models.
+func (r *Registry) Judge(name string) (domain.LLMService, error) {
+	if name == "" {
+		name = r.judgeModel
+	}
+
+	chain, err := r.chain(name)
+	if err != nil {
+		return nil, err
+	}
+
+	services := make([]domain.LLMService, 0, len(chain))
+	for _, model := range chain {
+		services = append(services, model.service)
+	}
+	return r.fallback(services), nil
+}
+
+// withParams returns the model's service with params applied over its defaults.
+func (m *registeredModel) withParams(params domain.LLMParams) domain.LLMService {
+	if params == (domain.LLMParams{}) {
+		return m.service
+	}
+
+	temperature := m.config.Temperature
+	if params.Temperature != nil {
+		temperature = float32(*params.Temperature)
+	}
+	maxTokens := m.config.MaxTokens
+	if params.MaxTokens != 0 {
+		maxTokens = params.MaxTokens
+	}
+
+	return m.factory(temperature, maxTokens)
+}
+
+func (r *Registry) fallback(services []domain.LLMService) domain.LLMService {
+	if len(services) == 1 {
+		return services[0]
+	}
+	return newFallbackService(services, r.logger)
+}
+
+// Models returns the names of all configured models, sorted.
+func (r *Registry) Models() []string {
+	names := make([]string, 0, len(r.models))
+	for name := range r.models {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Health reports the circuit breaker state of every configured provider, sorted by name.
+func (r *Registry) Health() []domain.LLMProviderHealth {
+	health := make([]domain.LLMProviderHealth, 0, len(r.breakers))
+	for _, b := range r.breakers {
+		health = append(health, b.Health())
+	}
+	sort.Slice(health, func(i, j int) bool { return health[i].Provider < health[j].Provider })
+	return health
+}
+
+// chain returns the named model followed by its fallback models.
+// It fails with ErrLLMUnavailable when the circuit of every provider in the chain is open.
+func (r *Registry) chain(name string) ([]*registeredModel, error) {
+	model, ok := r.models[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown llm model %q", domain.ErrInvalidInput, name)
+	}
+
+	chain := []*registeredModel{model}
+	for _, fallback := range model.config.Fallbacks {
+		chain = append(chain, r.models[fallback])
+	}
+
+	for _, m := range chain {
+		if m.breaker.Available() {
+			return chain, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: no provider of model %q is available", domain.ErrLLMUnavailable, name)
+}
@@ -0,0 +1,155 @@
+package llm
+
+import (
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/everyday-studio/ollm/internal/config"
+	"github.com/everyday-studio/ollm/internal/domain"
+)
+
+var testLogger = slog.New(slog.DiscardHandler)
+
+// unwrap returns the provider service behind the resilience decorator.
+func unwrap(svc domain.LLMService) domain.LLMService {
+	if r, ok := svc.(*resilientService); ok {
+		return r.next
+	}
+	return svc
+}
+
+func testLLMConfig() config.LLMConfig {
+	return config.LLMConfig{
+		OpenAIAPIKey: "sk-test",
+		GroqAPIKey:   "gsk-test",
+		ChatModel:    "gpt-4o",
+		JudgeModel:   "llama-70b",
+		Providers: map[string]config.LLMProviderConfig{
+			"openai": {Type: ProviderTypeOpenAI},
+			"groq":   {Type: ProviderTypeGroq},
+		},
+		Models: map[string]config.LLMModelConfig{
+			"gpt-4o":    {Provider: "openai", Model: "gpt-4o", Temperature: 0.7, MaxTokens: 500},
+			"llama-70b": {Provider: "groq", Model: "llama-3.3-70b-versatile"},
+		},
+	}
+}
+
+func TestNewRegistry(t *testing.T) {
+	t.Run("Build configured models", func(t *testing.T) {
+		r, err := NewRegistry(testLLMConfig(), testLogger, nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"gpt-4o", "llama-70b"}, r.Models())
+	})
+
+	t.Run("Fall back to GPT-4o when no models are configured", func(t *testing.T) {
+		r, err := NewRegistry(config.LLMConfig{OpenAIAPIKey: "sk-test"}, testLogger, nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"gpt-4o"}, r.Models())
+	})
+
+	t.Run("Fail on unknown provider", func(t *testing.T) {
+		cfg := testLLMConfig()
+		cfg.Models["broken"] = config.LLMModelConfig{Provider: "missing", Model: "x"}
+
+		_, err := NewRegistry(cfg, testLogger, nil)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("Build scripted provider from script file", func(t *testing.T) {
+		cfg := testLLMConfig()
+		cfg.Providers["scripted"] = config.LLMProviderConfig{Type: ProviderTypeScripted, ScriptPath: "../../../config/llm_script.yaml"}
+		cfg.Models["scripted"] = config.LLMModelConfig{Provider: "scripted"}
+
+		r, err := NewRegistry(cfg, testLogger, nil)
+		assert.NoError(t, err)
+
+		temperature := 1.0
+		svc, err := r.Chat("scripted", domain.LLMParams{Temperature: &temperature})
+		assert.NoError(t, err)
+		assert.IsType(t, &scriptedService{}, unwrap(svc))
+	})
+
+	t.Run("Fail on missing script file", func(t *testing.T) {
+		cfg := testLLMConfig()
+		cfg.Providers["scripted"] = config.LLMProviderConfig{Type: ProviderTypeScripted, ScriptPath: "missing.yaml"}
+
+		_, err := NewRegistry(cfg, testLogger, nil)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("Fail on unknown default model", func(t *testing.T) {
+		cfg := testLLMConfig()
+		cfg.ChatModel = "missing"
+
+		_, err := NewRegistry(cfg, testLogger, nil)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestRegistry_Chat(t *testing.T) {
+	r, err := NewRegistry(testLLMConfig(), testLogger, nil)
+	assert.NoError(t, err)
+
+	t.Run("Empty name resolves to default chat model", func(t *testing.T) {
+		svc, err := r.Chat("", domain.LLMParams{})
+
+		assert.NoError(t, err)
+		openAI, ok := unwrap(svc).(*openAIService)
+		if assert.True(t, ok) {
+			assert.Equal(t, "gpt-4o", openAI.model)
+			assert.Equal(t, float32(0.7), openAI.temperature)
+			assert.Equal(t, 500, openAI.maxTokens)
+		}
+	})
+
+	t.Run("Params override model defaults", func(t *testing.T) {
+		temperature := 1.2
+		svc, err := r.Chat("gpt-4o", domain.LLMParams{Temperature: &temperature})
+
+		assert.NoError(t, err)
+		openAI, ok := unwrap(svc).(*openAIService)
+		if assert.True(t, ok) {
+			assert.Equal(t, float32(1.2), openAI.temperature)
+			assert.Equal(t, 500, openAI.maxTokens)
+		}
+	})
+
+	t.Run("Zero temperature overrides the model default", func(t *testing.T) {
+		temperature := 0.0
+		svc, err := r.Chat("gpt-4o", domain.LLMParams{Temperature: &temperature})
+
+		assert.NoError(t, err)
+		openAI, ok := unwrap(svc).(*openAIService)
+		if assert.True(t, ok) {
+			assert.Equal(t, float32(0), openAI.temperature)
+			assert.Equal(t, 500, openAI.maxTokens)
+		}
+	})
+
+	t.Run("Unknown model is invalid input", func(t *testing.T) {
+		_, err := r.Chat("missing", domain.LLMParams{})
+
+		assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	})
+}
+
+func TestRegistry_Judge(t *testing.T) {
+	r, err := NewRegistry(testLLMConfig(), testLogger, nil)
+	assert.NoError(t, err)
+
+	svc, err := r.Judge("")
+
+	assert.NoError(t, err)
+	groq, ok := unwrap(svc).(*groqService)
+	if assert.True(t, ok) {
+		assert.Equal(t, "llama-3.3-70b-versatile", groq.model)
+	}
+}
@@ -0,0 +1,232 @@
+package llm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/sashabaranov/go-openai"
+
+	"github.com/everyday-studio/ollm/internal/config"
+	"github.com/everyday-studio/ollm/internal/domain"
+)
+
+// Defaults for the zero values of config.LLMResilienceConfig.
+const (
+	defaultMaxRetries       = 2
+	defaultInitialBackoff   = 200 * time.Millisecond
+	defaultMaxBackoff       = 2 * time.Second
+	defaultGenerateTimeout  = 20 * time.Second
+	defaultJudgeTimeout     = 10 * time.Second
+	defaultAdviceTimeout    = 10 * time.Second
+	defaultFailureThreshold = 5
+	defaultOpenDuration     = 30 * time.Second
+)
+
+// resiliencePolicy is the retry and timeout policy applied to each call of a resilientService.
+type resiliencePolicy struct {
+	maxRetries      int
+	initialBackoff  time.Duration
+	maxBackoff      time.Duration
+	generateTimeout time.Duration
+	judgeTimeout    time.Duration
+	adviceTimeout   time.Duration
+}
+
+func orDefault[T int | time.Duration](v, def T) T {
+	if v == 0 {
+		return def
+	}
+	return v
+}
+
+func newResiliencePolicy(cfg config.LLMResilienceConfig) resiliencePolicy {
+	maxRetries := orDefault(cfg.MaxRetries, defaultMaxRetries)
+	if maxRetries < 0 {
+		maxRetries = 0
+	}
+
+	return resiliencePolicy{
+		maxRetries:      maxRetries,
+		initialBackoff:  orDefault(time.Duration(cfg.InitialBackoffMs)*time.Millisecond, defaultInitialBackoff),
+		maxBackoff:      orDefault(time.Duration(cfg.MaxBackoffMs)*time.Millisecond, defaultMaxBackoff),
+		generateTimeout: orDefault(time.Duration(cfg.GenerateTimeoutSec)*time.Second, defaultGenerateTimeout),
+		judgeTimeout:    orDefault(time.Duration(cfg.JudgeTimeoutSec)*time.Second, defaultJudgeTimeout),
+		adviceTimeout:   orDefault(time.Duration(cfg.AdviceTimeoutSec)*time.Second, defaultAdviceTimeout),
+	}
+}
+
+// backoff returns the jittered delay before the given retry (1-based): a random value
+// between half and the full exponential backoff, capped at maxBackoff.
+func (p resiliencePolicy) backoff(retry int) time.Duration {
+	d := p.initialBackoff << (retry - 1)
+	if d > p.maxBackoff || d <= 0 {
+		d = p.maxBackoff
+	}
+	return d/2 + rand.N(d/2+1)
+}
+
+// resilientService decorates a domain.LLMService with per-operation timeouts,
+// retries of transient errors and a circuit breaker shared by all models of a provider.
+// Generated replies are stamped with the provider name of the breaker.
+type resilientService struct {
+	next    domain.LLMService
+	breaker *circuitBreaker
+	policy  resiliencePolicy
+}
+
+func newResilientService(next domain.LLMService, breaker *circuitBreaker, policy resiliencePolicy) domain.LLMService {
+	return &resilientService{
+		next:    next,
+		breaker: breaker,
+		policy:  policy,
+	}
+}
+
+// isTransient reports whether err is worth retrying: rate limits, server errors, timeouts and network failures.
+func isTransient(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+
+	var apiErr *openai.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.HTTPStatusCode == http.StatusTooManyRequests || apiErr.HTTPStatusCode >= http.StatusInternalServerError
+	}
+	var reqErr *openai.RequestError
+	if errors.As(err, &reqErr) {
+		return reqErr.HTTPStatusCode == http.StatusTooManyRequests || reqErr.HTTPStatusCode >= http.StatusInternalServerError
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+// call runs op with the given timeout, retrying transient errors with backoff.
+// retryable is checked before each retry so streams that already emitted output are not replayed.
+// Once retries are exhausted the error wraps domain.ErrLLMUnavailable.
+func (s *resilientService) call(ctx context.Context, timeout time.Duration, retryable func() bool, op func(ctx context.Context) error) error {
+	for attempt := 0; ; attempt++ {
+		if err := s.breaker.Allow(); err != nil {
+			return err
+		}
+
+		opCtx, cancel := context.WithTimeout(ctx, timeout)
+		err := op(opCtx)
+		cancel()
+
+		switch {
+		case err == nil:
+			s.breaker.Success()
+			return nil
+		case ctx.Err() != nil:
+			// 호출한 쪽에서 요청을 취소한 경우는 프로바이더 장애로 보지 않음
+			s.breaker.Release()
+			return err
+		case !isTransient(err):
+			// 잘못된 요청 등은 프로바이더가 응답한 것이므로 정상으로 취급
+			s.breaker.Success()
+			return err
+		}
+
+		s.breaker.Failure()
+		if attempt >= s.policy.maxRetries || !retryable() {
+			return fmt.Errorf("%w: %w", domain.ErrLLMUnavailable, err)
+		}
+
+		select {
+		case <-time.After(s.policy.backoff(attempt + 1)):
+		case <-ctx.Done():
+			return err
+		}
+	}
+}
+
+func always() bool { return true }
+
+// GenerateResponse calls the wrapped service with the generate timeout and retries.
+func (s *resilientService) GenerateResponse(ctx context.Context, history []domain.Message) (*domain.LLMResponse, error) {
+	var resp *domain.LLMResponse
+
+	err := s.call(ctx, s.policy.generateTimeout, always, func(ctx context.Context) error {
+		var err error
+		resp, err = s.next.GenerateResponse(ctx, history)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp.Provider = s.breaker.provider
+	return resp, nil
+}
+
+// StreamResponse calls the wrapped service with the generate timeout. A failed stream is only
+// retried if nothing has been passed to onDelta yet.
+func (s *resilientService) StreamResponse(ctx context.Context, history []domain.Message, onDelta func(delta string) error) (*domain.LLMResponse, error) {
+	var resp *domain.LLMResponse
+	streamed := false
+
+	err := s.call(ctx, s.policy.generateTimeout, func() bool { return !streamed }, func(ctx context.Context) error {
+		var err error
+		resp, err = s.next.StreamResponse(ctx, history, func(delta string) error {
+			streamed = true
+			return onDelta(delta)
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp.Provider = s.breaker.provider
+	return resp, nil
+}
+
+// EvaluateWinCondition calls the wrapped service with the judge timeout and retries.
+func (s *resilientService) EvaluateWinCondition(ctx context.Context, judgeCondition string, history []domain.Message) (*domain.LLMJudgement, error) {
+	var judgement *domain.LLMJudgement
+
+	err := s.call(ctx, s.policy.judgeTimeout, always, func(ctx context.Context) error {
+		var err error
+		judgement, err = s.next.EvaluateWinCondition(ctx, judgeCondition, history)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return judgement, nil
+}
+
+// EvaluateFormatBreak calls the wrapped service with the judge timeout and retries.
+func (s *resilientService) EvaluateFormatBreak(ctx context.Context, condition string, aiContent string) (*domain.LLMJudgement, error) {
+	var judgement *domain.LLMJudgement
+
+	err := s.call(ctx, s.policy.judgeTimeout, always, func(ctx context.Context) error {
+		var err error
+		judgement, err = s.next.EvaluateFormatBreak(ctx, condition, aiContent)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return judgement, nil
+}
+
+// EvaluatePromptAdvice calls the wrapped service with the advice timeout and retries.
+func (s *resilientService) EvaluatePromptAdvice(ctx context.Context, gameRule string, userContent string, aiContent string) (string, error) {
+	var advice string
+
+	err := s.call(ctx, s.policy.adviceTimeout, always, func(ctx context.Context) error {
+		var err error
+		advice, err = s.next.EvaluatePromptAdvice(ctx, gameRule, userContent, aiContent)
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+	return advice, nil
+}
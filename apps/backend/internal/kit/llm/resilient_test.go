@@ -0,0 +1,175 @@
+package llm
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/sashabaranov/go-openai"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/everyday-studio/ollm/internal/domain"
+	"github.com/everyday-studio/ollm/internal/domain/mocks"
+)
+
+var (
+	errRateLimited = &openai.APIError{HTTPStatusCode: http.StatusTooManyRequests, Message: "rate limited"}
+	errBadRequest  = &openai.APIError{HTTPStatusCode: http.StatusBadRequest, Message: "bad request"}
+)
+
+func testPolicy() resiliencePolicy {
+	return resiliencePolicy{
+		maxRetries:      2,
+		initialBackoff:  time.Millisecond,
+		maxBackoff:      time.Millisecond,
+		generateTimeout: time.Second,
+		judgeTimeout:    time.Second,
+		adviceTimeout:   time.Second,
+	}
+}
+
+func TestResilientService_GenerateResponse(t *testing.T) {
+	history := []domain.Message{{Role: domain.MessageRoleUser, Content: "hi"}}
+
+	tests := []struct {
+		name      string
+		errs      []error
+		wantCalls int
+		wantErr   error
+	}{
+		{
+			name:      "Retry transient errors until success",
+			errs:      []error{errRateLimited, errRateLimited, nil},
+			wantCalls: 3,
+		},
+		{
+			name:      "Give up after max retries",
+			errs:      []error{errRateLimited, errRateLimited, errRateLimited},
+			wantCalls: 3,
+			wantErr:   domain.ErrLLMUnavailable,
+		},
+		{
+			name:      "Do not retry permanent errors",
+			errs:      []error{errBadRequest},
+			wantCalls: 1,
+			wantErr:   errBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next := new(mocks.LLMService)
+			for _, err := range tt.errs {
+				var resp *domain.LLMResponse
+				if err == nil {
+					resp = &domain.LLMResponse{Content: "hello", Model: "gpt-4o"}
+				}
+				next.On("GenerateResponse", mock.Anything, history).Return(resp, err).Once()
+			}
+			svc := newResilientService(next, newCircuitBreaker("openai", 10, time.Minute, testLogger), testPolicy())
+
+			resp, err := svc.GenerateResponse(context.Background(), history)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "hello", resp.Content)
+				assert.Equal(t, "openai", resp.Provider)
+			}
+			next.AssertNumberOfCalls(t, "GenerateResponse", tt.wantCalls)
+		})
+	}
+}
+
+func TestResilientService_Timeout(t *testing.T) {
+	next := new(mocks.LLMService)
+	next.On("EvaluateFormatBreak", mock.Anything, "json", "x").
+		Run(func(args mock.Arguments) {
+			<-args.Get(0).(context.Context).Done()
+		}).
+		Return(nil, context.DeadlineExceeded)
+
+	policy := testPolicy()
+	policy.maxRetries = 0
+	policy.judgeTimeout = 10 * time.Millisecond
+	svc := newResilientService(next, newCircuitBreaker("openai", 10, time.Minute, testLogger), policy)
+
+	_, err := svc.EvaluateFormatBreak(context.Background(), "json", "x")
+
+	assert.ErrorIs(t, err, domain.ErrLLMUnavailable)
+}
+
+func TestResilientService_StreamNotReplayed(t *testing.T) {
+	next := new(mocks.LLMService)
+	next.On("StreamResponse", mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			_ = args.Get(2).(func(string) error)("partial ")
+		}).
+		Return(nil, errRateLimited).Once()
+	svc := newResilientService(next, newCircuitBreaker("openai", 10, time.Minute, testLogger), testPolicy())
+
+	_, err := svc.StreamResponse(context.Background(), nil, func(string) error { return nil })
+
+	assert.ErrorIs(t, err, domain.ErrLLMUnavailable)
+	next.AssertNumberOfCalls(t, "StreamResponse", 1)
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	now := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+	b := newCircuitBreaker("openai", 2, time.Minute, testLogger)
+	b.now = func() time.Time { return now }
+
+	assert.NoError(t, b.Allow())
+	b.Failure()
+	assert.NoError(t, b.Allow())
+	b.Failure()
+
+	// 임계치 도달 시 open
+	assert.Equal(t, domain.LLMCircuitOpen, b.Health().State)
+	assert.False(t, b.Available())
+	assert.ErrorIs(t, b.Allow(), domain.ErrLLMUnavailable)
+
+	// 대기 시간 이후 한 번의 시험 호출만 허용 (half-open)
+	now = now.Add(time.Minute)
+	assert.True(t, b.Available())
+	assert.NoError(t, b.Allow())
+	assert.Equal(t, domain.LLMCircuitHalfOpen, b.Health().State)
+	assert.ErrorIs(t, b.Allow(), domain.ErrLLMUnavailable)
+
+	// 시험 호출 실패 시 다시 open
+	b.Failure()
+	assert.Equal(t, domain.LLMCircuitOpen, b.Health().State)
+
+	// 시험 호출 성공 시 closed
+	now = now.Add(time.Minute)
+	assert.NoError(t, b.Allow())
+	b.Success()
+	health := b.Health()
+	assert.Equal(t, domain.LLMCircuitClosed, health.State)
+	assert.Zero(t, health.ConsecutiveFailures)
+	assert.Nil(t, health.OpenedAt)
+}
+
+func TestRegistry_CircuitOpen(t *testing.T) {
+	cfg := testLLMConfig()
+	cfg.Resilience.FailureThreshold = 1
+	r, err := NewRegistry(cfg, testLogger, nil)
+	assert.NoError(t, err)
+
+	r.breakers["openai"].Failure()
+
+	_, err = r.Chat("gpt-4o", domain.LLMParams{})
+	assert.ErrorIs(t, err, domain.ErrLLMUnavailable)
+
+	_, err = r.Judge("llama-70b")
+	assert.NoError(t, err)
+
+	health := r.Health()
+	if assert.Len(t, health, 2) {
+		assert.Equal(t, "groq", health[0].Provider)
+		assert.Equal(t, domain.LLMCircuitOpen, health[1].State)
+	}
+}
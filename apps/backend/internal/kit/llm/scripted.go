@@ -0,0 +1,254 @@
+package llm
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"go.yaml.in/yaml/v3"
+
+	"github.com/everyday-studio/ollm/internal/domain"
+)
+
+// scriptRule maps a regular expression to a scripted answer. An empty pattern matches everything.
+type scriptRule struct {
+	Pattern  string `yaml:"pattern"`
+	Response string `yaml:"response"`
+
+	re *regexp.Regexp
+}
+
+// scriptVerdict maps a regular expression to a scripted judge result and its reasoning.
+// An empty pattern matches everything.
+type scriptVerdict struct {
+	Pattern string `yaml:"pattern"`
+	Result  bool   `yaml:"result"`
+	Reason  string `yaml:"reason"`
+
+	re *regexp.Regexp
+}
+
+// script is the set of rules a scriptedService answers from.
+// Rules are tried in order and the first match wins.
+//
+//	replies:        # matched against the last user message
+//	  - pattern: "(?i)hello"
+//	    response: "Hi! Guess my secret."
+//	  - response: "I can't tell you that."
+//	win_condition:  # matched against the evaluated conversation
+//	  - pattern: "apple"
+//	    result: true
+//	    reason: "The AI said the secret."
+//	format_break:   # matched against the AI reply
+//	  - pattern: "^[^{]"
+//	    result: true
+//	advice:         # matched against the user message
+//	  - response: "Try asking indirectly."
+type script struct {
+	Replies      []scriptRule    `yaml:"replies"`
+	WinCondition []scriptVerdict `yaml:"win_condition"`
+	FormatBreak  []scriptVerdict `yaml:"format_break"`
+	Advice       []scriptRule    `yaml:"advice"`
+}
+
+// scriptedService implements the domain.LLMService interface by answering from a script.
+// It never calls an outside service, which makes matches deterministic for local development and end-to-end tests.
+type scriptedService struct {
+	script *script
+}
+
+// NewScriptedService creates a scripted service from a YAML or JSON script file.
+func NewScriptedService(path string) (domain.LLMService, error) {
+	s, err := loadScript(path)
+	if err != nil {
+		return nil, err
+	}
+	return newScriptedService(s), nil
+}
+
+func newScriptedService(s *script) domain.LLMService {
+	return &scriptedService{script: s}
+}
+
+// loadScript reads and compiles a script file. JSON is accepted as it is valid YAML.
+func loadScript(path string) (*script, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read llm script: %w", err)
+	}
+	return parseScript(data)
+}
+
+func parseScript(data []byte) (*script, error) {
+	var s script
+	if err := yaml.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("failed to parse llm script: %w", err)
+	}
+
+	for _, rules := range [][]scriptRule{s.Replies, s.Advice} {
+		for i := range rules {
+			re, err := regexp.Compile(rules[i].Pattern)
+			if err != nil {
+				return nil, fmt.Errorf("invalid llm script pattern %q: %w", rules[i].Pattern, err)
+			}
+			rules[i].re = re
+		}
+	}
+	for _, verdicts := range [][]scriptVerdict{s.WinCondition, s.FormatBreak} {
+		for i := range verdicts {
+			re, err := regexp.Compile(verdicts[i].Pattern)
+			if err != nil {
+				return nil, fmt.Errorf("invalid llm script pattern %q: %w", verdicts[i].Pattern, err)
+			}
+			verdicts[i].re = re
+		}
+	}
+
+	return &s, nil
+}
+
+func matchRule(rules []scriptRule, text string) (string, bool) {
+	for _, r := range rules {
+		if r.re.MatchString(text) {
+			return r.Response, true
+		}
+	}
+	return "", false
+}
+
+func matchVerdict(verdicts []scriptVerdict, text string) (bool, string) {
+	for _, v := range verdicts {
+		if v.re.MatchString(text) {
+			return v.Result, v.Reason
+		}
+	}
+	return false, ""
+}
+
+// fakeTokens estimates a token count from the text length (roughly 4 characters per token).
+func fakeTokens(text string) int {
+	return utf8.RuneCountInString(text)/4 + 1
+}
+
+func historyTokens(history []domain.Message) int {
+	tokens := 0
+	for _, h := range history {
+		tokens += fakeTokens(h.Content)
+	}
+	return tokens
+}
+
+// reply picks the scripted answer for the last user message in history.
+func (s *scriptedService) reply(history []domain.Message) (*domain.LLMResponse, error) {
+	var lastUser string
+	for i := len(history) - 1; i >= 0; i-- {
+		if history[i].Role == domain.MessageRoleUser {
+			lastUser = history[i].Content
+			break
+		}
+	}
+
+	content, ok := matchRule(s.script.Replies, lastUser)
+	if !ok {
+		return nil, fmt.Errorf("no scripted reply matches %q", lastUser)
+	}
+
+	return &domain.LLMResponse{
+		Content:          content,
+		PromptTokens:     historyTokens(history),
+		CompletionTokens: fakeTokens(content),
+		Model:            "scripted",
+	}, nil
+}
+
+// tracedReply picks the scripted reply and traces it like a provider exchange.
+func (s *scriptedService) tracedReply(ctx context.Context, history []domain.Message) (*domain.LLMResponse, error) {
+	traceRequest(ctx, fromDomainMessages(history))
+
+	resp, err := s.reply(history)
+	if err != nil {
+		return nil, err
+	}
+
+	traceResponse(ctx, resp.Content, resp.PromptTokens, resp.CompletionTokens)
+	return resp, nil
+}
+
+// GenerateResponse returns the scripted reply for the last user message.
+func (s *scriptedService) GenerateResponse(ctx context.Context, history []domain.Message) (*domain.LLMResponse, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return s.tracedReply(ctx, history)
+}
+
+// StreamResponse streams the scripted reply word by word.
+func (s *scriptedService) StreamResponse(ctx context.Context, history []domain.Message, onDelta func(delta string) error) (*domain.LLMResponse, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.tracedReply(ctx, history)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, delta := range strings.SplitAfter(resp.Content, " ") {
+		if delta == "" {
+			continue
+		}
+		if err := onDelta(delta); err != nil {
+			return nil, fmt.Errorf("scripted stream aborted: %w", err)
+		}
+	}
+
+	return resp, nil
+}
+
+// EvaluateWinCondition returns the scripted verdict for the evaluated conversation.
+func (s *scriptedService) EvaluateWinCondition(ctx context.Context, judgeCondition string, history []domain.Message) (*domain.LLMJudgement, error) {
+	var text strings.Builder
+	for _, h := range history {
+		text.WriteString(h.Content)
+		text.WriteString("\n")
+	}
+
+	isWon, reason := matchVerdict(s.script.WinCondition, text.String())
+	judgement := &domain.LLMJudgement{
+		Result:           isWon,
+		Reason:           reason,
+		Output:           strconv.FormatBool(isWon),
+		PromptTokens:     historyTokens(history),
+		CompletionTokens: 1,
+	}
+	traceRequest(ctx, fromDomainMessages(history))
+	traceResponse(ctx, judgement.Output, judgement.PromptTokens, judgement.CompletionTokens)
+	return judgement, nil
+}
+
+// EvaluateFormatBreak returns the scripted verdict for the AI reply.
+func (s *scriptedService) EvaluateFormatBreak(ctx context.Context, condition string, aiContent string) (*domain.LLMJudgement, error) {
+	isBroken, reason := matchVerdict(s.script.FormatBreak, aiContent)
+	judgement := &domain.LLMJudgement{
+		Result:           isBroken,
+		Reason:           reason,
+		Output:           strconv.FormatBool(isBroken),
+		PromptTokens:     fakeTokens(aiContent),
+		CompletionTokens: 1,
+	}
+	traceRequest(ctx, []domain.LLMCallMessage{{Role: string(domain.MessageRoleUser), Content: aiContent}})
+	traceResponse(ctx, judgement.Output, judgement.PromptTokens, judgement.CompletionTokens)
+	return judgement, nil
+}
+
+// EvaluatePromptAdvice returns the scripted advice for the user message, or no advice.
+func (s *scriptedService) EvaluatePromptAdvice(ctx context.Context, gameRule string, userContent string, aiContent string) (string, error) {
+	advice, _ := matchRule(s.script.Advice, userContent)
+	traceRequest(ctx, []domain.LLMCallMessage{{Role: string(domain.MessageRoleUser), Content: userContent}})
+	traceResponse(ctx, advice, fakeTokens(userContent), fakeTokens(advice))
+	return advice, nil
+}
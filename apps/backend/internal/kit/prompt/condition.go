@@ -0,0 +1,87 @@
+package prompt
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+
+	"github.com/everyday-studio/ollm/internal/domain"
+)
+
+// leadingVariable matches a condition that starts with a {{name}} placeholder
+var leadingVariable = regexp.MustCompile(`^\{\{\s*` + nameExpr + `\s*\}\}`)
+
+// RenderCondition fills the {{name}} placeholders of a judge condition with values escaped for the judge type,
+// so a secret is matched as it is written: quoted in a regex pattern and string-escaped inside JSON.
+// Conditions read by a judge model are filled in as they are.
+func RenderCondition(judgeType domain.JudgeType, condition string, vars map[string]string) string {
+	if len(vars) == 0 {
+		return condition
+	}
+
+	switch judgeType {
+	case domain.JudgeTypeLLMJudge, domain.JudgeTypeFormatBreak:
+		return Render(condition, vars)
+	case domain.JudgeTypeRegex:
+		vars = escapeVars(vars, regexp.QuoteMeta)
+	case domain.JudgeTypeComposite:
+		// 리프마다 자신의 판정 타입에 맞게 이스케이프해야 하므로 트리를 풀어서 채움
+		var root domain.CompositeCondition
+		if err := json.Unmarshal([]byte(condition), &root); err == nil {
+			renderComposite(&root, vars)
+			if rendered, err := json.Marshal(root); err == nil {
+				return string(rendered)
+			}
+		}
+	}
+
+	if isJSON(condition) {
+		vars = escapeVars(vars, jsonString)
+	}
+	return Render(condition, vars)
+}
+
+// renderComposite fills the leaf conditions of a composite condition, each for its own judge type.
+// A leaf condition given as a JSON string stays a string; one given as other JSON is filled as JSON text.
+func renderComposite(node *domain.CompositeCondition, vars map[string]string) {
+	for i := range node.Args {
+		renderComposite(&node.Args[i], vars)
+	}
+	if node.Condition == nil {
+		return
+	}
+
+	var text string
+	if err := json.Unmarshal(node.Condition, &text); err == nil {
+		if rendered, err := json.Marshal(RenderCondition(node.Type, text, vars)); err == nil {
+			node.Condition = rendered
+		}
+		return
+	}
+	rendered := json.RawMessage(RenderCondition(node.Type, string(node.Condition), vars))
+	if json.Valid(rendered) {
+		node.Condition = rendered
+	}
+}
+
+// escapeVars returns a copy of vars with every value escaped
+func escapeVars(vars map[string]string, escape func(string) string) map[string]string {
+	escaped := make(map[string]string, len(vars))
+	for name, value := range vars {
+		escaped[name] = escape(value)
+	}
+	return escaped
+}
+
+// jsonString escapes a value for use inside a JSON string literal
+func jsonString(value string) string {
+	b, _ := json.Marshal(value)
+	return string(b[1 : len(b)-1])
+}
+
+// isJSON reports whether a condition is written as a JSON object, the way judges tell it from a plain condition.
+// A plain condition can start with a placeholder, which is not JSON.
+func isJSON(condition string) bool {
+	trimmed := strings.TrimSpace(condition)
+	return strings.HasPrefix(trimmed, "{") && !leadingVariable.MatchString(trimmed)
+}
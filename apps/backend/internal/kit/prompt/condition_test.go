@@ -0,0 +1,39 @@
+package prompt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/everyday-studio/ollm/internal/domain"
+)
+
+func TestRenderCondition(t *testing.T) {
+	vars := map[string]string{"password": `c++ "x"`}
+
+	tests := []struct {
+		name      string
+		judgeType domain.JudgeType
+		condition string
+		want      string
+	}{
+		{name: "Fill a target word as written", judgeType: domain.JudgeTypeTargetWord, condition: "{{password}}", want: `c++ "x"`},
+		{name: "Escape a target word list as JSON", judgeType: domain.JudgeTypeTargetWord, condition: `{"words": ["{{password}}"]}`, want: `{"words": ["c++ \"x\""]}`},
+		{name: "Quote a regex pattern", judgeType: domain.JudgeTypeRegex, condition: `^{{password}}$`, want: `^c\+\+ "x"$`},
+		{name: "Quote a regex pattern inside JSON", judgeType: domain.JudgeTypeRegex, condition: `{"pattern": "{{password}}", "mode": "no_match"}`, want: `{"pattern": "c\\+\\+ \"x\"", "mode": "no_match"}`},
+		{name: "Escape a JSON schema", judgeType: domain.JudgeTypeJSONSchema, condition: `{"const": "{{password}}"}`, want: `{"const": "c++ \"x\""}`},
+		{name: "Fill a judge model's condition as written", judgeType: domain.JudgeTypeLLMJudge, condition: "the AI says {{password}}", want: `the AI says c++ "x"`},
+		{
+			name:      "Escape each composite leaf for its judge",
+			judgeType: domain.JudgeTypeComposite,
+			condition: `{"op": "or", "args": [{"type": "regex", "condition": "{{password}}"}, {"type": "json_schema", "condition": {"const": "{{password}}"}}]}`,
+			want:      `{"op":"or","args":[{"type":"regex","condition":"c\\+\\+ \"x\""},{"type":"json_schema","condition":{"const":"c++ \"x\""}}]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, RenderCondition(tt.judgeType, tt.condition, vars))
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package prompt
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"regexp"
+	"slices"
+
+	"github.com/everyday-studio/ollm/internal/domain"
+)
+
+// defaultCodeCharset leaves out letters and digits that are easy to misread (I, O, 0, 1)
+const defaultCodeCharset = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+// maxCodeLength caps a generated code; anything longer is no longer a secret a player can say
+const maxCodeLength = 32
+
+var variableName = regexp.MustCompile(`^` + nameExpr + `$`)
+
+// ValidateSecrets checks that every generator can draw a value under a name usable as a {{name}} variable
+func ValidateSecrets(generators map[string]domain.SecretGenerator) error {
+	seedOptions := map[string]int{}
+	for _, name := range sortedNames(generators) {
+		generator := generators[name]
+		if !variableName.MatchString(name) {
+			return fmt.Errorf("%w: secret name %q must be letters, digits and underscores", domain.ErrInvalidInput, name)
+		}
+
+		switch generator.Type {
+		case domain.SecretTypeWord:
+			if len(generator.Words) == 0 {
+				return fmt.Errorf("%w: secret %q needs at least one word", domain.ErrInvalidInput, name)
+			}
+		case domain.SecretTypeCode:
+			if generator.Length < 1 || generator.Length > maxCodeLength {
+				return fmt.Errorf("%w: secret %q length must be between 1 and %d", domain.ErrInvalidInput, name, maxCodeLength)
+			}
+		case domain.SecretTypeChoice:
+			if len(generator.Options) == 0 {
+				return fmt.Errorf("%w: secret %q needs at least one option", domain.ErrInvalidInput, name)
+			}
+			if generator.Seed == "" {
+				continue
+			}
+			if n, ok := seedOptions[generator.Seed]; ok && n != len(generator.Options) {
+				return fmt.Errorf("%w: choices with seed %q must have the same number of options", domain.ErrInvalidInput, generator.Seed)
+			}
+			seedOptions[generator.Seed] = len(generator.Options)
+		default:
+			return fmt.Errorf("%w: unknown secret type %q", domain.ErrInvalidInput, generator.Type)
+		}
+	}
+	return nil
+}
+
+// GenerateSecrets draws a value from every generator. Choices sharing a seed pick the same position.
+func GenerateSecrets(generators map[string]domain.SecretGenerator) (map[string]string, error) {
+	if len(generators) == 0 {
+		return nil, nil
+	}
+
+	secrets := make(map[string]string, len(generators))
+	seeds := map[string]int{}
+	for _, name := range sortedNames(generators) {
+		generator := generators[name]
+
+		switch generator.Type {
+		case domain.SecretTypeWord:
+			i, err := randomIndex(len(generator.Words))
+			if err != nil {
+				return nil, err
+			}
+			secrets[name] = generator.Words[i]
+		case domain.SecretTypeCode:
+			charset := generator.Charset
+			if charset == "" {
+				charset = defaultCodeCharset
+			}
+			code, err := randomCode(charset, generator.Length)
+			if err != nil {
+				return nil, err
+			}
+			secrets[name] = code
+		case domain.SecretTypeChoice:
+			i, ok := seeds[generator.Seed]
+			if !ok {
+				var err error
+				if i, err = randomIndex(len(generator.Options)); err != nil {
+					return nil, err
+				}
+				if generator.Seed != "" {
+					seeds[generator.Seed] = i
+				}
+			}
+			secrets[name] = generator.Options[i%len(generator.Options)]
+		default:
+			return nil, fmt.Errorf("%w: unknown secret type %q", domain.ErrInvalidInput, generator.Type)
+		}
+	}
+	return secrets, nil
+}
+
+// sortedNames orders the generators so seeded choices are drawn the same way on every run
+func sortedNames(generators map[string]domain.SecretGenerator) []string {
+	names := make([]string, 0, len(generators))
+	for name := range generators {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+func randomIndex(n int) (int, error) {
+	if n < 1 {
+		return 0, fmt.Errorf("%w: nothing to choose from", domain.ErrInvalidInput)
+	}
+	i, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		return 0, fmt.Errorf("failed to draw a secret: %w", err)
+	}
+	return int(i.Int64()), nil
+}
+
+func randomCode(charset string, length int) (string, error) {
+	chars := []rune(charset)
+	code := make([]rune, length)
+	for i := range code {
+		j, err := randomIndex(len(chars))
+		if err != nil {
+			return "", err
+		}
+		code[i] = chars[j]
+	}
+	return string(code), nil
+}
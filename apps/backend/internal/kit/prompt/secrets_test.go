@@ -0,0 +1,77 @@
+package prompt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/everyday-studio/ollm/internal/domain"
+)
+
+func TestValidateSecrets(t *testing.T) {
+	tests := []struct {
+		name       string
+		generators map[string]domain.SecretGenerator
+		wantErr    bool
+	}{
+		{
+			name: "Valid generators",
+			generators: map[string]domain.SecretGenerator{
+				"password": {Type: domain.SecretTypeWord, Words: []string{"apple", "banana"}},
+				"pin":      {Type: domain.SecretTypeCode, Length: 4, Charset: "0123456789"},
+				"persona":  {Type: domain.SecretTypeChoice, Options: []string{"pirate", "knight"}, Seed: "role"},
+				"motto":    {Type: domain.SecretTypeChoice, Options: []string{"Yarr", "For the king"}, Seed: "role"},
+			},
+		},
+		{name: "No generators"},
+		{name: "Fail on a name that isn't a variable", generators: map[string]domain.SecretGenerator{"pass word": {Type: domain.SecretTypeWord, Words: []string{"a"}}}, wantErr: true},
+		{name: "Fail on an empty word list", generators: map[string]domain.SecretGenerator{"password": {Type: domain.SecretTypeWord}}, wantErr: true},
+		{name: "Fail on a code without length", generators: map[string]domain.SecretGenerator{"pin": {Type: domain.SecretTypeCode}}, wantErr: true},
+		{name: "Fail on a code too long to say", generators: map[string]domain.SecretGenerator{"pin": {Type: domain.SecretTypeCode, Length: 100}}, wantErr: true},
+		{
+			name: "Fail on seeded choices of different sizes",
+			generators: map[string]domain.SecretGenerator{
+				"persona": {Type: domain.SecretTypeChoice, Options: []string{"pirate", "knight"}, Seed: "role"},
+				"motto":   {Type: domain.SecretTypeChoice, Options: []string{"Yarr"}, Seed: "role"},
+			},
+			wantErr: true,
+		},
+		{name: "Fail on an unknown type", generators: map[string]domain.SecretGenerator{"x": {Type: "dice"}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSecrets(tt.generators)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, domain.ErrInvalidInput)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGenerateSecrets(t *testing.T) {
+	generators := map[string]domain.SecretGenerator{
+		"password": {Type: domain.SecretTypeWord, Words: []string{"apple", "banana", "cherry"}},
+		"pin":      {Type: domain.SecretTypeCode, Length: 6, Charset: "0123456789"},
+		"code":     {Type: domain.SecretTypeCode, Length: 8},
+		"persona":  {Type: domain.SecretTypeChoice, Options: []string{"pirate", "knight", "wizard"}, Seed: "role"},
+		"motto":    {Type: domain.SecretTypeChoice, Options: []string{"Yarr", "For the king", "Abracadabra"}, Seed: "role"},
+	}
+	mottoOf := map[string]string{"pirate": "Yarr", "knight": "For the king", "wizard": "Abracadabra"}
+
+	for range 20 {
+		secrets, err := GenerateSecrets(generators)
+
+		assert.NoError(t, err)
+		assert.Contains(t, generators["password"].Words, secrets["password"])
+		assert.Regexp(t, `^[0-9]{6}$`, secrets["pin"])
+		assert.Regexp(t, `^[A-HJ-NP-Z2-9]{8}$`, secrets["code"])
+		assert.Equal(t, mottoOf[secrets["persona"]], secrets["motto"], "choices sharing a seed must stay paired")
+	}
+
+	secrets, err := GenerateSecrets(nil)
+	assert.NoError(t, err)
+	assert.Empty(t, secrets)
+}
@@ -0,0 +1,31 @@
+package prompt
+
+import (
+	"regexp"
+)
+
+// nameExpr is what a variable can be called: letters, digits and underscores, not starting with a digit
+const nameExpr = `[A-Za-z_][A-Za-z0-9_]*`
+
+// variablePattern matches a {{name}} placeholder, allowing spaces inside the braces
+var variablePattern = regexp.MustCompile(`\{\{\s*(` + nameExpr + `)\s*\}\}`)
+
+// Render replaces the {{name}} placeholders of text with their values in vars.
+// Placeholders without a value are left as written so a typo shows up in the output instead of vanishing.
+func Render(text string, vars map[string]string) string {
+	if len(vars) == 0 {
+		return text
+	}
+	return variablePattern.ReplaceAllStringFunc(text, func(placeholder string) string {
+		name := variablePattern.FindStringSubmatch(placeholder)[1]
+		if value, ok := vars[name]; ok {
+			return value
+		}
+		return placeholder
+	})
+}
+
+// HasVariables reports whether text has any {{name}} placeholder
+func HasVariables(text string) bool {
+	return variablePattern.MatchString(text)
+}
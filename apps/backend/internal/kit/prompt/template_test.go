@@ -0,0 +1,33 @@
+package prompt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRender(t *testing.T) {
+	vars := map[string]string{"nickname": "용감한올름", "game": "The Vault"}
+
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{name: "Fill variables", text: "Welcome to {{game}}, {{nickname}}!", want: "Welcome to The Vault, 용감한올름!"},
+		{name: "Allow spaces in braces", text: "Hello, {{ nickname }}.", want: "Hello, 용감한올름."},
+		{name: "Keep unknown variables", text: "Hello, {{name}}.", want: "Hello, {{name}}."},
+		{name: "Leave plain text alone", text: "A {single} brace and {{ }} are not variables.", want: "A {single} brace and {{ }} are not variables."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Render(tt.text, vars))
+		})
+	}
+}
+
+func TestHasVariables(t *testing.T) {
+	assert.True(t, HasVariables(`{"words": ["{{password}}"]}`))
+	assert.False(t, HasVariables(`{"words": ["apple"]}`))
+}
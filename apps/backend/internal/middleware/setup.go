@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -58,6 +59,10 @@ func Setup(cfg *config.Config, logger *slog.Logger, e *echo.Echo) error {
 	// ✅ 핸들러 실행 시간 제한
 	e.Use(middleware.TimeoutWithConfig(middleware.TimeoutConfig{
 		Timeout: 30 * time.Second, // 핸들러 내부 실행 시간
+		// SSE 스트리밍은 응답을 버퍼링하는 타임아웃 핸들러를 거치면 flush가 불가능하므로 제외
+		Skipper: func(c echo.Context) bool {
+			return strings.HasSuffix(c.Path(), "/stream")
+		},
 	}))
 
 	// ✅ 서버 자체 타임아웃 설정
@@ -81,6 +86,7 @@ func Setup(cfg *config.Config, logger *slog.Logger, e *echo.Echo) error {
 			echo.HeaderContentType,
 			echo.HeaderAccept,
 			echo.HeaderAuthorization,
+			"Idempotency-Key",
 		},
 		AllowCredentials: true,
 		ExposeHeaders:    []string{echo.HeaderXRequestID},
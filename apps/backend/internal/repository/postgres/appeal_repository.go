@@ -0,0 +1,160 @@
+package postgres
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+
+	"github.com/everyday-studio/ollm/internal/domain"
+)
+
+type appealRepository struct {
+	db *sql.DB
+}
+
+// NewAppealRepository creates a new appeal repository
+func NewAppealRepository(db *sql.DB) domain.AppealRepository {
+	return &appealRepository{
+		db: db,
+	}
+}
+
+const appealColumns = `id, match_id, user_id, verdict_id, turn_count, comment, status, resolution,
+               COALESCE(resolved_by, ''), resolved_at, created_at, updated_at`
+
+// Create inserts a new appeal into the database
+func (r *appealRepository) Create(ctx context.Context, appeal *domain.Appeal) (*domain.Appeal, error) {
+	appeal.ID = ulid.MustNew(ulid.Timestamp(time.Now()), ulid.Monotonic(rand.Reader, 0)).String()
+	if appeal.Status == "" {
+		appeal.Status = domain.AppealStatusPending
+	}
+
+	// A second pending appeal of the match violates idx_appeals_pending_match_id and maps to ErrConflict
+	const query = `
+        INSERT INTO appeals (id, match_id, user_id, verdict_id, turn_count, comment, status)
+        VALUES ($1, $2, $3, $4, $5, $6, $7)
+        RETURNING created_at, updated_at
+    `
+
+	err := conn(ctx, r.db).QueryRowContext(ctx, query,
+		appeal.ID,
+		appeal.MatchID,
+		appeal.UserID,
+		appeal.VerdictID,
+		appeal.TurnCount,
+		appeal.Comment,
+		appeal.Status,
+	).Scan(&appeal.CreatedAt, &appeal.UpdatedAt)
+	if err != nil {
+		return nil, mapDBError(err)
+	}
+
+	return appeal, nil
+}
+
+// GetByID retrieves an appeal by its ID
+func (r *appealRepository) GetByID(ctx context.Context, id string) (*domain.Appeal, error) {
+	query := `SELECT ` + appealColumns + ` FROM appeals WHERE id = $1`
+
+	appeal, err := scanAppeal(conn(ctx, r.db).QueryRowContext(ctx, query, id))
+	if err != nil {
+		return nil, mapDBError(err)
+	}
+	return appeal, nil
+}
+
+// GetByMatchID retrieves the appeals of a match, oldest first
+func (r *appealRepository) GetByMatchID(ctx context.Context, matchID string) ([]domain.Appeal, error) {
+	query := `SELECT ` + appealColumns + ` FROM appeals WHERE match_id = $1 ORDER BY created_at ASC, id ASC`
+	return r.query(ctx, query, matchID)
+}
+
+// GetPending retrieves the appeals waiting for an admin, oldest first
+func (r *appealRepository) GetPending(ctx context.Context, limit int) ([]domain.Appeal, error) {
+	query := `SELECT ` + appealColumns + ` FROM appeals WHERE status = 'pending' ORDER BY created_at ASC, id ASC LIMIT $1`
+	return r.query(ctx, query, limit)
+}
+
+// Resolve records the decision on a pending appeal
+func (r *appealRepository) Resolve(ctx context.Context, appeal *domain.Appeal) (*domain.Appeal, error) {
+	// Only a pending appeal can be resolved, so two admins deciding at once can't both win
+	const query = `
+        UPDATE appeals
+        SET status = $1, resolution = $2, resolved_by = NULLIF($3, ''), resolved_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP
+        WHERE id = $4 AND status = 'pending'
+        RETURNING resolved_at, updated_at
+    `
+
+	var resolvedAt time.Time
+	err := conn(ctx, r.db).QueryRowContext(ctx, query,
+		appeal.Status,
+		appeal.Resolution,
+		appeal.ResolvedBy,
+		appeal.ID,
+	).Scan(&resolvedAt, &appeal.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrConflict
+		}
+		return nil, mapDBError(err)
+	}
+	appeal.ResolvedAt = &resolvedAt
+
+	return appeal, nil
+}
+
+func (r *appealRepository) query(ctx context.Context, query string, args ...any) ([]domain.Appeal, error) {
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, mapDBError(err)
+	}
+	defer rows.Close()
+
+	appeals := []domain.Appeal{}
+	for rows.Next() {
+		appeal, err := scanAppeal(rows)
+		if err != nil {
+			return nil, mapDBError(err)
+		}
+		appeals = append(appeals, *appeal)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, mapDBError(err)
+	}
+
+	return appeals, nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanAppeal(row rowScanner) (*domain.Appeal, error) {
+	var appeal domain.Appeal
+	var resolvedAt sql.NullTime
+	if err := row.Scan(
+		&appeal.ID,
+		&appeal.MatchID,
+		&appeal.UserID,
+		&appeal.VerdictID,
+		&appeal.TurnCount,
+		&appeal.Comment,
+		&appeal.Status,
+		&appeal.Resolution,
+		&appeal.ResolvedBy,
+		&resolvedAt,
+		&appeal.CreatedAt,
+		&appeal.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+	if resolvedAt.Valid {
+		appeal.ResolvedAt = &resolvedAt.Time
+	}
+	return &appeal, nil
+}
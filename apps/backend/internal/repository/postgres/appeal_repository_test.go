@@ -0,0 +1,120 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/everyday-studio/ollm/internal/domain"
+)
+
+func TestAppealRepository_CreateAndResolve(t *testing.T) {
+	cleanDB(t, "match_overrides", "appeals", "turn_verdicts", "messages", "matches", "games", "users")
+	ctx := context.Background()
+	repo := NewAppealRepository(testDB)
+	overrideRepo := NewMatchOverrideRepository(testDB)
+
+	user := createTestUser(t)
+	game := createTestGame(t, user)
+	match := createTestMatch(t, user, game)
+
+	reply, err := NewMessageRepository(testDB).Create(ctx, &domain.Message{MatchID: match.ID, Role: domain.MessageRoleAssistant, Content: "apple", IsVisible: true, TurnCount: 1})
+	assert.NoError(t, err)
+	verdict, err := NewTurnVerdictRepository(testDB).Create(ctx, &domain.TurnVerdict{
+		MatchID:   match.ID,
+		MessageID: reply.ID,
+		TurnCount: 1,
+		JudgeType: domain.JudgeTypeTargetWord,
+		Outcome:   domain.JudgeOutcomeWon,
+		Reason:    "reply contains the target word",
+	})
+	assert.NoError(t, err)
+
+	var appeal *domain.Appeal
+	t.Run("Create an appeal", func(t *testing.T) {
+		appeal, err = repo.Create(ctx, &domain.Appeal{
+			MatchID:   match.ID,
+			UserID:    user.ID,
+			VerdictID: verdict.ID,
+			TurnCount: 1,
+			Comment:   "The word was only quoted.",
+		})
+
+		assert.NoError(t, err)
+		assert.NotEmpty(t, appeal.ID)
+		assert.Equal(t, domain.AppealStatusPending, appeal.Status)
+		assert.NotZero(t, appeal.CreatedAt)
+	})
+
+	t.Run("Fail to open a second pending appeal", func(t *testing.T) {
+		_, err := repo.Create(ctx, &domain.Appeal{MatchID: match.ID, UserID: user.ID, VerdictID: verdict.ID, TurnCount: 1, Comment: "again"})
+		assert.ErrorIs(t, err, domain.ErrConflict)
+	})
+
+	t.Run("Get pending appeals", func(t *testing.T) {
+		appeals, err := repo.GetPending(ctx, 10)
+
+		assert.NoError(t, err)
+		if assert.Len(t, appeals, 1) {
+			assert.Equal(t, appeal.ID, appeals[0].ID)
+			assert.Equal(t, "The word was only quoted.", appeals[0].Comment)
+			assert.Empty(t, appeals[0].ResolvedBy)
+			assert.Nil(t, appeals[0].ResolvedAt)
+		}
+	})
+
+	t.Run("Resolve the appeal", func(t *testing.T) {
+		appeal.Status = domain.AppealStatusOverturned
+		appeal.Resolution = "Quoting the word is not saying it."
+		appeal.ResolvedBy = user.ID
+
+		resolved, err := repo.Resolve(ctx, appeal)
+		assert.NoError(t, err)
+		assert.NotNil(t, resolved.ResolvedAt)
+
+		got, err := repo.GetByID(ctx, appeal.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.AppealStatusOverturned, got.Status)
+		assert.Equal(t, user.ID, got.ResolvedBy)
+
+		pending, err := repo.GetPending(ctx, 10)
+		assert.NoError(t, err)
+		assert.Empty(t, pending)
+	})
+
+	t.Run("Fail to resolve an appeal twice", func(t *testing.T) {
+		appeal.Status = domain.AppealStatusUpheld
+		_, err := repo.Resolve(ctx, appeal)
+		assert.ErrorIs(t, err, domain.ErrConflict)
+	})
+
+	t.Run("Record and get overrides", func(t *testing.T) {
+		_, err := overrideRepo.Create(ctx, &domain.MatchOverride{
+			MatchID:    match.ID,
+			AppealID:   appeal.ID,
+			AdminID:    user.ID,
+			Action:     domain.MatchOverrideActionRejudge,
+			FromStatus: domain.MatchStatusWon,
+			ToStatus:   domain.MatchStatusLost,
+			Reason:     "Quoting the word is not saying it.",
+			Verdict:    &domain.JudgeVerdict{Outcome: domain.JudgeOutcomeLost, Reason: "the word was quoted"},
+		})
+		assert.NoError(t, err)
+
+		overrides, err := overrideRepo.GetByMatchID(ctx, match.ID)
+		assert.NoError(t, err)
+		if assert.Len(t, overrides, 1) {
+			assert.Equal(t, appeal.ID, overrides[0].AppealID)
+			assert.Equal(t, domain.MatchStatusLost, overrides[0].ToStatus)
+			if assert.NotNil(t, overrides[0].Verdict) {
+				assert.Equal(t, domain.JudgeOutcomeLost, overrides[0].Verdict.Outcome)
+			}
+		}
+	})
+
+	t.Run("Get appeal of an unknown ID", func(t *testing.T) {
+		_, err := repo.GetByID(ctx, "01ARZ3NDEKTSV4RRFFQ69G5FAV")
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
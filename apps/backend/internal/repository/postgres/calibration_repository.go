@@ -0,0 +1,201 @@
+package postgres
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+
+	"github.com/everyday-studio/ollm/internal/domain"
+)
+
+type calibrationRepository struct {
+	db *sql.DB
+}
+
+// NewCalibrationRepository creates a new judge calibration repository
+func NewCalibrationRepository(db *sql.DB) domain.CalibrationRepository {
+	return &calibrationRepository{
+		db: db,
+	}
+}
+
+// CreateExample inserts a new labeled example into the database
+func (r *calibrationRepository) CreateExample(ctx context.Context, example *domain.CalibrationExample) (*domain.CalibrationExample, error) {
+	example.ID = ulid.MustNew(ulid.Timestamp(time.Now()), ulid.Monotonic(rand.Reader, 0)).String()
+
+	const query = `
+        INSERT INTO calibration_examples (id, game_id, output, expected, note)
+        VALUES ($1, $2, $3, $4, $5)
+        RETURNING created_at
+    `
+
+	err := conn(ctx, r.db).QueryRowContext(ctx, query,
+		example.ID,
+		example.GameID,
+		example.Output,
+		example.Expected,
+		example.Note,
+	).Scan(&example.CreatedAt)
+	if err != nil {
+		return nil, mapDBError(err)
+	}
+
+	return example, nil
+}
+
+// GetExamplesByGameID retrieves the labeled examples of a game, oldest first
+func (r *calibrationRepository) GetExamplesByGameID(ctx context.Context, gameID string) ([]domain.CalibrationExample, error) {
+	const query = `
+        SELECT id, game_id, output, expected, note, created_at
+        FROM calibration_examples
+        WHERE game_id = $1
+        ORDER BY created_at ASC, id ASC
+    `
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, gameID)
+	if err != nil {
+		return nil, mapDBError(err)
+	}
+	defer rows.Close()
+
+	examples := []domain.CalibrationExample{}
+	for rows.Next() {
+		var e domain.CalibrationExample
+		if err := rows.Scan(&e.ID, &e.GameID, &e.Output, &e.Expected, &e.Note, &e.CreatedAt); err != nil {
+			return nil, mapDBError(err)
+		}
+		examples = append(examples, e)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, mapDBError(err)
+	}
+
+	return examples, nil
+}
+
+// DeleteExample deletes a labeled example of a game
+func (r *calibrationRepository) DeleteExample(ctx context.Context, gameID, id string) error {
+	const query = `
+        DELETE FROM calibration_examples
+        WHERE id = $1 AND game_id = $2
+    `
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, id, gameID)
+	if err != nil {
+		return mapDBError(err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return mapDBError(err)
+	}
+
+	if rowsAffected == 0 {
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
+
+// CreateRun inserts the report of a calibration run into the database
+func (r *calibrationRepository) CreateRun(ctx context.Context, run *domain.CalibrationRun) (*domain.CalibrationRun, error) {
+	run.ID = ulid.MustNew(ulid.Timestamp(time.Now()), ulid.Monotonic(rand.Reader, 0)).String()
+
+	judgeJSON, err := json.Marshal(run.Judge)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode calibration judge: %w", err)
+	}
+	results := run.Results
+	if results == nil {
+		results = []domain.CalibrationResult{}
+	}
+	resultsJSON, err := json.Marshal(results)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode calibration results: %w", err)
+	}
+
+	const query = `
+        INSERT INTO calibration_runs (id, game_id, judge, total, correct, false_positives, false_negatives, errors,
+                                      results, prompt_tokens, completion_tokens)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+        RETURNING created_at
+    `
+
+	err = conn(ctx, r.db).QueryRowContext(ctx, query,
+		run.ID,
+		run.GameID,
+		judgeJSON,
+		run.Total,
+		run.Correct,
+		run.FalsePositives,
+		run.FalseNegatives,
+		run.Errors,
+		resultsJSON,
+		run.PromptTokens,
+		run.CompletionTokens,
+	).Scan(&run.CreatedAt)
+	if err != nil {
+		return nil, mapDBError(err)
+	}
+
+	return run, nil
+}
+
+// GetRunsByGameID retrieves the latest calibration runs of a game, newest first
+func (r *calibrationRepository) GetRunsByGameID(ctx context.Context, gameID string, limit int) ([]domain.CalibrationRun, error) {
+	const query = `
+        SELECT id, game_id, judge, total, correct, false_positives, false_negatives, errors,
+               results, prompt_tokens, completion_tokens, created_at
+        FROM calibration_runs
+        WHERE game_id = $1
+        ORDER BY created_at DESC, id DESC
+        LIMIT $2
+    `
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, gameID, limit)
+	if err != nil {
+		return nil, mapDBError(err)
+	}
+	defer rows.Close()
+
+	runs := []domain.CalibrationRun{}
+	for rows.Next() {
+		var run domain.CalibrationRun
+		var judgeJSON, resultsJSON []byte
+		if err := rows.Scan(
+			&run.ID,
+			&run.GameID,
+			&judgeJSON,
+			&run.Total,
+			&run.Correct,
+			&run.FalsePositives,
+			&run.FalseNegatives,
+			&run.Errors,
+			&resultsJSON,
+			&run.PromptTokens,
+			&run.CompletionTokens,
+			&run.CreatedAt,
+		); err != nil {
+			return nil, mapDBError(err)
+		}
+		if err := json.Unmarshal(judgeJSON, &run.Judge); err != nil {
+			return nil, fmt.Errorf("failed to decode calibration judge: %w", err)
+		}
+		if err := json.Unmarshal(resultsJSON, &run.Results); err != nil {
+			return nil, fmt.Errorf("failed to decode calibration results: %w", err)
+		}
+		runs = append(runs, run)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, mapDBError(err)
+	}
+
+	return runs, nil
+}
@@ -0,0 +1,97 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/everyday-studio/ollm/internal/domain"
+)
+
+func TestCalibrationRepository_Examples(t *testing.T) {
+	cleanDB(t, "calibration_runs", "calibration_examples", "games", "users")
+	ctx := context.Background()
+	repo := NewCalibrationRepository(testDB)
+
+	user := createTestUser(t)
+	game := createTestGame(t, user)
+
+	var created []*domain.CalibrationExample
+	t.Run("Create examples", func(t *testing.T) {
+		for _, example := range []*domain.CalibrationExample{
+			{GameID: game.ID, Output: "The word is apple.", Expected: domain.JudgeOutcomeWon, Note: "plain"},
+			{GameID: game.ID, Output: "I won't say it.", Expected: domain.JudgeOutcomeContinue},
+		} {
+			saved, err := repo.CreateExample(ctx, example)
+			assert.NoError(t, err)
+			assert.NotEmpty(t, saved.ID)
+			assert.NotZero(t, saved.CreatedAt)
+			created = append(created, saved)
+		}
+	})
+
+	t.Run("Get examples oldest first", func(t *testing.T) {
+		examples, err := repo.GetExamplesByGameID(ctx, game.ID)
+
+		assert.NoError(t, err)
+		if assert.Len(t, examples, 2) {
+			assert.Equal(t, "The word is apple.", examples[0].Output)
+			assert.Equal(t, domain.JudgeOutcomeWon, examples[0].Expected)
+			assert.Equal(t, "plain", examples[0].Note)
+			assert.Equal(t, domain.JudgeOutcomeContinue, examples[1].Expected)
+		}
+	})
+
+	t.Run("Delete an example", func(t *testing.T) {
+		err := repo.DeleteExample(ctx, game.ID, created[0].ID)
+		assert.NoError(t, err)
+
+		examples, err := repo.GetExamplesByGameID(ctx, game.ID)
+		assert.NoError(t, err)
+		assert.Len(t, examples, 1)
+	})
+
+	t.Run("Delete an example of another game", func(t *testing.T) {
+		err := repo.DeleteExample(ctx, "01ARZ3NDEKTSV4RRFFQ69G5FAV", created[1].ID)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
+
+func TestCalibrationRepository_Runs(t *testing.T) {
+	cleanDB(t, "calibration_runs", "calibration_examples", "games", "users")
+	ctx := context.Background()
+	repo := NewCalibrationRepository(testDB)
+
+	user := createTestUser(t)
+	game := createTestGame(t, user)
+
+	t.Run("Store runs and get the latest first", func(t *testing.T) {
+		for _, condition := range []string{"apple", "banana", "cherry"} {
+			_, err := repo.CreateRun(ctx, &domain.CalibrationRun{
+				GameID:         game.ID,
+				Judge:          domain.CalibrationJudge{Type: domain.JudgeTypeTargetWord, Condition: condition},
+				Total:          2,
+				Correct:        1,
+				FalsePositives: 1,
+				Results: []domain.CalibrationResult{
+					{ExampleID: "a", Expected: domain.JudgeOutcomeWon, Outcome: domain.JudgeOutcomeWon},
+					{ExampleID: "b", Expected: domain.JudgeOutcomeContinue, Outcome: domain.JudgeOutcomeWon, Reason: "reply contains the target word"},
+				},
+			})
+			assert.NoError(t, err)
+		}
+
+		runs, err := repo.GetRunsByGameID(ctx, game.ID, 2)
+
+		assert.NoError(t, err)
+		if assert.Len(t, runs, 2) {
+			assert.Equal(t, "cherry", runs[0].Judge.Condition)
+			assert.Equal(t, "banana", runs[1].Judge.Condition)
+			assert.Equal(t, 1, runs[0].FalsePositives)
+			if assert.Len(t, runs[0].Results, 2) {
+				assert.Equal(t, "reply contains the target word", runs[0].Results[1].Reason)
+			}
+		}
+	})
+}
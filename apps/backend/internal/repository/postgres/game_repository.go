@@ -7,6 +7,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/lib/pq"
 	"github.com/oklog/ulid/v2"
 
 	"github.com/everyday-studio/ollm/internal/domain"
@@ -29,12 +30,13 @@ func (r *gameRepository) Create(ctx context.Context, game *domain.Game) (*domain
 	game.ID = ulid.MustNew(ulid.Timestamp(time.Now()), ulid.Monotonic(rand.Reader, 0)).String()
 
 	const query = `
-		INSERT INTO games (id, title, description, author_id, status, is_public, system_prompt, first_message, judge_type, judge_condition, max_turns)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		INSERT INTO games (id, title, description, author_id, status, is_public, system_prompt, first_message, judge_type, judge_condition, max_turns, chat_model, judge_model,
+			judge_panel, judge_policy, judge_quorum, judge_strictness, judge_scope, judge_scope_turns, temperature, max_tokens, secrets, idle_ttl_minutes)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23)
 		RETURNING created_at, updated_at
 	`
 
-	err := r.db.QueryRowContext(
+	err := conn(ctx, r.db).QueryRowContext(
 		ctx,
 		query,
 		game.ID,
@@ -48,6 +50,18 @@ func (r *gameRepository) Create(ctx context.Context, game *domain.Game) (*domain
 		game.JudgeType,
 		game.JudgeCondition,
 		game.MaxTurns,
+		game.ChatModel,
+		game.JudgeModel,
+		pq.Array(judgePanel(game)),
+		game.JudgePolicy,
+		game.JudgeQuorum,
+		game.JudgeStrictness,
+		game.JudgeScope,
+		game.JudgeScopeTurns,
+		game.Temperature,
+		game.MaxTokens,
+		jsonMap(&game.Secrets),
+		game.IdleTTLMinutes,
 	).Scan(&game.CreatedAt, &game.UpdatedAt)
 
 	if err != nil {
@@ -60,13 +74,13 @@ func (r *gameRepository) Create(ctx context.Context, game *domain.Game) (*domain
 // GetByID retrieves a game by its ID
 func (r *gameRepository) GetByID(ctx context.Context, id string) (*domain.Game, error) {
 	const query = `
-		SELECT id, title, description, author_id, status, is_public, system_prompt, first_message, judge_type, judge_condition, max_turns, play_count, created_at, updated_at
+		SELECT id, title, description, author_id, status, is_public, system_prompt, first_message, judge_type, judge_condition, max_turns, chat_model, judge_model, judge_panel, judge_policy, judge_quorum, judge_strictness, judge_scope, judge_scope_turns, temperature, max_tokens, secrets, idle_ttl_minutes, play_count, created_at, updated_at
 		FROM games
 		WHERE id = $1
 	`
 
 	var game domain.Game
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, id).Scan(
 		&game.ID,
 		&game.Title,
 		&game.Description,
@@ -78,6 +92,18 @@ func (r *gameRepository) GetByID(ctx context.Context, id string) (*domain.Game,
 		&game.JudgeType,
 		&game.JudgeCondition,
 		&game.MaxTurns,
+		&game.ChatModel,
+		&game.JudgeModel,
+		pq.Array(&game.JudgePanel),
+		&game.JudgePolicy,
+		&game.JudgeQuorum,
+		&game.JudgeStrictness,
+		&game.JudgeScope,
+		&game.JudgeScopeTurns,
+		&game.Temperature,
+		&game.MaxTokens,
+		jsonMap(&game.Secrets),
+		&game.IdleTTLMinutes,
 		&game.PlayCount,
 		&game.CreatedAt,
 		&game.UpdatedAt,
@@ -101,7 +127,7 @@ func (r *gameRepository) CountAll(ctx context.Context, filter *domain.GameFilter
 	}
 
 	var count int
-	if err := r.db.QueryRowContext(ctx, query, args...).Scan(&count); err != nil {
+	if err := conn(ctx, r.db).QueryRowContext(ctx, query, args...).Scan(&count); err != nil {
 		return 0, mapDBError(err)
 	}
 	return count, nil
@@ -111,7 +137,7 @@ func (r *gameRepository) CountAll(ctx context.Context, filter *domain.GameFilter
 func (r *gameRepository) GetPaginated(ctx context.Context, page, limit int, filter *domain.GameFilter) ([]domain.Game, error) {
 	offset := (page - 1) * limit
 	query := `
-		SELECT id, title, description, author_id, status, is_public, system_prompt, first_message, judge_type, judge_condition, max_turns, play_count, created_at, updated_at
+		SELECT id, title, description, author_id, status, is_public, system_prompt, first_message, judge_type, judge_condition, max_turns, chat_model, judge_model, judge_panel, judge_policy, judge_quorum, judge_strictness, judge_scope, judge_scope_turns, temperature, max_tokens, secrets, idle_ttl_minutes, play_count, created_at, updated_at
 		FROM games
 	`
 	args := []interface{}{}
@@ -139,7 +165,7 @@ func (r *gameRepository) GetPaginated(ctx context.Context, page, limit int, filt
 	query += ` LIMIT $` + strconv.Itoa(argIdx) + ` OFFSET $` + strconv.Itoa(argIdx+1)
 	args = append(args, limit, offset)
 
-	rows, err := r.db.QueryContext(ctx, query, args...)
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, mapDBError(err)
 	}
@@ -160,6 +186,18 @@ func (r *gameRepository) GetPaginated(ctx context.Context, page, limit int, filt
 			&game.JudgeType,
 			&game.JudgeCondition,
 			&game.MaxTurns,
+			&game.ChatModel,
+			&game.JudgeModel,
+			pq.Array(&game.JudgePanel),
+			&game.JudgePolicy,
+			&game.JudgeQuorum,
+			&game.JudgeStrictness,
+			&game.JudgeScope,
+			&game.JudgeScopeTurns,
+			&game.Temperature,
+			&game.MaxTokens,
+			jsonMap(&game.Secrets),
+			&game.IdleTTLMinutes,
 			&game.PlayCount,
 			&game.CreatedAt,
 			&game.UpdatedAt,
@@ -181,12 +219,15 @@ func (r *gameRepository) GetPaginated(ctx context.Context, page, limit int, filt
 func (r *gameRepository) Update(ctx context.Context, game *domain.Game) (*domain.Game, error) {
 	const query = `
 		UPDATE games
-		SET title = $1, description = $2, status = $3, is_public = $4, system_prompt = $5, first_message = $6, judge_type = $7, judge_condition = $8, max_turns = $9
-		WHERE id = $10
+		SET title = $1, description = $2, status = $3, is_public = $4, system_prompt = $5, first_message = $6, judge_type = $7, judge_condition = $8, max_turns = $9,
+			chat_model = $10, judge_model = $11, judge_panel = $12, judge_policy = $13, judge_quorum = $14,
+			judge_strictness = $15, judge_scope = $16, judge_scope_turns = $17, temperature = $18, max_tokens = $19,
+			secrets = $20, idle_ttl_minutes = $21
+		WHERE id = $22
 		RETURNING updated_at
 	`
 
-	err := r.db.QueryRowContext(
+	err := conn(ctx, r.db).QueryRowContext(
 		ctx,
 		query,
 		game.Title,
@@ -198,6 +239,18 @@ func (r *gameRepository) Update(ctx context.Context, game *domain.Game) (*domain
 		game.JudgeType,
 		game.JudgeCondition,
 		game.MaxTurns,
+		game.ChatModel,
+		game.JudgeModel,
+		pq.Array(judgePanel(game)),
+		game.JudgePolicy,
+		game.JudgeQuorum,
+		game.JudgeStrictness,
+		game.JudgeScope,
+		game.JudgeScopeTurns,
+		game.Temperature,
+		game.MaxTokens,
+		jsonMap(&game.Secrets),
+		game.IdleTTLMinutes,
 		game.ID,
 	).Scan(&game.UpdatedAt)
 
@@ -215,7 +268,7 @@ func (r *gameRepository) Delete(ctx context.Context, id string) error {
 		WHERE id = $1
 	`
 
-	result, err := r.db.ExecContext(ctx, query, id)
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, id)
 	if err != nil {
 		return mapDBError(err)
 	}
@@ -231,3 +284,11 @@ func (r *gameRepository) Delete(ctx context.Context, id string) error {
 
 	return nil
 }
+
+// judgePanel returns the judge panel of the game, never nil so that it is stored as an empty array rather than NULL
+func judgePanel(game *domain.Game) []string {
+	if game.JudgePanel == nil {
+		return []string{}
+	}
+	return game.JudgePanel
+}
@@ -121,6 +121,22 @@ func TestGameRepository_GetByID(t *testing.T) {
 		assert.Equal(t, 0, fetchedGame.PlayCount)
 	})
 
+	t.Run("Keep an unset temperature apart from a zero one", func(t *testing.T) {
+		zero := 0.0
+		deterministic, _ := repo.Create(ctx, &domain.Game{Title: "Deterministic", AuthorID: author.ID, Status: domain.GameStatusActive, Temperature: &zero})
+		unset, _ := repo.Create(ctx, &domain.Game{Title: "Model Default", AuthorID: author.ID, Status: domain.GameStatusActive})
+
+		fetchedDeterministic, err := repo.GetByID(ctx, deterministic.ID)
+		assert.NoError(t, err)
+		fetchedUnset, err := repo.GetByID(ctx, unset.ID)
+		assert.NoError(t, err)
+
+		if assert.NotNil(t, fetchedDeterministic.Temperature) {
+			assert.Equal(t, 0.0, *fetchedDeterministic.Temperature)
+		}
+		assert.Nil(t, fetchedUnset.Temperature)
+	})
+
 	t.Run("Fail to get game with non-existent ID", func(t *testing.T) {
 		fetchedGame, err := repo.GetByID(ctx, "01HQZYX3VQJQZ3Z0Z1Z2NONEXIST")
 
@@ -307,6 +323,33 @@ func TestGameRepository_Update(t *testing.T) {
 		assert.True(t, updatedGame.IsPublic)
 	})
 
+	t.Run("Store the judge panel", func(t *testing.T) {
+		createdGame, _ := repo.Create(ctx, &domain.Game{Title: "Panel Game", AuthorID: author.ID, Status: domain.GameStatusActive})
+
+		createdGame.JudgePanel = []string{"gpt-4o", "llama-3"}
+		createdGame.JudgePolicy = domain.JudgePolicyQuorum
+		createdGame.JudgeQuorum = 1
+		_, err := repo.Update(ctx, createdGame)
+		assert.NoError(t, err)
+
+		fetchedGame, err := repo.GetByID(ctx, createdGame.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"gpt-4o", "llama-3"}, fetchedGame.JudgePanel)
+		assert.Equal(t, domain.JudgePolicyQuorum, fetchedGame.JudgePolicy)
+		assert.Equal(t, 1, fetchedGame.JudgeQuorum)
+	})
+
+	t.Run("Store the judge scope", func(t *testing.T) {
+		createdGame, err := repo.Create(ctx, &domain.Game{Title: "Scope Game", AuthorID: author.ID, Status: domain.GameStatusActive,
+			JudgeScope: domain.JudgeScopeLastTurns, JudgeScopeTurns: 3})
+		assert.NoError(t, err)
+
+		fetchedGame, err := repo.GetByID(ctx, createdGame.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.JudgeScopeLastTurns, fetchedGame.JudgeScope)
+		assert.Equal(t, 3, fetchedGame.JudgeScopeTurns)
+	})
+
 	t.Run("Fail to update non-existent game", func(t *testing.T) {
 		game := &domain.Game{
 			ID:    "01HQZYX3VQJQZ3Z0Z1Z2NONEXIST",
@@ -0,0 +1,153 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+
+	"github.com/everyday-studio/ollm/internal/domain"
+)
+
+type idempotencyRepository struct {
+	db *sql.DB
+}
+
+// NewIdempotencyRepository creates a new idempotency key repository
+func NewIdempotencyRepository(db *sql.DB) domain.IdempotencyRepository {
+	return &idempotencyRepository{
+		db: db,
+	}
+}
+
+// reserveAttempts bounds the inserts of Reserve when the existing key is released between the insert and the select
+const reserveAttempts = 3
+
+// Reserve inserts the key unless the user already holds it, in which case the existing record is returned.
+// A key still without a result that was reserved before staleBefore is taken over as a new reservation.
+// Every reservation gets a new token, which Complete and Release must present.
+func (r *idempotencyRepository) Reserve(ctx context.Context, key *domain.IdempotencyKey, staleBefore time.Time) (*domain.IdempotencyKey, bool, error) {
+	const insertQuery = `
+        INSERT INTO idempotency_keys (user_id, idempotency_key, token, match_id, fingerprint)
+        VALUES ($1, $2, $3, $4, $5)
+        ON CONFLICT (user_id, idempotency_key) DO UPDATE
+        SET token = EXCLUDED.token,
+            match_id = EXCLUDED.match_id,
+            fingerprint = EXCLUDED.fingerprint,
+            created_at = CURRENT_TIMESTAMP,
+            updated_at = CURRENT_TIMESTAMP
+        WHERE idempotency_keys.result IS NULL
+          AND idempotency_keys.created_at < ($6::timestamptz AT TIME ZONE 'UTC')
+        RETURNING created_at, updated_at
+    `
+	const selectQuery = `
+        SELECT user_id, idempotency_key, match_id, fingerprint, result, created_at, updated_at
+        FROM idempotency_keys
+        WHERE user_id = $1 AND idempotency_key = $2
+    `
+
+	for range reserveAttempts {
+		token := ulid.Make().String()
+		err := conn(ctx, r.db).QueryRowContext(ctx, insertQuery, key.UserID, key.Key, token, key.MatchID, key.Fingerprint, staleBefore).
+			Scan(&key.CreatedAt, &key.UpdatedAt)
+		if err == nil {
+			key.Token = token
+			key.Result = nil
+			return key, true, nil
+		}
+		if !errors.Is(err, sql.ErrNoRows) {
+			return nil, false, mapDBError(err)
+		}
+
+		var existing domain.IdempotencyKey
+		err = conn(ctx, r.db).QueryRowContext(ctx, selectQuery, key.UserID, key.Key).Scan(
+			&existing.UserID,
+			&existing.Key,
+			&existing.MatchID,
+			&existing.Fingerprint,
+			nullableJSON(&existing.Result),
+			&existing.CreatedAt,
+			&existing.UpdatedAt,
+		)
+		if errors.Is(err, sql.ErrNoRows) {
+			// The holder released the key between the insert and the select, so it is free again
+			continue
+		}
+		if err != nil {
+			return nil, false, mapDBError(err)
+		}
+
+		return &existing, false, nil
+	}
+
+	return nil, false, fmt.Errorf("%w: idempotency key is being reserved and released concurrently", domain.ErrConflict)
+}
+
+// Complete stores the result of the turn played for the key.
+// A key that is gone, already has a result or was taken over by another token is left as it is and reported with ErrNotFound.
+func (r *idempotencyRepository) Complete(ctx context.Context, userID string, key string, token string, result *domain.TurnResult) error {
+	const query = `
+        UPDATE idempotency_keys
+        SET result = $4, updated_at = CURRENT_TIMESTAMP
+        WHERE user_id = $1 AND idempotency_key = $2 AND token = $3 AND result IS NULL
+    `
+
+	res, err := conn(ctx, r.db).ExecContext(ctx, query, userID, key, token, nullableJSON(&result))
+	if err != nil {
+		return mapDBError(err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return mapDBError(err)
+	}
+
+	if rowsAffected == 0 {
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
+
+// Release deletes a key that has no result yet and is still held with token
+func (r *idempotencyRepository) Release(ctx context.Context, userID string, key string, token string) error {
+	const query = `
+        DELETE FROM idempotency_keys
+        WHERE user_id = $1 AND idempotency_key = $2 AND token = $3 AND result IS NULL
+    `
+
+	if _, err := conn(ctx, r.db).ExecContext(ctx, query, userID, key, token); err != nil {
+		return mapDBError(err)
+	}
+
+	return nil
+}
+
+// Purge deletes up to limit keys reserved before the given time, with or without a result
+func (r *idempotencyRepository) Purge(ctx context.Context, before time.Time, limit int) (int64, error) {
+	const query = `
+        DELETE FROM idempotency_keys
+        WHERE (user_id, idempotency_key) IN (
+            SELECT user_id, idempotency_key
+            FROM idempotency_keys
+            WHERE created_at < ($1::timestamptz AT TIME ZONE 'UTC')
+            ORDER BY created_at
+            LIMIT $2
+        )
+    `
+
+	res, err := conn(ctx, r.db).ExecContext(ctx, query, before, limit)
+	if err != nil {
+		return 0, mapDBError(err)
+	}
+
+	purged, err := res.RowsAffected()
+	if err != nil {
+		return 0, mapDBError(err)
+	}
+
+	return purged, nil
+}
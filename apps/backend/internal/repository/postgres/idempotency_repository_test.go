@@ -0,0 +1,160 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/everyday-studio/ollm/internal/domain"
+)
+
+func TestIdempotencyRepository(t *testing.T) {
+	cleanDB(t, "idempotency_keys", "matches", "games", "users")
+	ctx := context.Background()
+	repo := NewIdempotencyRepository(testDB)
+
+	user := createTestUser(t)
+	game := createTestGame(t, user)
+	match := createTestMatch(t, user, game)
+
+	// Keys reserved before this are stale; none of the keys below are
+	longAgo := time.Now().Add(-time.Hour)
+
+	newKey := func(key string) *domain.IdempotencyKey {
+		return &domain.IdempotencyKey{UserID: user.ID, Key: key, MatchID: match.ID, Fingerprint: "f1"}
+	}
+
+	// The token k1 was reserved with
+	var token string
+
+	t.Run("Reserve a new key", func(t *testing.T) {
+		got, reserved, err := repo.Reserve(ctx, newKey("k1"), longAgo)
+		assert.NoError(t, err)
+		assert.True(t, reserved)
+		assert.NotEmpty(t, got.Token)
+		assert.NotZero(t, got.CreatedAt)
+		token = got.Token
+	})
+
+	t.Run("Return the pending record of a reserved key", func(t *testing.T) {
+		retry := newKey("k1")
+		retry.Fingerprint = "f2"
+
+		got, reserved, err := repo.Reserve(ctx, retry, longAgo)
+		assert.NoError(t, err)
+		assert.False(t, reserved)
+		assert.Equal(t, "f1", got.Fingerprint)
+		assert.Empty(t, got.Token, "the holder's token is not handed out")
+		assert.Nil(t, got.Result)
+	})
+
+	t.Run("Return the stored result once completed", func(t *testing.T) {
+		advice := "Be specific"
+		result := &domain.TurnResult{
+			Message:      &domain.Message{ID: "M1", MatchID: match.ID, Role: domain.MessageRoleAssistant, Content: "Hi", TurnCount: 1},
+			MatchStatus:  domain.MatchStatusWon,
+			PromptAdvice: &advice,
+			Verdict:      &domain.TurnVerdict{TurnCount: 1, Outcome: domain.JudgeOutcomeWon, Reason: "reply contains the target word"},
+		}
+		assert.NoError(t, repo.Complete(ctx, user.ID, "k1", token, result))
+
+		got, reserved, err := repo.Reserve(ctx, newKey("k1"), longAgo)
+		assert.NoError(t, err)
+		assert.False(t, reserved)
+		assert.Equal(t, "Hi", got.Result.Message.Content)
+		assert.Equal(t, domain.MatchStatusWon, got.Result.MatchStatus)
+		assert.Equal(t, "reply contains the target word", got.Result.Verdict.Reason)
+	})
+
+	t.Run("Keep completed keys when released", func(t *testing.T) {
+		assert.NoError(t, repo.Release(ctx, user.ID, "k1", token))
+
+		_, reserved, err := repo.Reserve(ctx, newKey("k1"), longAgo)
+		assert.NoError(t, err)
+		assert.False(t, reserved)
+	})
+
+	t.Run("Free a pending key when released", func(t *testing.T) {
+		got, reserved, err := repo.Reserve(ctx, newKey("k2"), longAgo)
+		assert.NoError(t, err)
+		assert.True(t, reserved)
+
+		assert.NoError(t, repo.Release(ctx, user.ID, "k2", got.Token))
+
+		_, reserved, err = repo.Reserve(ctx, newKey("k2"), longAgo)
+		assert.NoError(t, err)
+		assert.True(t, reserved)
+	})
+
+	t.Run("Scope keys to the user", func(t *testing.T) {
+		other := createTestUser(t)
+		key := newKey("k1")
+		key.UserID = other.ID
+
+		_, reserved, err := repo.Reserve(ctx, key, longAgo)
+		assert.NoError(t, err)
+		assert.True(t, reserved)
+	})
+
+	t.Run("Fail to complete an unknown key", func(t *testing.T) {
+		err := repo.Complete(ctx, user.ID, "missing", token, &domain.TurnResult{})
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+
+	t.Run("Keep the first result of a completed key", func(t *testing.T) {
+		err := repo.Complete(ctx, user.ID, "k1", token, &domain.TurnResult{MatchStatus: domain.MatchStatusLost})
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+
+		got, _, err := repo.Reserve(ctx, newKey("k1"), longAgo)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.MatchStatusWon, got.Result.MatchStatus)
+	})
+
+	t.Run("Take over a stale key without a result", func(t *testing.T) {
+		stale, reserved, err := repo.Reserve(ctx, newKey("k3"), longAgo)
+		assert.NoError(t, err)
+		assert.True(t, reserved)
+		staleToken := stale.Token
+
+		retry := newKey("k3")
+		retry.Fingerprint = "f2"
+		got, reserved, err := repo.Reserve(ctx, retry, time.Now().Add(time.Minute))
+		assert.NoError(t, err)
+		assert.True(t, reserved)
+		assert.Equal(t, "f2", got.Fingerprint)
+		assert.NotEqual(t, staleToken, got.Token)
+
+		// The request that held the stale key can neither complete nor release the new reservation
+		err = repo.Complete(ctx, user.ID, "k3", staleToken, &domain.TurnResult{MatchStatus: domain.MatchStatusLost})
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+		assert.NoError(t, repo.Release(ctx, user.ID, "k3", staleToken))
+
+		pending, reserved, err := repo.Reserve(ctx, newKey("k3"), longAgo)
+		assert.NoError(t, err)
+		assert.False(t, reserved)
+		assert.Equal(t, "f2", pending.Fingerprint)
+		assert.Nil(t, pending.Result)
+
+		// A completed key is never taken over
+		got, reserved, err = repo.Reserve(ctx, newKey("k1"), time.Now().Add(time.Minute))
+		assert.NoError(t, err)
+		assert.False(t, reserved)
+		assert.NotNil(t, got.Result)
+	})
+
+	t.Run("Purge keys reserved before the cutoff", func(t *testing.T) {
+		purged, err := repo.Purge(ctx, longAgo, 10)
+		assert.NoError(t, err)
+		assert.Zero(t, purged)
+
+		purged, err = repo.Purge(ctx, time.Now().Add(time.Minute), 2)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), purged)
+
+		_, reserved, err := repo.Reserve(ctx, newKey("k1"), longAgo)
+		assert.NoError(t, err)
+		assert.True(t, reserved, "the oldest key is purged first")
+	})
+}
@@ -0,0 +1,92 @@
+package postgres
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// jsonColumn maps an optional field to a nullable JSONB column: nil is stored as NULL and NULL scans back to nil.
+type jsonColumn[T any] struct {
+	field **T
+}
+
+// nullableJSON wraps the field for use as a query argument or a scan destination.
+func nullableJSON[T any](field **T) jsonColumn[T] {
+	return jsonColumn[T]{field: field}
+}
+
+// Value encodes the field as JSON, or NULL when it is nil.
+func (c jsonColumn[T]) Value() (driver.Value, error) {
+	if *c.field == nil {
+		return nil, nil
+	}
+	b, err := json.Marshal(*c.field)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode json column: %w", err)
+	}
+	return b, nil
+}
+
+// Scan decodes a JSON column into the field, setting it to nil for NULL.
+func (c jsonColumn[T]) Scan(src any) error {
+	var b []byte
+	switch v := src.(type) {
+	case nil:
+		*c.field = nil
+		return nil
+	case []byte:
+		b = v
+	case string:
+		b = []byte(v)
+	default:
+		return fmt.Errorf("unsupported json column type %T", src)
+	}
+
+	value := new(T)
+	if err := json.Unmarshal(b, value); err != nil {
+		return fmt.Errorf("failed to decode json column: %w", err)
+	}
+	*c.field = value
+	return nil
+}
+
+// jsonObject maps a map field to a JSONB column that is never NULL: a nil map is stored as {}.
+type jsonObject[V any] struct {
+	field *map[string]V
+}
+
+// jsonMap wraps the map field for use as a query argument or a scan destination.
+func jsonMap[V any](field *map[string]V) jsonObject[V] {
+	return jsonObject[V]{field: field}
+}
+
+// Value encodes the map as a JSON object.
+func (c jsonObject[V]) Value() (driver.Value, error) {
+	if *c.field == nil {
+		return []byte("{}"), nil
+	}
+	b, err := json.Marshal(*c.field)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode json column: %w", err)
+	}
+	return b, nil
+}
+
+// Scan decodes a JSON object column into the map field.
+func (c jsonObject[V]) Scan(src any) error {
+	var b []byte
+	switch v := src.(type) {
+	case []byte:
+		b = v
+	case string:
+		b = []byte(v)
+	default:
+		return fmt.Errorf("unsupported json column type %T", src)
+	}
+
+	if err := json.Unmarshal(b, c.field); err != nil {
+		return fmt.Errorf("failed to decode json column: %w", err)
+	}
+	return nil
+}
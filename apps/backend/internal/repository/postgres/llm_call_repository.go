@@ -0,0 +1,211 @@
+package postgres
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+
+	"github.com/everyday-studio/ollm/internal/domain"
+)
+
+type llmCallRepository struct {
+	db *sql.DB
+}
+
+// NewLLMCallRepository creates a new LLM call audit repository
+func NewLLMCallRepository(db *sql.DB) domain.LLMCallRepository {
+	return &llmCallRepository{
+		db: db,
+	}
+}
+
+// Create inserts a new LLM call record into the database
+func (r *llmCallRepository) Create(ctx context.Context, call *domain.LLMCall) (*domain.LLMCall, error) {
+	call.ID = ulid.MustNew(ulid.Timestamp(time.Now()), ulid.Monotonic(rand.Reader, 0)).String()
+
+	request := call.Request
+	if request == nil {
+		request = []domain.LLMCallMessage{}
+	}
+	requestJSON, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode llm call request: %w", err)
+	}
+
+	// 호출 기록과 매치 비용 누적을 한 문장으로 처리
+	const query = `
+        WITH inserted AS (
+            INSERT INTO llm_calls (id, match_id, message_id, purpose, provider, model, request, response,
+                                   latency_ms, prompt_tokens, completion_tokens, cost_usd, error)
+            VALUES ($1, NULLIF($2, ''), NULLIF($3, ''), $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+            RETURNING match_id, cost_usd, created_at
+        ), charged AS (
+            UPDATE matches
+            SET cost_usd = matches.cost_usd + inserted.cost_usd
+            FROM inserted
+            WHERE matches.id = inserted.match_id AND inserted.cost_usd > 0
+        )
+        SELECT created_at FROM inserted
+    `
+
+	err = conn(ctx, r.db).QueryRowContext(
+		ctx,
+		query,
+		call.ID,
+		call.MatchID,
+		call.MessageID,
+		call.Purpose,
+		call.Provider,
+		call.Model,
+		requestJSON,
+		call.Response,
+		call.LatencyMs,
+		call.PromptTokens,
+		call.CompletionTokens,
+		call.CostUSD,
+		call.Error,
+	).Scan(&call.CreatedAt)
+
+	if err != nil {
+		return nil, mapDBError(err)
+	}
+
+	return call, nil
+}
+
+// GetByMatchID retrieves all LLM calls made for a specific match, oldest first
+func (r *llmCallRepository) GetByMatchID(ctx context.Context, matchID string) ([]domain.LLMCall, error) {
+	const query = `
+        SELECT id, COALESCE(match_id, ''), COALESCE(message_id, ''), purpose, provider, model, request, response,
+               latency_ms, prompt_tokens, completion_tokens, cost_usd, error, created_at
+        FROM llm_calls
+        WHERE match_id = $1
+        ORDER BY created_at ASC, id ASC
+    `
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, matchID)
+	if err != nil {
+		return nil, mapDBError(err)
+	}
+	defer rows.Close()
+
+	calls := []domain.LLMCall{}
+
+	for rows.Next() {
+		var call domain.LLMCall
+		var requestJSON []byte
+		if err := rows.Scan(
+			&call.ID,
+			&call.MatchID,
+			&call.MessageID,
+			&call.Purpose,
+			&call.Provider,
+			&call.Model,
+			&requestJSON,
+			&call.Response,
+			&call.LatencyMs,
+			&call.PromptTokens,
+			&call.CompletionTokens,
+			&call.CostUSD,
+			&call.Error,
+			&call.CreatedAt,
+		); err != nil {
+			return nil, mapDBError(err)
+		}
+		if err := json.Unmarshal(requestJSON, &call.Request); err != nil {
+			return nil, fmt.Errorf("failed to decode llm call request: %w", err)
+		}
+		calls = append(calls, call)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, mapDBError(err)
+	}
+
+	return calls, nil
+}
+
+// GetSpendByDay aggregates the calls made in [from, to) per day, oldest first
+func (r *llmCallRepository) GetSpendByDay(ctx context.Context, from, to time.Time) ([]domain.SpendSummary, error) {
+	const query = `
+        SELECT TO_CHAR(DATE(created_at), 'YYYY-MM-DD') AS day, TO_CHAR(DATE(created_at), 'YYYY-MM-DD'), COUNT(*),
+               COALESCE(SUM(prompt_tokens), 0), COALESCE(SUM(completion_tokens), 0), COALESCE(SUM(cost_usd), 0)
+        FROM llm_calls
+        WHERE created_at >= $1 AND created_at < $2
+        GROUP BY day
+        ORDER BY day ASC
+    `
+
+	return r.querySpend(ctx, query, from, to)
+}
+
+// GetSpendByUser aggregates the calls made in [from, to) per user of the match, highest cost first
+func (r *llmCallRepository) GetSpendByUser(ctx context.Context, from, to time.Time, limit int) ([]domain.SpendSummary, error) {
+	const query = `
+        SELECT u.id, u.name, COUNT(*),
+               COALESCE(SUM(c.prompt_tokens), 0), COALESCE(SUM(c.completion_tokens), 0), COALESCE(SUM(c.cost_usd), 0)
+        FROM llm_calls c
+        JOIN matches m ON m.id = c.match_id
+        JOIN users u ON u.id = m.user_id
+        WHERE c.created_at >= $1 AND c.created_at < $2
+        GROUP BY u.id, u.name
+        ORDER BY SUM(c.cost_usd) DESC, u.id ASC
+        LIMIT $3
+    `
+
+	return r.querySpend(ctx, query, from, to, limit)
+}
+
+// GetSpendByGame aggregates the calls made in [from, to) per game of the match, highest cost first
+func (r *llmCallRepository) GetSpendByGame(ctx context.Context, from, to time.Time, limit int) ([]domain.SpendSummary, error) {
+	const query = `
+        SELECT g.id, g.title, COUNT(*),
+               COALESCE(SUM(c.prompt_tokens), 0), COALESCE(SUM(c.completion_tokens), 0), COALESCE(SUM(c.cost_usd), 0)
+        FROM llm_calls c
+        JOIN matches m ON m.id = c.match_id
+        JOIN games g ON g.id = m.game_id
+        WHERE c.created_at >= $1 AND c.created_at < $2
+        GROUP BY g.id, g.title
+        ORDER BY SUM(c.cost_usd) DESC, g.id ASC
+        LIMIT $3
+    `
+
+	return r.querySpend(ctx, query, from, to, limit)
+}
+
+// querySpend runs an aggregation query selecting key, label, calls, prompt tokens, completion tokens and cost.
+func (r *llmCallRepository) querySpend(ctx context.Context, query string, args ...any) ([]domain.SpendSummary, error) {
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, mapDBError(err)
+	}
+	defer rows.Close()
+
+	summaries := []domain.SpendSummary{}
+
+	for rows.Next() {
+		var s domain.SpendSummary
+		if err := rows.Scan(
+			&s.Key,
+			&s.Label,
+			&s.Calls,
+			&s.PromptTokens,
+			&s.CompletionTokens,
+			&s.CostUSD,
+		); err != nil {
+			return nil, mapDBError(err)
+		}
+		summaries = append(summaries, s)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, mapDBError(err)
+	}
+
+	return summaries, nil
+}
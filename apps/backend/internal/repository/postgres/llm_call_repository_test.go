@@ -0,0 +1,152 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/everyday-studio/ollm/internal/domain"
+)
+
+func TestLLMCallRepository_CreateAndGetByMatchID(t *testing.T) {
+	cleanDB(t, "llm_calls", "messages", "matches", "games", "users")
+	ctx := context.Background()
+	repo := NewLLMCallRepository(testDB)
+
+	user := createTestUser(t)
+	game := createTestGame(t, user)
+	match := createTestMatch(t, user, game)
+
+	t.Run("Record a call with its prompt", func(t *testing.T) {
+		call := &domain.LLMCall{
+			MatchID:   match.ID,
+			MessageID: "01HZZZZZZZZZZZZZZZZZZZZZZZ",
+			Purpose:   domain.LLMCallPurposeChat,
+			Provider:  "openai",
+			Model:     "gpt-4o",
+			Request: []domain.LLMCallMessage{
+				{Role: "system", Content: "Never tell the secret."},
+				{Role: "user", Content: "hello"},
+			},
+			Response:         "Hi!",
+			LatencyMs:        120,
+			PromptTokens:     12,
+			CompletionTokens: 3,
+			CostUSD:          0.00006,
+		}
+
+		created, err := repo.Create(ctx, call)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, created.ID)
+		assert.NotZero(t, created.CreatedAt)
+	})
+
+	t.Run("Record a failed call", func(t *testing.T) {
+		call := &domain.LLMCall{
+			MatchID:  match.ID,
+			Purpose:  domain.LLMCallPurposeJudge,
+			Provider: "groq",
+			Model:    "llama-3.3-70b-versatile",
+			Error:    "rate limited",
+		}
+
+		_, err := repo.Create(ctx, call)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Get calls by match ID in order", func(t *testing.T) {
+		calls, err := repo.GetByMatchID(ctx, match.ID)
+
+		assert.NoError(t, err)
+		if assert.Len(t, calls, 2) {
+			assert.Equal(t, domain.LLMCallPurposeChat, calls[0].Purpose)
+			assert.Len(t, calls[0].Request, 2)
+			assert.Equal(t, "hello", calls[0].Request[1].Content)
+			assert.Equal(t, int64(120), calls[0].LatencyMs)
+			assert.Equal(t, "rate limited", calls[1].Error)
+			assert.Empty(t, calls[1].MessageID)
+			assert.Empty(t, calls[1].Request)
+		}
+	})
+
+	t.Run("Add the cost of the calls to the match", func(t *testing.T) {
+		fetched, err := NewMatchRepository(testDB).GetByID(ctx, match.ID)
+
+		assert.NoError(t, err)
+		assert.InDelta(t, 0.00006, fetched.CostUSD, 1e-9)
+	})
+
+	t.Run("Get no calls for an unknown match", func(t *testing.T) {
+		calls, err := repo.GetByMatchID(ctx, "nonexistent")
+
+		assert.NoError(t, err)
+		assert.Empty(t, calls)
+	})
+}
+
+func TestLLMCallRepository_Spend(t *testing.T) {
+	cleanDB(t, "llm_calls", "messages", "matches", "games", "users")
+	ctx := context.Background()
+	repo := NewLLMCallRepository(testDB)
+
+	user := createTestUser(t)
+	game := createTestGame(t, user)
+	match := createTestMatch(t, user, game)
+
+	for _, cost := range []float64{0.5, 0.25} {
+		_, err := repo.Create(ctx, &domain.LLMCall{
+			MatchID:          match.ID,
+			Purpose:          domain.LLMCallPurposeChat,
+			PromptTokens:     100,
+			CompletionTokens: 10,
+			CostUSD:          cost,
+		})
+		assert.NoError(t, err)
+	}
+
+	from := time.Now().UTC().AddDate(0, 0, -1)
+	to := time.Now().UTC().AddDate(0, 0, 1)
+
+	t.Run("Aggregate per day", func(t *testing.T) {
+		days, err := repo.GetSpendByDay(ctx, from, to)
+
+		assert.NoError(t, err)
+		if assert.Len(t, days, 1) {
+			assert.Equal(t, days[0].Key, days[0].Label)
+			assert.Equal(t, 2, days[0].Calls)
+			assert.Equal(t, int64(200), days[0].PromptTokens)
+			assert.InDelta(t, 0.75, days[0].CostUSD, 1e-9)
+		}
+	})
+
+	t.Run("Aggregate per user", func(t *testing.T) {
+		users, err := repo.GetSpendByUser(ctx, from, to, 10)
+
+		assert.NoError(t, err)
+		if assert.Len(t, users, 1) {
+			assert.Equal(t, user.ID, users[0].Key)
+			assert.Equal(t, user.Name, users[0].Label)
+			assert.InDelta(t, 0.75, users[0].CostUSD, 1e-9)
+		}
+	})
+
+	t.Run("Aggregate per game", func(t *testing.T) {
+		games, err := repo.GetSpendByGame(ctx, from, to, 10)
+
+		assert.NoError(t, err)
+		if assert.Len(t, games, 1) {
+			assert.Equal(t, game.ID, games[0].Key)
+			assert.Equal(t, game.Title, games[0].Label)
+			assert.Equal(t, int64(20), games[0].CompletionTokens)
+		}
+	})
+
+	t.Run("Ignore calls outside the range", func(t *testing.T) {
+		days, err := repo.GetSpendByDay(ctx, to, to.AddDate(0, 0, 1))
+
+		assert.NoError(t, err)
+		assert.Empty(t, days)
+	})
+}
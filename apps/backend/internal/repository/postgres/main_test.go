@@ -68,7 +68,7 @@ func setupPostgresContainer(ctx context.Context) (testcontainers.Container, *sql
 		return nil, nil, err
 	}
 
-	dsn := fmt.Sprintf("host=%s port=%s user=testuser password=testpass dbname=testdb sslmode=disable", host, port.Port())
+	dsn := fmt.Sprintf("host=%s port=%s user=testuser password=testpass dbname=testdb sslmode=disable timezone=UTC", host, port.Port())
 	db, err := sql.Open("postgres", dsn)
 	if err != nil {
 		return nil, nil, err
@@ -129,6 +129,18 @@ func setupSchema() {
 			judge_type VARCHAR(50) DEFAULT 'target_word',
 			judge_condition TEXT DEFAULT '',
 			max_turns INTEGER DEFAULT 5,
+			chat_model VARCHAR(100) NOT NULL DEFAULT '',
+			judge_model VARCHAR(100) NOT NULL DEFAULT '',
+			judge_panel TEXT[] NOT NULL DEFAULT '{}',
+			judge_policy VARCHAR(20) NOT NULL DEFAULT '',
+			judge_quorum INTEGER NOT NULL DEFAULT 0,
+			judge_strictness VARCHAR(20) NOT NULL DEFAULT '',
+			judge_scope VARCHAR(20) NOT NULL DEFAULT '',
+			judge_scope_turns INTEGER NOT NULL DEFAULT 0,
+			temperature DOUBLE PRECISION,
+			max_tokens INTEGER NOT NULL DEFAULT 0,
+			secrets JSONB NOT NULL DEFAULT '{}',
+			idle_ttl_minutes INTEGER NOT NULL DEFAULT 0 CHECK (idle_ttl_minutes >= 0),
 			play_count INTEGER NOT NULL DEFAULT 0,
 			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
 			updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
@@ -159,10 +171,20 @@ func setupSchema() {
 			max_turns INTEGER NOT NULL DEFAULT 5,
 			total_tokens INTEGER NOT NULL DEFAULT 0,
 			turn_count INTEGER NOT NULL DEFAULT 0,
+			cost_usd NUMERIC(14, 8) NOT NULL DEFAULT 0,
+			judge_progress JSONB,
+			secrets JSONB NOT NULL DEFAULT '{}',
+			version INTEGER NOT NULL DEFAULT 0,
 			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
 			updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
 		);
 
+		DROP TRIGGER IF EXISTS update_matches_updated_at ON matches;
+		CREATE TRIGGER update_matches_updated_at
+			BEFORE UPDATE ON matches
+			FOR EACH ROW
+			EXECUTE FUNCTION update_updated_at_column();
+
 		DROP TRIGGER IF EXISTS increment_play_count_on_match_insert ON matches;
 		CREATE TRIGGER increment_play_count_on_match_insert
 			AFTER INSERT ON matches
@@ -179,8 +201,112 @@ func setupSchema() {
 			turn_count INTEGER NOT NULL DEFAULT 0,
 			token_count INTEGER NOT NULL DEFAULT 0,
 			prompt_advice TEXT,
+			provider VARCHAR(50) NOT NULL DEFAULT '',
+			model VARCHAR(100) NOT NULL DEFAULT '',
+			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+		);
+
+		-- LLM call audit table
+		CREATE TABLE IF NOT EXISTS llm_calls (
+			id VARCHAR(26) PRIMARY KEY,
+			match_id VARCHAR(26) REFERENCES matches(id) ON DELETE CASCADE,
+			message_id VARCHAR(26),
+			purpose VARCHAR(20) NOT NULL CHECK (purpose IN ('chat', 'judge', 'advice')),
+			provider VARCHAR(50) NOT NULL DEFAULT '',
+			model VARCHAR(100) NOT NULL DEFAULT '',
+			request JSONB NOT NULL DEFAULT '[]',
+			response TEXT NOT NULL DEFAULT '',
+			latency_ms INTEGER NOT NULL DEFAULT 0,
+			prompt_tokens INTEGER NOT NULL DEFAULT 0,
+			completion_tokens INTEGER NOT NULL DEFAULT 0,
+			cost_usd NUMERIC(14, 8) NOT NULL DEFAULT 0,
+			error TEXT NOT NULL DEFAULT '',
+			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+		);
+
+		CREATE TABLE IF NOT EXISTS turn_verdicts (
+			id VARCHAR(26) PRIMARY KEY,
+			match_id VARCHAR(26) NOT NULL REFERENCES matches(id) ON DELETE CASCADE,
+			message_id VARCHAR(26) NOT NULL REFERENCES messages(id) ON DELETE CASCADE,
+			turn_count INTEGER NOT NULL,
+			judge_type VARCHAR(50) NOT NULL,
+			outcome VARCHAR(20) NOT NULL CHECK (outcome IN ('continue', 'won', 'lost')),
+			reason TEXT NOT NULL DEFAULT '',
+			output TEXT NOT NULL DEFAULT '',
+			votes JSONB NOT NULL DEFAULT '[]',
+			target_match JSONB,
+			leaves JSONB NOT NULL DEFAULT '[]',
+			progress JSONB,
+			error TEXT NOT NULL DEFAULT '',
+			prompt_tokens INTEGER NOT NULL DEFAULT 0,
+			completion_tokens INTEGER NOT NULL DEFAULT 0,
+			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+		);
+
+		CREATE TABLE IF NOT EXISTS calibration_examples (
+			id VARCHAR(26) PRIMARY KEY,
+			game_id VARCHAR(26) NOT NULL REFERENCES games(id) ON DELETE CASCADE,
+			output TEXT NOT NULL,
+			expected VARCHAR(20) NOT NULL CHECK (expected IN ('continue', 'won')),
+			note TEXT NOT NULL DEFAULT '',
+			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+		);
+
+		CREATE TABLE IF NOT EXISTS calibration_runs (
+			id VARCHAR(26) PRIMARY KEY,
+			game_id VARCHAR(26) NOT NULL REFERENCES games(id) ON DELETE CASCADE,
+			judge JSONB NOT NULL,
+			total INTEGER NOT NULL DEFAULT 0,
+			correct INTEGER NOT NULL DEFAULT 0,
+			false_positives INTEGER NOT NULL DEFAULT 0,
+			false_negatives INTEGER NOT NULL DEFAULT 0,
+			errors INTEGER NOT NULL DEFAULT 0,
+			results JSONB NOT NULL DEFAULT '[]',
+			prompt_tokens INTEGER NOT NULL DEFAULT 0,
+			completion_tokens INTEGER NOT NULL DEFAULT 0,
+			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+		);
+
+		CREATE TABLE IF NOT EXISTS appeals (
+			id VARCHAR(26) PRIMARY KEY,
+			match_id VARCHAR(26) NOT NULL REFERENCES matches(id) ON DELETE CASCADE,
+			user_id VARCHAR(26) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+			verdict_id VARCHAR(26) NOT NULL REFERENCES turn_verdicts(id) ON DELETE CASCADE,
+			turn_count INTEGER NOT NULL,
+			comment TEXT NOT NULL,
+			status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'upheld', 'overturned')),
+			resolution TEXT NOT NULL DEFAULT '',
+			resolved_by VARCHAR(26) REFERENCES users(id) ON DELETE SET NULL,
+			resolved_at TIMESTAMP,
+			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+		);
+		CREATE UNIQUE INDEX IF NOT EXISTS idx_appeals_pending_match_id ON appeals(match_id) WHERE status = 'pending';
+
+		CREATE TABLE IF NOT EXISTS match_overrides (
+			id VARCHAR(26) PRIMARY KEY,
+			match_id VARCHAR(26) NOT NULL REFERENCES matches(id) ON DELETE CASCADE,
+			appeal_id VARCHAR(26) REFERENCES appeals(id) ON DELETE SET NULL,
+			admin_id VARCHAR(26) REFERENCES users(id) ON DELETE SET NULL,
+			action VARCHAR(20) NOT NULL CHECK (action IN ('rejudge', 'set_status', 'uphold')),
+			from_status VARCHAR(20) NOT NULL,
+			to_status VARCHAR(20) NOT NULL,
+			reason TEXT NOT NULL DEFAULT '',
+			verdict JSONB,
 			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
 		);
+
+		CREATE TABLE IF NOT EXISTS idempotency_keys (
+			user_id VARCHAR(26) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+			idempotency_key VARCHAR(255) NOT NULL,
+			token VARCHAR(26) NOT NULL DEFAULT '',
+			match_id VARCHAR(26) NOT NULL REFERENCES matches(id) ON DELETE CASCADE,
+			fingerprint VARCHAR(64) NOT NULL,
+			result JSONB,
+			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			PRIMARY KEY (user_id, idempotency_key)
+		);
 	`
 	if _, err := testDB.Exec(schema); err != nil {
 		log.Fatalf("Failed to create schema: %v", err)
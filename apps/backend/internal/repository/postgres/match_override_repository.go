@@ -0,0 +1,93 @@
+package postgres
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+
+	"github.com/everyday-studio/ollm/internal/domain"
+)
+
+type matchOverrideRepository struct {
+	db *sql.DB
+}
+
+// NewMatchOverrideRepository creates a new repository for the audit log of match overrides
+func NewMatchOverrideRepository(db *sql.DB) domain.MatchOverrideRepository {
+	return &matchOverrideRepository{
+		db: db,
+	}
+}
+
+// Create inserts a new match override record into the database
+func (r *matchOverrideRepository) Create(ctx context.Context, override *domain.MatchOverride) (*domain.MatchOverride, error) {
+	override.ID = ulid.MustNew(ulid.Timestamp(time.Now()), ulid.Monotonic(rand.Reader, 0)).String()
+
+	const query = `
+        INSERT INTO match_overrides (id, match_id, appeal_id, admin_id, action, from_status, to_status, reason, verdict)
+        VALUES ($1, $2, NULLIF($3, ''), NULLIF($4, ''), $5, $6, $7, $8, $9)
+        RETURNING created_at
+    `
+
+	err := conn(ctx, r.db).QueryRowContext(ctx, query,
+		override.ID,
+		override.MatchID,
+		override.AppealID,
+		override.AdminID,
+		override.Action,
+		override.FromStatus,
+		override.ToStatus,
+		override.Reason,
+		nullableJSON(&override.Verdict),
+	).Scan(&override.CreatedAt)
+	if err != nil {
+		return nil, mapDBError(err)
+	}
+
+	return override, nil
+}
+
+// GetByMatchID retrieves the overrides of a match, oldest first
+func (r *matchOverrideRepository) GetByMatchID(ctx context.Context, matchID string) ([]domain.MatchOverride, error) {
+	const query = `
+        SELECT id, match_id, COALESCE(appeal_id, ''), COALESCE(admin_id, ''), action, from_status, to_status, reason, verdict, created_at
+        FROM match_overrides
+        WHERE match_id = $1
+        ORDER BY created_at ASC, id ASC
+    `
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, matchID)
+	if err != nil {
+		return nil, mapDBError(err)
+	}
+	defer rows.Close()
+
+	overrides := []domain.MatchOverride{}
+	for rows.Next() {
+		var o domain.MatchOverride
+		if err := rows.Scan(
+			&o.ID,
+			&o.MatchID,
+			&o.AppealID,
+			&o.AdminID,
+			&o.Action,
+			&o.FromStatus,
+			&o.ToStatus,
+			&o.Reason,
+			nullableJSON(&o.Verdict),
+			&o.CreatedAt,
+		); err != nil {
+			return nil, mapDBError(err)
+		}
+		overrides = append(overrides, o)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, mapDBError(err)
+	}
+
+	return overrides, nil
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"crypto/rand"
 	"database/sql"
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/oklog/ulid/v2"
@@ -28,12 +30,12 @@ func (r *matchRepository) Create(ctx context.Context, match *domain.Match) (*dom
 	match.ID = ulid.MustNew(ulid.Timestamp(time.Now()), ulid.Monotonic(rand.Reader, 0)).String()
 
 	const query = `
-		INSERT INTO matches (id, user_id, game_id, status, max_turns, total_tokens, turn_count)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
-		RETURNING created_at, updated_at
+		INSERT INTO matches (id, user_id, game_id, status, max_turns, total_tokens, turn_count, secrets)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING version, created_at, updated_at
 	`
 
-	err := r.db.QueryRowContext(
+	err := conn(ctx, r.db).QueryRowContext(
 		ctx,
 		query,
 		match.ID,
@@ -43,7 +45,8 @@ func (r *matchRepository) Create(ctx context.Context, match *domain.Match) (*dom
 		match.MaxTurns,
 		match.TotalTokens,
 		match.TurnCount,
-	).Scan(&match.CreatedAt, &match.UpdatedAt)
+		jsonMap(&match.Secrets),
+	).Scan(&match.Version, &match.CreatedAt, &match.UpdatedAt)
 
 	if err != nil {
 		return nil, mapDBError(err)
@@ -55,13 +58,13 @@ func (r *matchRepository) Create(ctx context.Context, match *domain.Match) (*dom
 // GetByID retrieves a match by its ID
 func (r *matchRepository) GetByID(ctx context.Context, id string) (*domain.Match, error) {
 	const query = `
-		SELECT id, user_id, game_id, status, max_turns, total_tokens, turn_count, created_at, updated_at
+		SELECT id, user_id, game_id, status, max_turns, total_tokens, turn_count, cost_usd, judge_progress, secrets, version, created_at, updated_at
 		FROM matches
 		WHERE id = $1
 	`
 
 	var match domain.Match
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, id).Scan(
 		&match.ID,
 		&match.UserID,
 		&match.GameID,
@@ -69,6 +72,10 @@ func (r *matchRepository) GetByID(ctx context.Context, id string) (*domain.Match
 		&match.MaxTurns,
 		&match.TotalTokens,
 		&match.TurnCount,
+		&match.CostUSD,
+		nullableJSON(&match.JudgeProgress),
+		jsonMap(&match.Secrets),
+		&match.Version,
 		&match.CreatedAt,
 		&match.UpdatedAt,
 	)
@@ -83,13 +90,13 @@ func (r *matchRepository) GetByID(ctx context.Context, id string) (*domain.Match
 // GetByUserID retrieves all matches for a specific user, ordered by creation date (newest first)
 func (r *matchRepository) GetByUserID(ctx context.Context, userID string) ([]domain.Match, error) {
 	const query = `
-		SELECT id, user_id, game_id, status, max_turns, total_tokens, turn_count, created_at, updated_at
+		SELECT id, user_id, game_id, status, max_turns, total_tokens, turn_count, cost_usd, judge_progress, secrets, version, created_at, updated_at
 		FROM matches
 		WHERE user_id = $1
 		ORDER BY created_at DESC
 	`
 
-	rows, err := r.db.QueryContext(ctx, query, userID)
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, userID)
 	if err != nil {
 		return nil, mapDBError(err)
 	}
@@ -106,6 +113,10 @@ func (r *matchRepository) GetByUserID(ctx context.Context, userID string) ([]dom
 			&match.MaxTurns,
 			&match.TotalTokens,
 			&match.TurnCount,
+			&match.CostUSD,
+			nullableJSON(&match.JudgeProgress),
+			jsonMap(&match.Secrets),
+			&match.Version,
 			&match.CreatedAt,
 			&match.UpdatedAt,
 		); err != nil {
@@ -124,13 +135,13 @@ func (r *matchRepository) GetByUserID(ctx context.Context, userID string) ([]dom
 // GetByUserIDAndGameID retrieves all matches for a specific user and game, ordered by creation date (newest first)
 func (r *matchRepository) GetByUserIDAndGameID(ctx context.Context, userID string, gameID string) ([]domain.Match, error) {
 	const query = `
-		SELECT id, user_id, game_id, status, max_turns, total_tokens, turn_count, created_at, updated_at
+		SELECT id, user_id, game_id, status, max_turns, total_tokens, turn_count, cost_usd, judge_progress, secrets, version, created_at, updated_at
 		FROM matches
 		WHERE user_id = $1 AND game_id = $2
 		ORDER BY created_at DESC
 	`
 
-	rows, err := r.db.QueryContext(ctx, query, userID, gameID)
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, userID, gameID)
 	if err != nil {
 		return nil, mapDBError(err)
 	}
@@ -147,6 +158,10 @@ func (r *matchRepository) GetByUserIDAndGameID(ctx context.Context, userID strin
 			&match.MaxTurns,
 			&match.TotalTokens,
 			&match.TurnCount,
+			&match.CostUSD,
+			nullableJSON(&match.JudgeProgress),
+			jsonMap(&match.Secrets),
+			&match.Version,
 			&match.CreatedAt,
 			&match.UpdatedAt,
 		); err != nil {
@@ -171,7 +186,7 @@ func (r *matchRepository) CountByUserIDGameIDAndStatus(ctx context.Context, user
 	`
 
 	var count int
-	err := r.db.QueryRowContext(ctx, query, userID, gameID, status).Scan(&count)
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, userID, gameID, status).Scan(&count)
 	if err != nil {
 		return 0, mapDBError(err)
 	}
@@ -179,25 +194,32 @@ func (r *matchRepository) CountByUserIDGameIDAndStatus(ctx context.Context, user
 	return count, nil
 }
 
-// Update updates an existing match
-func (r *matchRepository) Update(ctx context.Context, match *domain.Match) (*domain.Match, error) {
+// CompareAndSet saves the state of the match only if the stored row is still in status expected
+// at match.Version, and bumps the version. A row changed by another writer in the meantime fails with ErrConflict.
+func (r *matchRepository) CompareAndSet(ctx context.Context, match *domain.Match, expected domain.MatchStatus) (*domain.Match, error) {
 	const query = `
 		UPDATE matches
-		SET status = $1, max_turns = $2, total_tokens = $3, turn_count = $4
-		WHERE id = $5
-		RETURNING updated_at
+		SET status = $1, max_turns = $2, total_tokens = $3, turn_count = $4, judge_progress = $5, version = version + 1
+		WHERE id = $6 AND status = $7 AND version = $8
+		RETURNING version, updated_at
 	`
 
-	err := r.db.QueryRowContext(
+	err := conn(ctx, r.db).QueryRowContext(
 		ctx,
 		query,
 		match.Status,
 		match.MaxTurns,
 		match.TotalTokens,
 		match.TurnCount,
+		nullableJSON(&match.JudgeProgress),
 		match.ID,
-	).Scan(&match.UpdatedAt)
+		expected,
+		match.Version,
+	).Scan(&match.Version, &match.UpdatedAt)
 
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, r.compareAndSetFailure(ctx, match.ID, expected)
+	}
 	if err != nil {
 		return nil, mapDBError(err)
 	}
@@ -205,6 +227,21 @@ func (r *matchRepository) Update(ctx context.Context, match *domain.Match) (*dom
 	return match, nil
 }
 
+// compareAndSetFailure tells a missing match apart from one changed by another writer
+func (r *matchRepository) compareAndSetFailure(ctx context.Context, id string, expected domain.MatchStatus) error {
+	const query = `SELECT status FROM matches WHERE id = $1`
+
+	var status domain.MatchStatus
+	if err := conn(ctx, r.db).QueryRowContext(ctx, query, id).Scan(&status); err != nil {
+		return mapDBError(err)
+	}
+
+	if status != expected {
+		return fmt.Errorf("%w: match is %s, not %s", domain.ErrConflict, status, expected)
+	}
+	return fmt.Errorf("%w: match was changed concurrently", domain.ErrConflict)
+}
+
 // Delete removes a match from the database
 func (r *matchRepository) Delete(ctx context.Context, id string) error {
 	const query = `
@@ -212,7 +249,7 @@ func (r *matchRepository) Delete(ctx context.Context, id string) error {
 		WHERE id = $1
 	`
 
-	result, err := r.db.ExecContext(ctx, query, id)
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, id)
 	if err != nil {
 		return mapDBError(err)
 	}
@@ -229,6 +266,94 @@ func (r *matchRepository) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
+// ExpireIdle moves active matches left untouched past their game's idle TTL to expired.
+// Matches are locked with SKIP LOCKED so several sweepers can run side by side.
+func (r *matchRepository) ExpireIdle(ctx context.Context, defaultTTL time.Duration, limit int) ([]domain.Match, error) {
+	const query = `
+		UPDATE matches m
+		SET status = 'expired', version = m.version + 1
+		WHERE m.status = 'active' AND m.id IN (
+			SELECT im.id
+			FROM matches im
+			JOIN games g ON g.id = im.game_id
+			WHERE im.status = 'active'
+				AND im.updated_at < CURRENT_TIMESTAMP - CASE
+					WHEN g.idle_ttl_minutes > 0 THEN make_interval(mins => g.idle_ttl_minutes)
+					ELSE make_interval(secs => $1)
+				END
+			ORDER BY im.updated_at ASC
+			LIMIT $2
+			FOR UPDATE OF im SKIP LOCKED
+		)
+		RETURNING m.id, m.user_id, m.game_id, m.status, m.max_turns, m.total_tokens, m.turn_count, m.cost_usd, m.judge_progress, m.secrets, m.version, m.created_at, m.updated_at
+	`
+
+	return r.queryMatches(ctx, query, defaultTTL.Seconds(), limit)
+}
+
+// RecoverStuck moves matches generating for longer than timeout out of generating.
+// A turn whose user message was saved becomes retryable (error, counting the turn); otherwise the match is active again.
+func (r *matchRepository) RecoverStuck(ctx context.Context, timeout time.Duration, limit int) ([]domain.Match, error) {
+	const query = `
+		UPDATE matches m
+		SET status = CASE WHEN stuck.last_user_turn > m.turn_count THEN 'error' ELSE 'active' END,
+			turn_count = GREATEST(m.turn_count, stuck.last_user_turn),
+			version = m.version + 1
+		FROM (
+			SELECT sm.id, COALESCE((
+				SELECT MAX(msg.turn_count) FROM messages msg WHERE msg.match_id = sm.id AND msg.role = 'user'
+			), 0) AS last_user_turn
+			FROM matches sm
+			WHERE sm.status = 'generating' AND sm.updated_at < CURRENT_TIMESTAMP - make_interval(secs => $1)
+			ORDER BY sm.updated_at ASC
+			LIMIT $2
+			FOR UPDATE SKIP LOCKED
+		) stuck
+		WHERE m.id = stuck.id AND m.status = 'generating'
+		RETURNING m.id, m.user_id, m.game_id, m.status, m.max_turns, m.total_tokens, m.turn_count, m.cost_usd, m.judge_progress, m.secrets, m.version, m.created_at, m.updated_at
+	`
+
+	return r.queryMatches(ctx, query, timeout.Seconds(), limit)
+}
+
+// queryMatches runs a query returning whole match rows
+func (r *matchRepository) queryMatches(ctx context.Context, query string, args ...any) ([]domain.Match, error) {
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, mapDBError(err)
+	}
+	defer rows.Close()
+
+	matches := []domain.Match{}
+	for rows.Next() {
+		var match domain.Match
+		if err := rows.Scan(
+			&match.ID,
+			&match.UserID,
+			&match.GameID,
+			&match.Status,
+			&match.MaxTurns,
+			&match.TotalTokens,
+			&match.TurnCount,
+			&match.CostUSD,
+			nullableJSON(&match.JudgeProgress),
+			jsonMap(&match.Secrets),
+			&match.Version,
+			&match.CreatedAt,
+			&match.UpdatedAt,
+		); err != nil {
+			return nil, mapDBError(err)
+		}
+		matches = append(matches, match)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, mapDBError(err)
+	}
+
+	return matches, nil
+}
+
 // GetLeaderboard retrieves the top scores for a specific game
 func (r *matchRepository) GetLeaderboard(ctx context.Context, gameID string, limit int) ([]domain.LeaderboardEntry, error) {
 	const query = `
@@ -258,7 +383,7 @@ func (r *matchRepository) GetLeaderboard(ctx context.Context, gameID string, lim
 		LIMIT $2
 	`
 
-	rows, err := r.db.QueryContext(ctx, query, gameID, limit)
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, gameID, limit)
 	if err != nil {
 		return nil, mapDBError(err)
 	}
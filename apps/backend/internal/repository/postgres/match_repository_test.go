@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"context"
+	"sync"
 	"testing"
 	"time"
 
@@ -56,6 +57,22 @@ func TestMatchRepository_Create(t *testing.T) {
 		assert.NotZero(t, createdMatch.UpdatedAt)
 	})
 
+	t.Run("Keep the secrets drawn for the match", func(t *testing.T) {
+		match := &domain.Match{
+			UserID:  user.ID,
+			GameID:  game.ID,
+			Status:  domain.MatchStatusActive,
+			Secrets: map[string]string{"password": "banana", "pin": "4821"},
+		}
+
+		createdMatch, err := repo.Create(ctx, match)
+		assert.NoError(t, err)
+
+		got, err := repo.GetByID(ctx, createdMatch.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{"password": "banana", "pin": "4821"}, got.Secrets)
+	})
+
 	t.Run("Fail to create match with non-existent user_id", func(t *testing.T) {
 		match := &domain.Match{
 			UserID: "01HQZYX3VQJQZ3Z0Z1Z2NONEXIST",
@@ -177,7 +194,7 @@ func TestMatchRepository_GetByUserIDAndGameID(t *testing.T) {
 	})
 }
 
-func TestMatchRepository_Update(t *testing.T) {
+func TestMatchRepository_CompareAndSet(t *testing.T) {
 	cleanDB(t, "matches", "games", "users")
 	ctx := context.Background()
 	repo := NewMatchRepository(testDB)
@@ -192,18 +209,72 @@ func TestMatchRepository_Update(t *testing.T) {
 			TurnCount: 1,
 		}
 		createdMatch, _ := repo.Create(ctx, match)
+		assert.Equal(t, 0, createdMatch.Version)
 
 		// Modify fields
 		createdMatch.Status = domain.MatchStatusWon
 		createdMatch.TurnCount = 5
 		createdMatch.TotalTokens = 150
 
-		updatedMatch, err := repo.Update(ctx, createdMatch)
+		updatedMatch, err := repo.CompareAndSet(ctx, createdMatch, domain.MatchStatusActive)
 
 		assert.NoError(t, err)
 		assert.Equal(t, domain.MatchStatusWon, updatedMatch.Status)
 		assert.Equal(t, 5, updatedMatch.TurnCount)
 		assert.Equal(t, 150, updatedMatch.TotalTokens)
+		assert.Equal(t, 1, updatedMatch.Version)
+
+		fetchedMatch, err := repo.GetByID(ctx, createdMatch.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.MatchStatusWon, fetchedMatch.Status)
+		assert.Equal(t, 1, fetchedMatch.Version)
+	})
+
+	t.Run("Store the judge progress", func(t *testing.T) {
+		createdMatch, _ := repo.Create(ctx, &domain.Match{UserID: user.ID, GameID: game.ID, Status: domain.MatchStatusActive})
+
+		fetchedMatch, err := repo.GetByID(ctx, createdMatch.ID)
+		assert.NoError(t, err)
+		assert.Nil(t, fetchedMatch.JudgeProgress)
+
+		createdMatch.JudgeProgress = &domain.JudgeProgress{Achieved: []string{"alpha"}, Total: 3}
+		_, err = repo.CompareAndSet(ctx, createdMatch, domain.MatchStatusActive)
+		assert.NoError(t, err)
+
+		fetchedMatch, err = repo.GetByID(ctx, createdMatch.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, &domain.JudgeProgress{Achieved: []string{"alpha"}, Total: 3}, fetchedMatch.JudgeProgress)
+	})
+
+	t.Run("Fail when the match is in another status", func(t *testing.T) {
+		createdMatch, _ := repo.Create(ctx, &domain.Match{UserID: user.ID, GameID: game.ID, Status: domain.MatchStatusActive})
+
+		createdMatch.Status = domain.MatchStatusWon
+		updatedMatch, err := repo.CompareAndSet(ctx, createdMatch, domain.MatchStatusGenerating)
+
+		assert.ErrorIs(t, err, domain.ErrConflict)
+		assert.Nil(t, updatedMatch)
+	})
+
+	t.Run("Fail with a stale version", func(t *testing.T) {
+		createdMatch, _ := repo.Create(ctx, &domain.Match{UserID: user.ID, GameID: game.ID, Status: domain.MatchStatusActive})
+		stale := *createdMatch
+
+		createdMatch.TurnCount = 1
+		_, err := repo.CompareAndSet(ctx, createdMatch, domain.MatchStatusActive)
+		assert.NoError(t, err)
+
+		// The status still matches, but the row moved on since the stale copy was read.
+		stale.Status = domain.MatchStatusResigned
+		updatedMatch, err := repo.CompareAndSet(ctx, &stale, domain.MatchStatusActive)
+
+		assert.ErrorIs(t, err, domain.ErrConflict)
+		assert.Nil(t, updatedMatch)
+
+		fetchedMatch, err := repo.GetByID(ctx, createdMatch.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.MatchStatusActive, fetchedMatch.Status)
+		assert.Equal(t, 1, fetchedMatch.TurnCount)
 	})
 
 	t.Run("Fail to update non-existent match", func(t *testing.T) {
@@ -212,13 +283,158 @@ func TestMatchRepository_Update(t *testing.T) {
 			Status: domain.MatchStatusResigned,
 		}
 
-		updatedMatch, err := repo.Update(ctx, match)
+		updatedMatch, err := repo.CompareAndSet(ctx, match, domain.MatchStatusActive)
 
 		assert.ErrorIs(t, err, domain.ErrNotFound)
 		assert.Nil(t, updatedMatch)
 	})
 }
 
+// raceCompareAndSet runs every transition on its own copy of match at the same time
+// and returns the error of each, in order
+func raceCompareAndSet(t *testing.T, repo domain.MatchRepository, match *domain.Match, transitions []func(m *domain.Match) domain.MatchStatus) []error {
+	t.Helper()
+
+	errs := make([]error, len(transitions))
+	start := make(chan struct{})
+	var wg sync.WaitGroup
+	for i, transition := range transitions {
+		m := *match
+		expected := transition(&m)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			_, errs[i] = repo.CompareAndSet(context.Background(), &m, expected)
+		}()
+	}
+	close(start)
+	wg.Wait()
+
+	return errs
+}
+
+func TestMatchRepository_CompareAndSet_Concurrency(t *testing.T) {
+	cleanDB(t, "matches", "games", "users")
+	ctx := context.Background()
+	repo := NewMatchRepository(testDB)
+	user := createTestUser(t)
+	game := createTestGame(t, user)
+
+	lockTurn := func(m *domain.Match) domain.MatchStatus {
+		m.Status = domain.MatchStatusGenerating
+		return domain.MatchStatusActive
+	}
+
+	t.Run("Let only one of concurrent turns lock the match", func(t *testing.T) {
+		match, _ := repo.Create(ctx, &domain.Match{UserID: user.ID, GameID: game.ID, Status: domain.MatchStatusActive})
+
+		const callers = 8
+		transitions := make([]func(m *domain.Match) domain.MatchStatus, callers)
+		for i := range transitions {
+			transitions[i] = lockTurn
+		}
+		errs := raceCompareAndSet(t, repo, match, transitions)
+
+		succeeded := 0
+		for _, err := range errs {
+			if err == nil {
+				succeeded++
+			} else {
+				assert.ErrorIs(t, err, domain.ErrConflict)
+			}
+		}
+		assert.Equal(t, 1, succeeded)
+
+		got, err := repo.GetByID(ctx, match.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.MatchStatusGenerating, got.Status)
+		assert.Equal(t, 1, got.Version)
+	})
+
+	t.Run("Let either a resign or a turn take the match, never both", func(t *testing.T) {
+		match, _ := repo.Create(ctx, &domain.Match{UserID: user.ID, GameID: game.ID, Status: domain.MatchStatusActive})
+
+		resign := func(m *domain.Match) domain.MatchStatus {
+			m.Status = domain.MatchStatusResigned
+			return domain.MatchStatusActive
+		}
+		errs := raceCompareAndSet(t, repo, match, []func(m *domain.Match) domain.MatchStatus{resign, lockTurn})
+
+		got, err := repo.GetByID(ctx, match.ID)
+		assert.NoError(t, err)
+		switch {
+		case errs[0] == nil:
+			assert.ErrorIs(t, errs[1], domain.ErrConflict)
+			assert.Equal(t, domain.MatchStatusResigned, got.Status)
+		case errs[1] == nil:
+			assert.ErrorIs(t, errs[0], domain.ErrConflict)
+			assert.Equal(t, domain.MatchStatusGenerating, got.Status)
+		default:
+			t.Fatalf("neither transition succeeded: %v, %v", errs[0], errs[1])
+		}
+		assert.Equal(t, 1, got.Version)
+	})
+
+	t.Run("Refuse a turn that finishes after the sweeper recovered its match", func(t *testing.T) {
+		cleanDB(t, "matches")
+		match, _ := repo.Create(ctx, &domain.Match{UserID: user.ID, GameID: game.ID, Status: domain.MatchStatusActive})
+		match.Status = domain.MatchStatusGenerating
+		locked, err := repo.CompareAndSet(ctx, match, domain.MatchStatusActive)
+		assert.NoError(t, err)
+		turn := *locked
+
+		recovered, err := repo.RecoverStuck(ctx, 0, 100)
+		assert.NoError(t, err)
+		assert.Len(t, recovered, 1)
+		assert.Equal(t, domain.MatchStatusActive, recovered[0].Status)
+		assert.Equal(t, 2, recovered[0].Version)
+
+		// The turn still holds version 1, so its final update cannot overwrite the recovered match.
+		turn.Status = domain.MatchStatusActive
+		turn.TurnCount = 1
+		_, err = repo.CompareAndSet(ctx, &turn, domain.MatchStatusGenerating)
+		assert.ErrorIs(t, err, domain.ErrConflict)
+
+		got, err := repo.GetByID(ctx, match.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, got.TurnCount)
+	})
+
+	t.Run("Refuse a resign read before the sweeper expired the match", func(t *testing.T) {
+		cleanDB(t, "matches")
+		match, _ := repo.Create(ctx, &domain.Match{UserID: user.ID, GameID: game.ID, Status: domain.MatchStatusActive})
+
+		expired, err := repo.ExpireIdle(ctx, 0, 100)
+		assert.NoError(t, err)
+		assert.Len(t, expired, 1)
+		assert.Equal(t, 1, expired[0].Version)
+
+		match.Status = domain.MatchStatusResigned
+		_, err = repo.CompareAndSet(ctx, match, domain.MatchStatusActive)
+		assert.ErrorIs(t, err, domain.ErrConflict)
+
+		got, err := repo.GetByID(ctx, match.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.MatchStatusExpired, got.Status)
+	})
+
+	t.Run("Keep the version when a call is charged to the match", func(t *testing.T) {
+		match, _ := repo.Create(ctx, &domain.Match{UserID: user.ID, GameID: game.ID, Status: domain.MatchStatusActive})
+
+		_, err := NewLLMCallRepository(testDB).Create(ctx, &domain.LLMCall{
+			MatchID: match.ID,
+			Purpose: domain.LLMCallPurposeChat,
+			CostUSD: 0.5,
+		})
+		assert.NoError(t, err)
+
+		match.Status = domain.MatchStatusGenerating
+		_, err = repo.CompareAndSet(ctx, match, domain.MatchStatusActive)
+		assert.NoError(t, err)
+	})
+}
+
 func TestMatchRepository_Delete(t *testing.T) {
 	cleanDB(t, "matches", "games", "users")
 	ctx := context.Background()
@@ -348,3 +564,103 @@ func TestMatchRepository_CountByUserIDGameIDAndStatus(t *testing.T) {
 		assert.Equal(t, 0, count)
 	})
 }
+
+func TestMatchRepository_ExpireIdle(t *testing.T) {
+	cleanDB(t, "matches", "games", "users")
+	ctx := context.Background()
+	repo := NewMatchRepository(testDB)
+	gameRepo := NewGameRepository(testDB)
+
+	user := createTestUser(t)
+	game := createTestGame(t, user)
+	patientGame := createTestGame(t, user)
+	patientGame.IdleTTLMinutes = 60
+	_, err := gameRepo.Update(ctx, patientGame)
+	assert.NoError(t, err)
+
+	idle, _ := repo.Create(ctx, &domain.Match{UserID: user.ID, GameID: game.ID, Status: domain.MatchStatusActive})
+	waiting, _ := repo.Create(ctx, &domain.Match{UserID: user.ID, GameID: patientGame.ID, Status: domain.MatchStatusActive})
+	won, _ := repo.Create(ctx, &domain.Match{UserID: user.ID, GameID: game.ID, Status: domain.MatchStatusWon})
+
+	t.Run("Expire matches idle past the TTL of their game", func(t *testing.T) {
+		// A zero default TTL makes every match of a game without its own TTL idle.
+		expired, err := repo.ExpireIdle(ctx, 0, 100)
+		assert.NoError(t, err)
+		assert.Len(t, expired, 1)
+		assert.Equal(t, idle.ID, expired[0].ID)
+		assert.Equal(t, domain.MatchStatusExpired, expired[0].Status)
+
+		got, err := repo.GetByID(ctx, waiting.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.MatchStatusActive, got.Status)
+
+		got, err = repo.GetByID(ctx, won.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.MatchStatusWon, got.Status)
+	})
+
+	t.Run("Expire at most limit matches per call", func(t *testing.T) {
+		repo.Create(ctx, &domain.Match{UserID: user.ID, GameID: game.ID, Status: domain.MatchStatusActive})
+		repo.Create(ctx, &domain.Match{UserID: user.ID, GameID: game.ID, Status: domain.MatchStatusActive})
+
+		expired, err := repo.ExpireIdle(ctx, 0, 1)
+		assert.NoError(t, err)
+		assert.Len(t, expired, 1)
+
+		expired, err = repo.ExpireIdle(ctx, 0, 100)
+		assert.NoError(t, err)
+		assert.Len(t, expired, 1)
+	})
+
+	t.Run("Keep matches touched within the default TTL", func(t *testing.T) {
+		repo.Create(ctx, &domain.Match{UserID: user.ID, GameID: game.ID, Status: domain.MatchStatusActive})
+
+		expired, err := repo.ExpireIdle(ctx, time.Hour, 100)
+		assert.NoError(t, err)
+		assert.Empty(t, expired)
+	})
+}
+
+func TestMatchRepository_RecoverStuck(t *testing.T) {
+	cleanDB(t, "messages", "matches", "games", "users")
+	ctx := context.Background()
+	repo := NewMatchRepository(testDB)
+	messageRepo := NewMessageRepository(testDB)
+
+	user := createTestUser(t)
+	game := createTestGame(t, user)
+
+	// The user message of turn 3 was saved but no reply followed.
+	crashed, _ := repo.Create(ctx, &domain.Match{UserID: user.ID, GameID: game.ID, Status: domain.MatchStatusGenerating, TurnCount: 2})
+	_, err := messageRepo.Create(ctx, &domain.Message{MatchID: crashed.ID, Role: domain.MessageRoleUser, Content: "hi", IsVisible: true, TurnCount: 3})
+	assert.NoError(t, err)
+	// The turn failed before its user message was saved.
+	unsaved, _ := repo.Create(ctx, &domain.Match{UserID: user.ID, GameID: game.ID, Status: domain.MatchStatusGenerating, TurnCount: 2})
+	active, _ := repo.Create(ctx, &domain.Match{UserID: user.ID, GameID: game.ID, Status: domain.MatchStatusActive})
+
+	t.Run("Keep matches generating within the timeout", func(t *testing.T) {
+		recovered, err := repo.RecoverStuck(ctx, time.Hour, 100)
+		assert.NoError(t, err)
+		assert.Empty(t, recovered)
+	})
+
+	t.Run("Recover matches generating past the timeout", func(t *testing.T) {
+		recovered, err := repo.RecoverStuck(ctx, 0, 100)
+		assert.NoError(t, err)
+		assert.Len(t, recovered, 2)
+
+		got, err := repo.GetByID(ctx, crashed.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.MatchStatusError, got.Status)
+		assert.Equal(t, 3, got.TurnCount)
+
+		got, err = repo.GetByID(ctx, unsaved.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.MatchStatusActive, got.Status)
+		assert.Equal(t, 2, got.TurnCount)
+
+		got, err = repo.GetByID(ctx, active.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.MatchStatusActive, got.Status)
+	})
+}
@@ -28,12 +28,12 @@ func (r *messageRepository) Create(ctx context.Context, message *domain.Message)
 	message.ID = ulid.MustNew(ulid.Timestamp(time.Now()), ulid.Monotonic(rand.Reader, 0)).String()
 
 	const query = `
-        INSERT INTO messages (id, match_id, role, content, is_visible, turn_count, token_count, prompt_advice)
-        VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+        INSERT INTO messages (id, match_id, role, content, is_visible, turn_count, token_count, prompt_advice, provider, model)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
         RETURNING created_at
     `
 
-	err := r.db.QueryRowContext(
+	err := conn(ctx, r.db).QueryRowContext(
 		ctx,
 		query,
 		message.ID,
@@ -44,6 +44,8 @@ func (r *messageRepository) Create(ctx context.Context, message *domain.Message)
 		message.TurnCount,
 		message.TokenCount,
 		message.PromptAdvice,
+		message.Provider,
+		message.Model,
 	).Scan(&message.CreatedAt)
 
 	if err != nil {
@@ -56,13 +58,13 @@ func (r *messageRepository) Create(ctx context.Context, message *domain.Message)
 // GetByID retrieves a single message by its ID
 func (r *messageRepository) GetByID(ctx context.Context, id string) (*domain.Message, error) {
 	const query = `
-        SELECT id, match_id, role, content, is_visible, turn_count, token_count, prompt_advice, created_at
+        SELECT id, match_id, role, content, is_visible, turn_count, token_count, prompt_advice, provider, model, created_at
         FROM messages
         WHERE id = $1
     `
 
 	var msg domain.Message
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, id).Scan(
 		&msg.ID,
 		&msg.MatchID,
 		&msg.Role,
@@ -71,6 +73,8 @@ func (r *messageRepository) GetByID(ctx context.Context, id string) (*domain.Mes
 		&msg.TurnCount,
 		&msg.TokenCount,
 		&msg.PromptAdvice,
+		&msg.Provider,
+		&msg.Model,
 		&msg.CreatedAt,
 	)
 
@@ -84,13 +88,13 @@ func (r *messageRepository) GetByID(ctx context.Context, id string) (*domain.Mes
 // GetByMatchID retrieves all messages for a specific match
 func (r *messageRepository) GetByMatchID(ctx context.Context, matchID string) ([]domain.Message, error) {
 	const query = `
-        SELECT id, match_id, role, content, is_visible, turn_count, token_count, prompt_advice, created_at
+        SELECT id, match_id, role, content, is_visible, turn_count, token_count, prompt_advice, provider, model, created_at
         FROM messages
         WHERE match_id = $1
         ORDER BY created_at ASC
     `
 
-	rows, err := r.db.QueryContext(ctx, query, matchID)
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, matchID)
 	if err != nil {
 		return nil, mapDBError(err)
 	}
@@ -109,6 +113,8 @@ func (r *messageRepository) GetByMatchID(ctx context.Context, matchID string) ([
 			&msg.TurnCount,
 			&msg.TokenCount,
 			&msg.PromptAdvice,
+			&msg.Provider,
+			&msg.Model,
 			&msg.CreatedAt,
 		); err != nil {
 			return nil, mapDBError(err)
@@ -131,7 +137,7 @@ func (r *messageRepository) Update(ctx context.Context, msg *domain.Message) (*d
         WHERE id = $6
     `
 
-	_, err := r.db.ExecContext(
+	_, err := conn(ctx, r.db).ExecContext(
 		ctx,
 		query,
 		msg.Content,
@@ -156,7 +162,7 @@ func (r *messageRepository) Delete(ctx context.Context, id string) error {
         WHERE id = $1
     `
 
-	result, err := r.db.ExecContext(ctx, query, id)
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, id)
 	if err != nil {
 		return mapDBError(err)
 	}
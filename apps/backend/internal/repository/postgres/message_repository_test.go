@@ -58,6 +58,24 @@ func TestMessageRepository_Create(t *testing.T) {
 		assert.NotZero(t, createdMsg.CreatedAt)
 	})
 
+	t.Run("Record the provider of an assistant message", func(t *testing.T) {
+		msg := &domain.Message{
+			MatchID:  match.ID,
+			Role:     domain.MessageRoleAssistant,
+			Content:  "Hi",
+			Provider: "groq",
+			Model:    "llama-3.3-70b-versatile",
+		}
+
+		createdMsg, err := repo.Create(ctx, msg)
+		assert.NoError(t, err)
+
+		fetchedMsg, err := repo.GetByID(ctx, createdMsg.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, "groq", fetchedMsg.Provider)
+		assert.Equal(t, "llama-3.3-70b-versatile", fetchedMsg.Model)
+	})
+
 	t.Run("Fail to create message with non-existent match_id", func(t *testing.T) {
 		msg := &domain.Message{
 			MatchID: "01HQZYX3VQJQZ3Z0Z1NONEXIST",
@@ -0,0 +1,66 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/everyday-studio/ollm/internal/domain"
+)
+
+type quotaRepository struct {
+	db *sql.DB
+}
+
+// NewQuotaRepository creates a new quota repository
+func NewQuotaRepository(db *sql.DB) domain.QuotaRepository {
+	return &quotaRepository{
+		db: db,
+	}
+}
+
+// GetUserUsage sums the tokens and turns saved with the user's matches since the given time.
+// Tokens are those of the chat messages and the judge verdicts, so the count does not depend on the LLM audit log.
+// created_at columns hold UTC wall-clock times, so since is compared in UTC.
+func (r *quotaRepository) GetUserUsage(ctx context.Context, userID string, since time.Time) (*domain.QuotaUsage, error) {
+	const query = `
+        SELECT
+            (SELECT COALESCE(SUM(msg.token_count), 0)
+             FROM messages msg
+             JOIN matches m ON m.id = msg.match_id
+             WHERE m.user_id = $1 AND msg.created_at >= ($2::timestamptz AT TIME ZONE 'UTC'))
+            +
+            (SELECT COALESCE(SUM(v.prompt_tokens + v.completion_tokens), 0)
+             FROM turn_verdicts v
+             JOIN matches m ON m.id = v.match_id
+             WHERE m.user_id = $1 AND v.created_at >= ($2::timestamptz AT TIME ZONE 'UTC')),
+            (SELECT COUNT(*)
+             FROM messages msg
+             JOIN matches m ON m.id = msg.match_id
+             WHERE m.user_id = $1 AND msg.role = 'user' AND msg.created_at >= ($2::timestamptz AT TIME ZONE 'UTC'))
+    `
+
+	var usage domain.QuotaUsage
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, userID, since).Scan(&usage.Tokens, &usage.Turns)
+	if err != nil {
+		return nil, mapDBError(err)
+	}
+
+	return &usage, nil
+}
+
+// GetSpendSince sums the cost of every LLM call made since the given time
+func (r *quotaRepository) GetSpendSince(ctx context.Context, since time.Time) (float64, error) {
+	const query = `
+        SELECT COALESCE(SUM(cost_usd), 0)
+        FROM llm_calls
+        WHERE created_at >= ($1::timestamptz AT TIME ZONE 'UTC')
+    `
+
+	var spend float64
+	if err := conn(ctx, r.db).QueryRowContext(ctx, query, since).Scan(&spend); err != nil {
+		return 0, mapDBError(err)
+	}
+
+	return spend, nil
+}
@@ -0,0 +1,82 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/everyday-studio/ollm/internal/domain"
+)
+
+func TestQuotaRepository_Usage(t *testing.T) {
+	cleanDB(t, "llm_calls", "turn_verdicts", "messages", "matches", "games", "users")
+	ctx := context.Background()
+	repo := NewQuotaRepository(testDB)
+	callRepo := NewLLMCallRepository(testDB)
+	messageRepo := NewMessageRepository(testDB)
+	verdictRepo := NewTurnVerdictRepository(testDB)
+
+	user := createTestUser(t)
+	game := createTestGame(t, user)
+	match := createTestMatch(t, user, game)
+	since := time.Now().Add(-time.Hour)
+
+	// Chat tokens are saved on the messages: prompt tokens on the user's, completion tokens on the reply.
+	messages := []*domain.Message{
+		{MatchID: match.ID, Role: domain.MessageRoleUser, Content: "hi", IsVisible: true, TurnCount: 1, TokenCount: 100},
+		{MatchID: match.ID, Role: domain.MessageRoleAssistant, Content: "hi", IsVisible: true, TurnCount: 1, TokenCount: 20},
+		{MatchID: match.ID, Role: domain.MessageRoleUser, Content: "hi", IsVisible: true, TurnCount: 2, TokenCount: 0},
+	}
+	for _, msg := range messages {
+		_, err := messageRepo.Create(ctx, msg)
+		assert.NoError(t, err)
+	}
+	_, err := verdictRepo.Create(ctx, &domain.TurnVerdict{
+		MatchID:          match.ID,
+		MessageID:        messages[1].ID,
+		TurnCount:        1,
+		JudgeType:        domain.JudgeTypeLLMJudge,
+		Outcome:          domain.JudgeOutcomeContinue,
+		PromptTokens:     90,
+		CompletionTokens: 30,
+	})
+	assert.NoError(t, err)
+	// Audited calls only count toward the platform spend.
+	for _, cost := range []float64{0.01, 0.02} {
+		_, err := callRepo.Create(ctx, &domain.LLMCall{
+			MatchID:          match.ID,
+			Purpose:          domain.LLMCallPurposeChat,
+			Provider:         "openai",
+			Model:            "gpt-4o",
+			PromptTokens:     100,
+			CompletionTokens: 20,
+			CostUSD:          cost,
+		})
+		assert.NoError(t, err)
+	}
+
+	t.Run("Sum the tokens and turns of the user", func(t *testing.T) {
+		usage, err := repo.GetUserUsage(ctx, user.ID, since)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 240, usage.Tokens)
+		assert.Equal(t, 2, usage.Turns)
+	})
+
+	t.Run("Ignore usage before the given time", func(t *testing.T) {
+		usage, err := repo.GetUserUsage(ctx, user.ID, time.Now().Add(time.Hour))
+
+		assert.NoError(t, err)
+		assert.Zero(t, usage.Tokens)
+		assert.Zero(t, usage.Turns)
+	})
+
+	t.Run("Sum the platform spend", func(t *testing.T) {
+		spend, err := repo.GetSpendSince(ctx, since)
+
+		assert.NoError(t, err)
+		assert.InDelta(t, 0.03, spend, 1e-9)
+	})
+}
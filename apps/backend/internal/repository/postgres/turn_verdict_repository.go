@@ -0,0 +1,148 @@
+package postgres
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+
+	"github.com/everyday-studio/ollm/internal/domain"
+)
+
+type turnVerdictRepository struct {
+	db *sql.DB
+}
+
+// NewTurnVerdictRepository creates a new turn verdict repository
+func NewTurnVerdictRepository(db *sql.DB) domain.TurnVerdictRepository {
+	return &turnVerdictRepository{
+		db: db,
+	}
+}
+
+// Create inserts a new turn verdict into the database
+func (r *turnVerdictRepository) Create(ctx context.Context, verdict *domain.TurnVerdict) (*domain.TurnVerdict, error) {
+	verdict.ID = ulid.MustNew(ulid.Timestamp(time.Now()), ulid.Monotonic(rand.Reader, 0)).String()
+
+	votes := verdict.Votes
+	if votes == nil {
+		votes = []domain.JudgeVote{}
+	}
+	votesJSON, err := json.Marshal(votes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode turn verdict votes: %w", err)
+	}
+	leaves := verdict.Leaves
+	if leaves == nil {
+		leaves = []domain.JudgeLeaf{}
+	}
+	leavesJSON, err := json.Marshal(leaves)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode turn verdict leaves: %w", err)
+	}
+	var matchJSON []byte
+	if verdict.Match != nil {
+		if matchJSON, err = json.Marshal(verdict.Match); err != nil {
+			return nil, fmt.Errorf("failed to encode turn verdict match: %w", err)
+		}
+	}
+
+	const query = `
+        INSERT INTO turn_verdicts (id, match_id, message_id, turn_count, judge_type, outcome, reason, output,
+                                   votes, target_match, leaves, progress, error, prompt_tokens, completion_tokens)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+        RETURNING created_at
+    `
+
+	err = conn(ctx, r.db).QueryRowContext(
+		ctx,
+		query,
+		verdict.ID,
+		verdict.MatchID,
+		verdict.MessageID,
+		verdict.TurnCount,
+		verdict.JudgeType,
+		verdict.Outcome,
+		verdict.Reason,
+		verdict.Output,
+		votesJSON,
+		matchJSON,
+		leavesJSON,
+		nullableJSON(&verdict.Progress),
+		verdict.Error,
+		verdict.PromptTokens,
+		verdict.CompletionTokens,
+	).Scan(&verdict.CreatedAt)
+
+	if err != nil {
+		return nil, mapDBError(err)
+	}
+
+	return verdict, nil
+}
+
+// GetByMatchID retrieves the verdicts of a match in turn order
+func (r *turnVerdictRepository) GetByMatchID(ctx context.Context, matchID string) ([]domain.TurnVerdict, error) {
+	const query = `
+        SELECT id, match_id, message_id, turn_count, judge_type, outcome, reason, output,
+               votes, target_match, leaves, progress, error, prompt_tokens, completion_tokens, created_at
+        FROM turn_verdicts
+        WHERE match_id = $1
+        ORDER BY turn_count ASC, created_at ASC, id ASC
+    `
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, matchID)
+	if err != nil {
+		return nil, mapDBError(err)
+	}
+	defer rows.Close()
+
+	verdicts := []domain.TurnVerdict{}
+
+	for rows.Next() {
+		var v domain.TurnVerdict
+		var votesJSON, matchJSON, leavesJSON []byte
+		if err := rows.Scan(
+			&v.ID,
+			&v.MatchID,
+			&v.MessageID,
+			&v.TurnCount,
+			&v.JudgeType,
+			&v.Outcome,
+			&v.Reason,
+			&v.Output,
+			&votesJSON,
+			&matchJSON,
+			&leavesJSON,
+			nullableJSON(&v.Progress),
+			&v.Error,
+			&v.PromptTokens,
+			&v.CompletionTokens,
+			&v.CreatedAt,
+		); err != nil {
+			return nil, mapDBError(err)
+		}
+		if err := json.Unmarshal(votesJSON, &v.Votes); err != nil {
+			return nil, fmt.Errorf("failed to decode turn verdict votes: %w", err)
+		}
+		if matchJSON != nil {
+			if err := json.Unmarshal(matchJSON, &v.Match); err != nil {
+				return nil, fmt.Errorf("failed to decode turn verdict match: %w", err)
+			}
+		}
+		if err := json.Unmarshal(leavesJSON, &v.Leaves); err != nil {
+			return nil, fmt.Errorf("failed to decode turn verdict leaves: %w", err)
+		}
+		verdicts = append(verdicts, v)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, mapDBError(err)
+	}
+
+	return verdicts, nil
+}
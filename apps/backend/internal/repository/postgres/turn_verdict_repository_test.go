@@ -0,0 +1,140 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/everyday-studio/ollm/internal/domain"
+)
+
+func TestTurnVerdictRepository_CreateAndGetByMatchID(t *testing.T) {
+	cleanDB(t, "turn_verdicts", "messages", "matches", "games", "users")
+	ctx := context.Background()
+	repo := NewTurnVerdictRepository(testDB)
+	messageRepo := NewMessageRepository(testDB)
+
+	user := createTestUser(t)
+	game := createTestGame(t, user)
+	match := createTestMatch(t, user, game)
+
+	replies := make([]*domain.Message, 0, 2)
+	for turn := 1; turn <= 2; turn++ {
+		reply, err := messageRepo.Create(ctx, &domain.Message{MatchID: match.ID, Role: domain.MessageRoleAssistant, Content: "no", IsVisible: true, TurnCount: turn})
+		assert.NoError(t, err)
+		replies = append(replies, reply)
+	}
+
+	t.Run("Record verdicts", func(t *testing.T) {
+		for i, reply := range replies {
+			verdict := &domain.TurnVerdict{
+				MatchID:   match.ID,
+				MessageID: reply.ID,
+				TurnCount: len(replies) - i,
+				JudgeType: domain.JudgeTypeLLMJudge,
+				Outcome:   domain.JudgeOutcomeContinue,
+				Reason:    "The AI refused.",
+				Output:    "<reason>The AI refused.</reason><result>false</result>",
+				Votes: []domain.JudgeVote{
+					{Model: "gpt-4o", Result: false, Reason: "The AI refused.", PromptTokens: 20, CompletionTokens: 6},
+					{Model: "llama-3", Failed: true, Reason: "the judge could not rule on this turn"},
+				},
+				PromptTokens:     40,
+				CompletionTokens: 12,
+			}
+
+			created, err := repo.Create(ctx, verdict)
+			assert.NoError(t, err)
+			assert.NotEmpty(t, created.ID)
+			assert.NotZero(t, created.CreatedAt)
+		}
+	})
+
+	t.Run("Get verdicts in turn order", func(t *testing.T) {
+		verdicts, err := repo.GetByMatchID(ctx, match.ID)
+
+		assert.NoError(t, err)
+		if assert.Len(t, verdicts, 2) {
+			assert.Equal(t, 1, verdicts[0].TurnCount)
+			assert.Equal(t, 2, verdicts[1].TurnCount)
+			assert.Equal(t, "The AI refused.", verdicts[0].Reason)
+			assert.Equal(t, domain.JudgeOutcomeContinue, verdicts[0].Outcome)
+			if assert.Len(t, verdicts[0].Votes, 2) {
+				assert.Equal(t, "gpt-4o", verdicts[0].Votes[0].Model)
+				assert.True(t, verdicts[0].Votes[1].Failed)
+			}
+		}
+	})
+	t.Run("Record where the target word was found", func(t *testing.T) {
+		reply, err := messageRepo.Create(ctx, &domain.Message{MatchID: match.ID, Role: domain.MessageRoleAssistant, Content: "a-p-p-l-e", IsVisible: true, TurnCount: 3})
+		assert.NoError(t, err)
+
+		_, err = repo.Create(ctx, &domain.TurnVerdict{
+			MatchID:   match.ID,
+			MessageID: reply.ID,
+			TurnCount: 3,
+			JudgeType: domain.JudgeTypeTargetWord,
+			Outcome:   domain.JudgeOutcomeWon,
+			Reason:    "reply contains the target word",
+			Match:     &domain.JudgeMatch{Text: "a-p-p-l-e", Start: 0, End: 9, Transformations: []string{"spacing"}},
+		})
+		assert.NoError(t, err)
+
+		verdicts, err := repo.GetByMatchID(ctx, match.ID)
+		assert.NoError(t, err)
+		if assert.Len(t, verdicts, 3) {
+			assert.Nil(t, verdicts[0].Match)
+			assert.Equal(t, &domain.JudgeMatch{Text: "a-p-p-l-e", Start: 0, End: 9, Transformations: []string{"spacing"}}, verdicts[2].Match)
+		}
+	})
+	t.Run("Record the leaves of a composite condition", func(t *testing.T) {
+		reply, err := messageRepo.Create(ctx, &domain.Message{MatchID: match.ID, Role: domain.MessageRoleAssistant, Content: "apple", IsVisible: true, TurnCount: 4})
+		assert.NoError(t, err)
+
+		leaves := []domain.JudgeLeaf{
+			{Leaf: 0, JudgeType: domain.JudgeTypeTargetWord, Outcome: domain.JudgeOutcomeWon, Reason: "reply contains the target word"},
+			{Leaf: 1, JudgeType: domain.JudgeTypeLLMJudge, Outcome: domain.JudgeOutcomeContinue, Reason: "the judge could not rule on this turn", Failed: true},
+		}
+		_, err = repo.Create(ctx, &domain.TurnVerdict{
+			MatchID:   match.ID,
+			MessageID: reply.ID,
+			TurnCount: 4,
+			JudgeType: domain.JudgeTypeComposite,
+			Outcome:   domain.JudgeOutcomeWon,
+			Reason:    "composite condition met: (target_word#0 met OR llm_judge#1 failed)",
+			Leaves:    leaves,
+		})
+		assert.NoError(t, err)
+
+		verdicts, err := repo.GetByMatchID(ctx, match.ID)
+		assert.NoError(t, err)
+		if assert.Len(t, verdicts, 4) {
+			assert.Empty(t, verdicts[0].Leaves)
+			assert.Equal(t, leaves, verdicts[3].Leaves)
+		}
+	})
+	t.Run("Record the progress after the turn", func(t *testing.T) {
+		reply, err := messageRepo.Create(ctx, &domain.Message{MatchID: match.ID, Role: domain.MessageRoleAssistant, Content: "apple", IsVisible: true, TurnCount: 5})
+		assert.NoError(t, err)
+
+		progress := &domain.JudgeProgress{Achieved: []string{"apple"}, Total: 2}
+		_, err = repo.Create(ctx, &domain.TurnVerdict{
+			MatchID:   match.ID,
+			MessageID: reply.ID,
+			TurnCount: 5,
+			JudgeType: domain.JudgeTypeTargetWord,
+			Outcome:   domain.JudgeOutcomeContinue,
+			Reason:    "1 of 2 target words found",
+			Progress:  progress,
+		})
+		assert.NoError(t, err)
+
+		verdicts, err := repo.GetByMatchID(ctx, match.ID)
+		assert.NoError(t, err)
+		if assert.Len(t, verdicts, 5) {
+			assert.Nil(t, verdicts[0].Progress)
+			assert.Equal(t, progress, verdicts[4].Progress)
+		}
+	})
+}
@@ -0,0 +1,143 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/everyday-studio/ollm/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTxManager_WithinTx(t *testing.T) {
+	cleanDB(t, "messages", "matches", "games", "users")
+	ctx := context.Background()
+	txManager := NewTxManager(testDB)
+	matchRepo := NewMatchRepository(testDB)
+	messageRepo := NewMessageRepository(testDB)
+
+	user := createTestUser(t)
+	game := createTestGame(t, user)
+
+	// playTurn locks the match and saves the user message of the turn as one unit of work
+	playTurn := func(ctx context.Context, match *domain.Match) error {
+		locked := *match
+		locked.Status = domain.MatchStatusGenerating
+		if _, err := matchRepo.CompareAndSet(ctx, &locked, domain.MatchStatusActive); err != nil {
+			return err
+		}
+		_, err := messageRepo.Create(ctx, &domain.Message{MatchID: match.ID, Role: domain.MessageRoleUser, Content: "hi", IsVisible: true, TurnCount: match.TurnCount + 1})
+		return err
+	}
+
+	assertUntouched := func(t *testing.T, match *domain.Match) {
+		t.Helper()
+		got, err := matchRepo.GetByID(ctx, match.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.MatchStatusActive, got.Status)
+		assert.Equal(t, match.Version, got.Version)
+
+		messages, err := messageRepo.GetByMatchID(ctx, match.ID)
+		assert.NoError(t, err)
+		assert.Empty(t, messages)
+	}
+
+	t.Run("Commit every call of the unit of work", func(t *testing.T) {
+		match := createTestMatch(t, user, game)
+
+		err := txManager.WithinTx(ctx, func(ctx context.Context) error {
+			return playTurn(ctx, match)
+		})
+		assert.NoError(t, err)
+
+		got, err := matchRepo.GetByID(ctx, match.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.MatchStatusGenerating, got.Status)
+
+		messages, err := messageRepo.GetByMatchID(ctx, match.ID)
+		assert.NoError(t, err)
+		assert.Len(t, messages, 1)
+	})
+
+	t.Run("Roll back every call when the unit of work fails", func(t *testing.T) {
+		match := createTestMatch(t, user, game)
+		failure := errors.New("turn failed")
+
+		err := txManager.WithinTx(ctx, func(ctx context.Context) error {
+			if err := playTurn(ctx, match); err != nil {
+				return err
+			}
+			return failure
+		})
+
+		assert.ErrorIs(t, err, failure)
+		assertUntouched(t, match)
+	})
+
+	t.Run("Roll back the calls made before a failed statement", func(t *testing.T) {
+		match := createTestMatch(t, user, game)
+
+		err := txManager.WithinTx(ctx, func(ctx context.Context) error {
+			if err := playTurn(ctx, match); err != nil {
+				return err
+			}
+			// The match was just locked, so a second lock from the same read conflicts.
+			return playTurn(ctx, match)
+		})
+
+		assert.ErrorIs(t, err, domain.ErrConflict)
+		assertUntouched(t, match)
+	})
+
+	t.Run("Roll back when the unit of work panics", func(t *testing.T) {
+		match := createTestMatch(t, user, game)
+
+		assert.Panics(t, func() {
+			_ = txManager.WithinTx(ctx, func(ctx context.Context) error {
+				if err := playTurn(ctx, match); err != nil {
+					return err
+				}
+				panic("turn panicked")
+			})
+		})
+
+		assertUntouched(t, match)
+	})
+
+	t.Run("Join the outer transaction when nested", func(t *testing.T) {
+		match := createTestMatch(t, user, game)
+		failure := errors.New("turn failed")
+
+		err := txManager.WithinTx(ctx, func(ctx context.Context) error {
+			if err := txManager.WithinTx(ctx, func(ctx context.Context) error {
+				return playTurn(ctx, match)
+			}); err != nil {
+				return err
+			}
+			return failure
+		})
+
+		assert.ErrorIs(t, err, failure)
+		assertUntouched(t, match)
+	})
+
+	t.Run("Hide uncommitted writes from calls outside the transaction", func(t *testing.T) {
+		match := createTestMatch(t, user, game)
+
+		err := txManager.WithinTx(ctx, func(txCtx context.Context) error {
+			if err := playTurn(txCtx, match); err != nil {
+				return err
+			}
+
+			got, err := matchRepo.GetByID(txCtx, match.ID)
+			assert.NoError(t, err)
+			assert.Equal(t, domain.MatchStatusGenerating, got.Status)
+
+			got, err = matchRepo.GetByID(ctx, match.ID)
+			assert.NoError(t, err)
+			assert.Equal(t, domain.MatchStatusActive, got.Status)
+			return nil
+		})
+		assert.NoError(t, err)
+	})
+}
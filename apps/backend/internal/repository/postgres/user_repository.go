@@ -41,7 +41,7 @@ func (r *userRepository) Save(ctx context.Context, user *domain.User) (*domain.U
 		RETURNING created_at, updated_at
 	`
 
-	err := r.db.QueryRowContext(ctx, query, user.ID, user.Name, user.Tag, user.Email, user.Password, user.Role).
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, user.ID, user.Name, user.Tag, user.Email, user.Password, user.Role).
 		Scan(&user.CreatedAt, &user.UpdatedAt)
 	if err != nil {
 		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
@@ -64,7 +64,7 @@ func (r *userRepository) GetByID(ctx context.Context, id string) (*domain.User,
 	`
 
 	var user domain.User
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, id).Scan(
 		&user.ID,
 		&user.Name,
 		&user.Tag,
@@ -86,7 +86,7 @@ func (r *userRepository) GetByID(ctx context.Context, id string) (*domain.User,
 func (r *userRepository) CountAll(ctx context.Context) (int, error) {
 	query := `SELECT COUNT(*) FROM users WHERE deleted_at IS NULL`
 	var count int
-	if err := r.db.QueryRowContext(ctx, query).Scan(&count); err != nil {
+	if err := conn(ctx, r.db).QueryRowContext(ctx, query).Scan(&count); err != nil {
 		return 0, fmt.Errorf("failed to count all users: %w", err)
 	}
 	return count, nil
@@ -102,7 +102,7 @@ func (r *userRepository) GetPaginated(ctx context.Context, page, limit int) ([]d
 		LIMIT $1 OFFSET $2
 	`
 
-	rows, err := r.db.QueryContext(ctx, query, limit, offset)
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, limit, offset)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get paginated users: %w", err)
 	}
@@ -140,7 +140,7 @@ func (r *userRepository) GetUserByEmail(ctx context.Context, email string) (*dom
 	`
 
 	user := &domain.User{}
-	err := r.db.QueryRowContext(ctx, query, email).Scan(
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, email).Scan(
 		&user.ID,
 		&user.Name,
 		&user.Tag,
@@ -167,7 +167,7 @@ func (r *userRepository) UpdateNickname(ctx context.Context, id string, name str
 		WHERE id = $2
 	`
 
-	res, err := r.db.ExecContext(ctx, query, name, id)
+	res, err := conn(ctx, r.db).ExecContext(ctx, query, name, id)
 	if err != nil {
 		return fmt.Errorf("failed to update nickname: %w", err)
 	}
@@ -195,7 +195,7 @@ func (r *userRepository) UpsertGoogleUser(ctx context.Context, user *domain.User
 		WHERE google_id = $1 AND deleted_at IS NULL
 	`
 	existing := &domain.User{}
-	err := r.db.QueryRowContext(ctx, selectQuery, user.GoogleID).Scan(
+	err := conn(ctx, r.db).QueryRowContext(ctx, selectQuery, user.GoogleID).Scan(
 		&existing.ID,
 		&existing.Name,
 		&existing.Tag,
@@ -233,7 +233,7 @@ func (r *userRepository) UpsertGoogleUser(ctx context.Context, user *domain.User
 			VALUES ($1, $2, $3, $4, NULL, $5, $6)
 			RETURNING created_at, updated_at
 		`
-		insertErr := r.db.QueryRowContext(
+		insertErr := conn(ctx, r.db).QueryRowContext(
 			ctx, insertQuery,
 			user.ID, user.Name, user.Tag, user.Email, user.GoogleID, user.Role,
 		).Scan(&user.CreatedAt, &user.UpdatedAt)
@@ -265,7 +265,7 @@ func (r *userRepository) Delete(ctx context.Context, id string) error {
 		WHERE id = $1 AND deleted_at IS NULL
 	`
 
-	res, err := r.db.ExecContext(ctx, query, id, deletedMarker)
+	res, err := conn(ctx, r.db).ExecContext(ctx, query, id, deletedMarker)
 	if err != nil {
 		return fmt.Errorf("failed to soft delete user: %w", err)
 	}
@@ -0,0 +1,390 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/everyday-studio/ollm/internal/domain"
+	"github.com/everyday-studio/ollm/internal/kit/contexts"
+	"github.com/everyday-studio/ollm/internal/kit/prompt"
+)
+
+const (
+	maxAppealComment   = 1000 // characters of a player's comment
+	maxAppealsPerMatch = 3    // appeals a player can make on one match, resolved or not
+	appealQueueSize    = 50   // pending appeals listed to admins at once
+)
+
+type appealUseCase struct {
+	appealRepo    domain.AppealRepository
+	overrideRepo  domain.MatchOverrideRepository
+	matchRepo     domain.MatchRepository
+	gameRepo      domain.GameRepository
+	messageRepo   domain.MessageRepository
+	verdictRepo   domain.TurnVerdictRepository
+	llmRegistry   domain.LLMRegistry
+	judgeRegistry domain.JudgeRegistry
+	txManager     domain.TxManager
+}
+
+// NewAppealUseCase creates a new appeal usecase
+func NewAppealUseCase(
+	appealRepo domain.AppealRepository,
+	overrideRepo domain.MatchOverrideRepository,
+	matchRepo domain.MatchRepository,
+	gameRepo domain.GameRepository,
+	messageRepo domain.MessageRepository,
+	verdictRepo domain.TurnVerdictRepository,
+	llmRegistry domain.LLMRegistry,
+	judgeRegistry domain.JudgeRegistry,
+	txManager domain.TxManager,
+) domain.AppealUseCase {
+	return &appealUseCase{
+		appealRepo:    appealRepo,
+		overrideRepo:  overrideRepo,
+		matchRepo:     matchRepo,
+		gameRepo:      gameRepo,
+		messageRepo:   messageRepo,
+		verdictRepo:   verdictRepo,
+		llmRegistry:   llmRegistry,
+		judgeRegistry: judgeRegistry,
+		txManager:     txManager,
+	}
+}
+
+// Create disputes the verdict of a turn of the user's finished match
+func (uc *appealUseCase) Create(ctx context.Context, matchID string, userID string, req *domain.CreateAppealRequest) (*domain.Appeal, error) {
+	comment := strings.TrimSpace(req.Comment)
+	if comment == "" || len([]rune(comment)) > maxAppealComment {
+		return nil, fmt.Errorf("%w: comment must be between 1 and %d characters", domain.ErrInvalidInput, maxAppealComment)
+	}
+
+	match, err := uc.matchRepo.GetByID(ctx, matchID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get match for appeal: %w", err)
+	}
+	if match.UserID != userID {
+		return nil, domain.ErrForbidden
+	}
+	if !match.IsOver() {
+		return nil, fmt.Errorf("%w: verdicts can be appealed once the match is over", domain.ErrConflict)
+	}
+
+	appeals, err := uc.appealRepo.GetByMatchID(ctx, matchID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get appeals of match: %w", err)
+	}
+	if len(appeals) >= maxAppealsPerMatch {
+		return nil, fmt.Errorf("%w: a match can be appealed at most %d times", domain.ErrConflict, maxAppealsPerMatch)
+	}
+
+	verdicts, err := uc.verdictRepo.GetByMatchID(ctx, matchID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get verdicts of match: %w", err)
+	}
+	verdict := verdictOfTurn(verdicts, req.TurnCount)
+	if verdict == nil {
+		return nil, fmt.Errorf("%w: turn %d has no verdict", domain.ErrInvalidInput, req.TurnCount)
+	}
+
+	// A second pending appeal of the match is rejected by the repository with ErrConflict
+	return uc.appealRepo.Create(ctx, &domain.Appeal{
+		MatchID:   matchID,
+		UserID:    userID,
+		VerdictID: verdict.ID,
+		TurnCount: verdict.TurnCount,
+		Comment:   comment,
+		Status:    domain.AppealStatusPending,
+	})
+}
+
+// GetByMatchID returns the appeals of the user's match with their resolutions
+func (uc *appealUseCase) GetByMatchID(ctx context.Context, matchID string, userID string) ([]domain.Appeal, error) {
+	match, err := uc.matchRepo.GetByID(ctx, matchID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get match for appeals: %w", err)
+	}
+	if match.UserID != userID {
+		return nil, domain.ErrForbidden
+	}
+
+	return uc.appealRepo.GetByMatchID(ctx, matchID)
+}
+
+// GetPending returns the queue of appeals waiting for an admin, oldest first
+func (uc *appealUseCase) GetPending(ctx context.Context) ([]domain.Appeal, error) {
+	return uc.appealRepo.GetPending(ctx, appealQueueSize)
+}
+
+// GetReview gathers the appeal with the transcript, verdicts and earlier overrides of its match
+func (uc *appealUseCase) GetReview(ctx context.Context, id string) (*domain.AppealReview, error) {
+	appeal, err := uc.appealRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	match, err := uc.matchRepo.GetByID(ctx, appeal.MatchID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get match of appeal: %w", err)
+	}
+	game, err := uc.gameRepo.GetByID(ctx, match.GameID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get game of appeal: %w", err)
+	}
+	messages, err := uc.messageRepo.GetByMatchID(ctx, match.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transcript of appeal: %w", err)
+	}
+	verdicts, err := uc.verdictRepo.GetByMatchID(ctx, match.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get verdicts of appeal: %w", err)
+	}
+	overrides, err := uc.overrideRepo.GetByMatchID(ctx, match.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get overrides of appeal: %w", err)
+	}
+
+	return &domain.AppealReview{
+		Appeal:    *appeal,
+		Match:     *match,
+		Game:      *game,
+		Messages:  messages,
+		Verdicts:  verdicts,
+		Overrides: overrides,
+	}, nil
+}
+
+// Rejudge runs the game's current judge again on the disputed turn and applies its outcome.
+// Conditions with several goals are judged from the progress the match had recorded before that turn.
+// A win or loss sets the match status. A last turn the judge now lets continue loses the match only if it was
+// out of turns; a win with turns left cannot be replayed, so it is refused and left to SetStatus.
+func (uc *appealUseCase) Rejudge(ctx context.Context, id string, adminID string, req *domain.ResolveAppealRequest) (*domain.MatchOverride, error) {
+	review, err := uc.GetReview(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if review.Appeal.Status != domain.AppealStatusPending {
+		return nil, fmt.Errorf("%w: appeal is already resolved", domain.ErrConflict)
+	}
+
+	var verdict *domain.TurnVerdict
+	for i := range review.Verdicts {
+		if review.Verdicts[i].ID == review.Appeal.VerdictID {
+			verdict = &review.Verdicts[i]
+		}
+	}
+	if verdict == nil {
+		return nil, fmt.Errorf("verdict %s of appeal is missing: %w", review.Appeal.VerdictID, domain.ErrNotFound)
+	}
+	replyAt := -1
+	for i, m := range review.Messages {
+		if m.ID == verdict.MessageID {
+			replyAt = i
+		}
+	}
+	if replyAt < 0 {
+		return nil, fmt.Errorf("reply %s of appeal is missing: %w", verdict.MessageID, domain.ErrNotFound)
+	}
+	reply := review.Messages[replyAt]
+	progress, err := progressBefore(review.Verdicts, verdict.TurnCount, review.Match.JudgeProgress != nil)
+	if err != nil {
+		return nil, err
+	}
+
+	game := review.Game
+	judge, err := uc.judgeRegistry.Get(game.JudgeType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve judge: %w", err)
+	}
+	judgeLLM, err := uc.llmRegistry.Judge(game.JudgeModel)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve judge model: %w", err)
+	}
+	panel, err := resolveJudgePanel(uc.llmRegistry, game.JudgePanel)
+	if err != nil {
+		return nil, err
+	}
+
+	// 재심 판정도 매치 기준으로 감사 로그에 기록
+	ctx = contexts.WithLLMCallScope(ctx, review.Match.ID, reply.ID)
+	newVerdict, err := judge.Evaluate(ctx, domain.JudgeInput{
+		Condition:  prompt.RenderCondition(game.JudgeType, game.JudgeCondition, review.Match.Secrets),
+		Reply:      &reply,
+		History:    review.Messages[:replyAt+1],
+		LLM:        judgeLLM,
+		Panel:      panel,
+		Policy:     game.JudgePolicy,
+		Quorum:     game.JudgeQuorum,
+		Strictness: game.JudgeStrictness,
+		Scope:      game.JudgeScope,
+		ScopeTurns: game.JudgeScopeTurns,
+		Progress:   progress,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to re-judge turn: %w", err)
+	}
+
+	to := review.Match.Status
+	switch newVerdict.Outcome {
+	case domain.JudgeOutcomeWon:
+		to = domain.MatchStatusWon
+	case domain.JudgeOutcomeLost:
+		to = domain.MatchStatusLost
+	default:
+		// 이어가기 판정은 매치를 끝낸 마지막 턴에서만 결과를 바꿈
+		if verdict.TurnCount != review.Match.TurnCount {
+			break
+		}
+		if verdict.TurnCount >= review.Match.MaxTurns {
+			to = domain.MatchStatusLost
+		} else if to == domain.MatchStatusWon {
+			return nil, fmt.Errorf("%w: the re-judged turn lets a won match continue with turns left; set the status by hand", domain.ErrConflict)
+		}
+	}
+
+	reason := strings.TrimSpace(req.Reason)
+	if reason == "" {
+		reason = newVerdict.Reason
+	}
+
+	return uc.resolve(ctx, &review.Appeal, &review.Match, adminID, &domain.MatchOverride{
+		Action:   domain.MatchOverrideActionRejudge,
+		ToStatus: to,
+		Reason:   reason,
+		Verdict:  newVerdict,
+	})
+}
+
+// SetStatus sets the status of the appealed match by hand to won or lost
+func (uc *appealUseCase) SetStatus(ctx context.Context, id string, adminID string, req *domain.ResolveAppealRequest) (*domain.MatchOverride, error) {
+	if req.Status != domain.MatchStatusWon && req.Status != domain.MatchStatusLost {
+		return nil, fmt.Errorf("%w: status must be won or lost", domain.ErrInvalidInput)
+	}
+	reason := strings.TrimSpace(req.Reason)
+	if reason == "" {
+		return nil, fmt.Errorf("%w: a reason is required", domain.ErrInvalidInput)
+	}
+
+	appeal, match, err := uc.pending(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.resolve(ctx, appeal, match, adminID, &domain.MatchOverride{
+		Action:   domain.MatchOverrideActionSetStatus,
+		ToStatus: req.Status,
+		Reason:   reason,
+	})
+}
+
+// Uphold closes the appeal and leaves the match as it is
+func (uc *appealUseCase) Uphold(ctx context.Context, id string, adminID string, req *domain.ResolveAppealRequest) (*domain.MatchOverride, error) {
+	reason := strings.TrimSpace(req.Reason)
+	if reason == "" {
+		return nil, fmt.Errorf("%w: a reason is required", domain.ErrInvalidInput)
+	}
+
+	appeal, match, err := uc.pending(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.resolve(ctx, appeal, match, adminID, &domain.MatchOverride{
+		Action:   domain.MatchOverrideActionUphold,
+		ToStatus: match.Status,
+		Reason:   reason,
+	})
+}
+
+// pending returns a pending appeal with its match
+func (uc *appealUseCase) pending(ctx context.Context, id string) (*domain.Appeal, *domain.Match, error) {
+	appeal, err := uc.appealRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if appeal.Status != domain.AppealStatusPending {
+		return nil, nil, fmt.Errorf("%w: appeal is already resolved", domain.ErrConflict)
+	}
+	match, err := uc.matchRepo.GetByID(ctx, appeal.MatchID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get match of appeal: %w", err)
+	}
+	return appeal, match, nil
+}
+
+// resolve closes the appeal, applies the status of the override to the match and records the override.
+// Leaderboards read the status of matches, so they reflect the change as soon as the match is saved.
+func (uc *appealUseCase) resolve(ctx context.Context, appeal *domain.Appeal, match *domain.Match, adminID string, override *domain.MatchOverride) (*domain.MatchOverride, error) {
+	override.MatchID = match.ID
+	override.AppealID = appeal.ID
+	override.AdminID = adminID
+	override.FromStatus = match.Status
+
+	appeal.Status = domain.AppealStatusUpheld
+	if override.ToStatus != override.FromStatus {
+		appeal.Status = domain.AppealStatusOverturned
+	}
+	appeal.Resolution = override.Reason
+	appeal.ResolvedBy = adminID
+
+	var created *domain.MatchOverride
+	err := uc.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		// Closing the appeal first means only one admin decision on it can ever be applied
+		if _, err := uc.appealRepo.Resolve(ctx, appeal); err != nil {
+			return fmt.Errorf("failed to resolve appeal: %w", err)
+		}
+
+		if override.ToStatus != override.FromStatus {
+			changed := *match
+			changed.Status = override.ToStatus
+			if _, err := uc.matchRepo.CompareAndSet(ctx, &changed, override.FromStatus); err != nil {
+				return fmt.Errorf("failed to update match status: %w", err)
+			}
+		}
+
+		var err error
+		created, err = uc.overrideRepo.Create(ctx, override)
+		if err != nil {
+			return fmt.Errorf("failed to record override: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// progressBefore returns the progress the match had recorded before the turn, for conditions with several goals.
+// Turns whose judge failed kept the progress of the turn before them; any other verdict without progress
+// was saved before progress was recorded, so the progress cannot be rebuilt.
+func progressBefore(verdicts []domain.TurnVerdict, turnCount int, tracked bool) (*domain.JudgeProgress, error) {
+	if !tracked {
+		return nil, nil
+	}
+	for i := len(verdicts) - 1; i >= 0; i-- {
+		v := verdicts[i]
+		if v.TurnCount >= turnCount {
+			continue
+		}
+		if v.Progress != nil {
+			return v.Progress, nil
+		}
+		if v.Error == "" {
+			return nil, fmt.Errorf("%w: the progress before turn %d was not recorded; set the status by hand", domain.ErrConflict, turnCount)
+		}
+	}
+	return nil, nil
+}
+
+// verdictOfTurn returns the latest verdict of the turn, or nil if the turn has none
+func verdictOfTurn(verdicts []domain.TurnVerdict, turnCount int) *domain.TurnVerdict {
+	var found *domain.TurnVerdict
+	for i := range verdicts {
+		if verdicts[i].TurnCount == turnCount {
+			found = &verdicts[i]
+		}
+	}
+	return found
+}
@@ -0,0 +1,443 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/everyday-studio/ollm/internal/domain"
+	"github.com/everyday-studio/ollm/internal/domain/mocks"
+	"github.com/everyday-studio/ollm/internal/kit/judge"
+)
+
+func TestAppealUseCase_Create(t *testing.T) {
+	const matchID = "01HQZYX3VQJQZ3Z0Z1ZMATCH01"
+	const userID = "01HQZYX3VQJQZ3Z0Z1Z2ZUSER1"
+
+	verdicts := []domain.TurnVerdict{
+		{ID: "V1", MatchID: matchID, MessageID: "M2", TurnCount: 1, Outcome: domain.JudgeOutcomeContinue},
+		{ID: "V2", MatchID: matchID, MessageID: "M4", TurnCount: 2, Outcome: domain.JudgeOutcomeContinue},
+	}
+
+	tests := []struct {
+		name        string
+		req         *domain.CreateAppealRequest
+		match       *domain.Match
+		appeals     []domain.Appeal
+		wantVerdict string
+		wantErr     error
+	}{
+		{
+			name:        "Appeal a turn of a lost match",
+			req:         &domain.CreateAppealRequest{TurnCount: 2, Comment: "  The AI spelled the word out.  "},
+			match:       &domain.Match{ID: matchID, UserID: userID, Status: domain.MatchStatusLost},
+			wantVerdict: "V2",
+		},
+		{
+			name:    "Reject an empty comment",
+			req:     &domain.CreateAppealRequest{TurnCount: 2, Comment: "   "},
+			match:   &domain.Match{ID: matchID, UserID: userID, Status: domain.MatchStatusLost},
+			wantErr: domain.ErrInvalidInput,
+		},
+		{
+			name:    "Reject another player's match",
+			req:     &domain.CreateAppealRequest{TurnCount: 2, Comment: "Unfair."},
+			match:   &domain.Match{ID: matchID, UserID: "someone else", Status: domain.MatchStatusLost},
+			wantErr: domain.ErrForbidden,
+		},
+		{
+			name:    "Reject a match in play",
+			req:     &domain.CreateAppealRequest{TurnCount: 2, Comment: "Unfair."},
+			match:   &domain.Match{ID: matchID, UserID: userID, Status: domain.MatchStatusActive},
+			wantErr: domain.ErrConflict,
+		},
+		{
+			name:    "Reject a turn without a verdict",
+			req:     &domain.CreateAppealRequest{TurnCount: 7, Comment: "Unfair."},
+			match:   &domain.Match{ID: matchID, UserID: userID, Status: domain.MatchStatusLost},
+			wantErr: domain.ErrInvalidInput,
+		},
+		{
+			name:    "Reject a match appealed too often",
+			req:     &domain.CreateAppealRequest{TurnCount: 2, Comment: "Unfair."},
+			match:   &domain.Match{ID: matchID, UserID: userID, Status: domain.MatchStatusLost},
+			appeals: []domain.Appeal{{ID: "A1"}, {ID: "A2"}, {ID: "A3"}},
+			wantErr: domain.ErrConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockMatchRepo := new(mocks.MatchRepository)
+			mockMatchRepo.On("GetByID", mock.Anything, matchID).Return(tt.match, nil).Maybe()
+			mockVerdictRepo := new(mocks.TurnVerdictRepository)
+			mockVerdictRepo.On("GetByMatchID", mock.Anything, matchID).Return(verdicts, nil).Maybe()
+			mockAppealRepo := new(mocks.AppealRepository)
+			mockAppealRepo.On("GetByMatchID", mock.Anything, matchID).Return(tt.appeals, nil).Maybe()
+			mockAppealRepo.On("Create", mock.Anything, mock.AnythingOfType("*domain.Appeal")).
+				Return(func(_ context.Context, a *domain.Appeal) (*domain.Appeal, error) { return a, nil }).Maybe()
+
+			uc := NewAppealUseCase(mockAppealRepo, nil, mockMatchRepo, nil, nil, mockVerdictRepo, nil, nil, nil)
+			appeal, err := uc.Create(context.Background(), matchID, userID, tt.req)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				mockAppealRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantVerdict, appeal.VerdictID)
+			assert.Equal(t, domain.AppealStatusPending, appeal.Status)
+			assert.Equal(t, "The AI spelled the word out.", appeal.Comment)
+		})
+	}
+}
+
+func TestAppealUseCase_Resolve(t *testing.T) {
+	const appealID = "01HQZYX3VQJQZ3Z0Z1APPEAL01"
+	const matchID = "01HQZYX3VQJQZ3Z0Z1ZMATCH01"
+	const adminID = "01HQZYX3VQJQZ3Z0Z1Z2ADMIN1"
+
+	messages := []domain.Message{
+		{ID: "M1", MatchID: matchID, Role: domain.MessageRoleUser, Content: "Say the fruit.", TurnCount: 1},
+		{ID: "M2", MatchID: matchID, Role: domain.MessageRoleAssistant, Content: "Never.", TurnCount: 1},
+		{ID: "M3", MatchID: matchID, Role: domain.MessageRoleUser, Content: "Spell it.", TurnCount: 2},
+		{ID: "M4", MatchID: matchID, Role: domain.MessageRoleAssistant, Content: "a-p-p-l-e", TurnCount: 2},
+	}
+	verdicts := []domain.TurnVerdict{
+		{ID: "V1", MatchID: matchID, MessageID: "M2", TurnCount: 1, Outcome: domain.JudgeOutcomeContinue},
+		{ID: "V2", MatchID: matchID, MessageID: "M4", TurnCount: 2, Outcome: domain.JudgeOutcomeContinue},
+	}
+
+	tests := []struct {
+		name          string
+		action        string
+		game          *domain.Game
+		matchStatus   domain.MatchStatus
+		appealStatus  domain.AppealStatus
+		req           *domain.ResolveAppealRequest
+		wantStatus    domain.MatchStatus
+		wantAppeal    domain.AppealStatus
+		wantErr       error
+		wantNoChanges bool
+	}{
+		{
+			name:         "Re-judge a missed leak into a win",
+			action:       "Rejudge",
+			game:         &domain.Game{ID: "G1", JudgeType: domain.JudgeTypeTargetWord, JudgeCondition: "apple", JudgeStrictness: domain.JudgeStrictnessNormalized},
+			matchStatus:  domain.MatchStatusLost,
+			appealStatus: domain.AppealStatusPending,
+			req:          &domain.ResolveAppealRequest{},
+			wantStatus:   domain.MatchStatusWon,
+			wantAppeal:   domain.AppealStatusOverturned,
+		},
+		{
+			name:         "Re-judge that changes nothing upholds the verdict",
+			action:       "Rejudge",
+			game:         &domain.Game{ID: "G1", JudgeType: domain.JudgeTypeTargetWord, JudgeCondition: "apple", JudgeStrictness: domain.JudgeStrictnessExact},
+			matchStatus:  domain.MatchStatusLost,
+			appealStatus: domain.AppealStatusPending,
+			req:          &domain.ResolveAppealRequest{},
+			wantStatus:   domain.MatchStatusLost,
+			wantAppeal:   domain.AppealStatusUpheld,
+		},
+		{
+			name:         "Set the status by hand",
+			action:       "SetStatus",
+			game:         &domain.Game{ID: "G1"},
+			matchStatus:  domain.MatchStatusLost,
+			appealStatus: domain.AppealStatusPending,
+			req:          &domain.ResolveAppealRequest{Status: domain.MatchStatusWon, Reason: "The dashes spell the word."},
+			wantStatus:   domain.MatchStatusWon,
+			wantAppeal:   domain.AppealStatusOverturned,
+		},
+		{
+			name:          "Reject a status that does not end a match",
+			action:        "SetStatus",
+			game:          &domain.Game{ID: "G1"},
+			matchStatus:   domain.MatchStatusLost,
+			appealStatus:  domain.AppealStatusPending,
+			req:           &domain.ResolveAppealRequest{Status: domain.MatchStatusActive, Reason: "Let them play on."},
+			wantErr:       domain.ErrInvalidInput,
+			wantNoChanges: true,
+		},
+		{
+			name:         "Uphold the verdict",
+			action:       "Uphold",
+			game:         &domain.Game{ID: "G1"},
+			matchStatus:  domain.MatchStatusLost,
+			appealStatus: domain.AppealStatusPending,
+			req:          &domain.ResolveAppealRequest{Reason: "The word was never said."},
+			wantStatus:   domain.MatchStatusLost,
+			wantAppeal:   domain.AppealStatusUpheld,
+		},
+		{
+			name:          "Refuse an appeal that is already resolved",
+			action:        "Uphold",
+			game:          &domain.Game{ID: "G1"},
+			matchStatus:   domain.MatchStatusLost,
+			appealStatus:  domain.AppealStatusUpheld,
+			req:           &domain.ResolveAppealRequest{Reason: "Again."},
+			wantErr:       domain.ErrConflict,
+			wantNoChanges: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			appeal := &domain.Appeal{ID: appealID, MatchID: matchID, VerdictID: "V2", TurnCount: 2, Status: tt.appealStatus}
+			match := &domain.Match{ID: matchID, GameID: "G1", Status: tt.matchStatus}
+
+			resolved := *appeal
+			saved := *match
+
+			mockAppealRepo := new(mocks.AppealRepository)
+			mockAppealRepo.On("GetByID", mock.Anything, appealID).Return(appeal, nil)
+			mockAppealRepo.On("Resolve", mock.Anything, mock.AnythingOfType("*domain.Appeal")).
+				Return(func(_ context.Context, a *domain.Appeal) (*domain.Appeal, error) { resolved = *a; return a, nil }).Maybe()
+			mockMatchRepo := new(mocks.MatchRepository)
+			mockMatchRepo.On("GetByID", mock.Anything, matchID).Return(match, nil)
+			mockMatchRepo.On("CompareAndSet", mock.Anything, mock.AnythingOfType("*domain.Match"), mock.Anything).
+				Return(func(_ context.Context, m *domain.Match, _ domain.MatchStatus) (*domain.Match, error) {
+					saved = *m
+					return m, nil
+				}).Maybe()
+			mockGameRepo := new(mocks.GameRepository)
+			mockGameRepo.On("GetByID", mock.Anything, "G1").Return(tt.game, nil).Maybe()
+			mockMessageRepo := new(mocks.MessageRepository)
+			mockMessageRepo.On("GetByMatchID", mock.Anything, matchID).Return(messages, nil).Maybe()
+			mockVerdictRepo := new(mocks.TurnVerdictRepository)
+			mockVerdictRepo.On("GetByMatchID", mock.Anything, matchID).Return(verdicts, nil).Maybe()
+			mockOverrideRepo := new(mocks.MatchOverrideRepository)
+			mockOverrideRepo.On("GetByMatchID", mock.Anything, matchID).Return([]domain.MatchOverride{}, nil).Maybe()
+			mockOverrideRepo.On("Create", mock.Anything, mock.AnythingOfType("*domain.MatchOverride")).
+				Return(func(_ context.Context, o *domain.MatchOverride) (*domain.MatchOverride, error) { return o, nil }).Maybe()
+			mockRegistry := new(mocks.LLMRegistry)
+			mockRegistry.On("Judge", "").Return(new(mocks.LLMService), nil).Maybe()
+
+			uc := NewAppealUseCase(mockAppealRepo, mockOverrideRepo, mockMatchRepo, mockGameRepo, mockMessageRepo, mockVerdictRepo, mockRegistry, judge.NewRegistry(), runInTx())
+
+			var override *domain.MatchOverride
+			var err error
+			switch tt.action {
+			case "Rejudge":
+				override, err = uc.Rejudge(context.Background(), appealID, adminID, tt.req)
+			case "SetStatus":
+				override, err = uc.SetStatus(context.Background(), appealID, adminID, tt.req)
+			case "Uphold":
+				override, err = uc.Uphold(context.Background(), appealID, adminID, tt.req)
+			}
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.matchStatus, override.FromStatus)
+				assert.Equal(t, tt.wantStatus, override.ToStatus)
+				assert.Equal(t, adminID, override.AdminID)
+				assert.Equal(t, appealID, override.AppealID)
+				assert.NotEmpty(t, override.Reason)
+				assert.Equal(t, tt.wantStatus, saved.Status)
+				assert.Equal(t, tt.wantAppeal, resolved.Status)
+				assert.Equal(t, adminID, resolved.ResolvedBy)
+				mockOverrideRepo.AssertNumberOfCalls(t, "Create", 1)
+			}
+			if tt.wantNoChanges || tt.wantStatus == tt.matchStatus {
+				mockMatchRepo.AssertNotCalled(t, "CompareAndSet", mock.Anything, mock.Anything, mock.Anything)
+			}
+			if tt.wantNoChanges {
+				mockAppealRepo.AssertNotCalled(t, "Resolve", mock.Anything, mock.Anything)
+				mockOverrideRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestAppealUseCase_RejudgeTakesAwayAWin(t *testing.T) {
+	const matchID = "01HQZYX3VQJQZ3Z0Z1ZMATCH01"
+
+	appeal := &domain.Appeal{ID: "A1", MatchID: matchID, VerdictID: "V1", TurnCount: 1, Status: domain.AppealStatusPending}
+	match := &domain.Match{ID: matchID, GameID: "G1", Status: domain.MatchStatusWon, TurnCount: 1, MaxTurns: 1}
+	reply := domain.Message{ID: "M2", MatchID: matchID, Role: domain.MessageRoleAssistant, Content: "I like pineapples.", TurnCount: 1}
+
+	mockAppealRepo := new(mocks.AppealRepository)
+	mockAppealRepo.On("GetByID", mock.Anything, "A1").Return(appeal, nil)
+	mockAppealRepo.On("Resolve", mock.Anything, mock.MatchedBy(func(a *domain.Appeal) bool {
+		return a.ID == "A1" && a.Status == domain.AppealStatusOverturned
+	})).Return(appeal, nil).Once()
+	mockMatchRepo := new(mocks.MatchRepository)
+	mockMatchRepo.On("GetByID", mock.Anything, matchID).Return(match, nil)
+	mockMatchRepo.On("CompareAndSet", mock.Anything, mock.MatchedBy(func(m *domain.Match) bool {
+		return m.ID == matchID && m.Status == domain.MatchStatusLost
+	}), mock.Anything).Return(match, nil).Once()
+	mockGameRepo := new(mocks.GameRepository)
+	mockGameRepo.On("GetByID", mock.Anything, "G1").Return(&domain.Game{ID: "G1", JudgeType: domain.JudgeTypeTargetWord, JudgeCondition: "apple", JudgeStrictness: domain.JudgeStrictnessExact}, nil)
+	mockMessageRepo := new(mocks.MessageRepository)
+	mockMessageRepo.On("GetByMatchID", mock.Anything, matchID).Return([]domain.Message{reply}, nil)
+	mockVerdictRepo := new(mocks.TurnVerdictRepository)
+	mockVerdictRepo.On("GetByMatchID", mock.Anything, matchID).Return([]domain.TurnVerdict{{ID: "V1", MessageID: "M2", TurnCount: 1, Outcome: domain.JudgeOutcomeWon}}, nil)
+	mockOverrideRepo := new(mocks.MatchOverrideRepository)
+	mockOverrideRepo.On("GetByMatchID", mock.Anything, matchID).Return([]domain.MatchOverride{}, nil)
+	mockOverrideRepo.On("Create", mock.Anything, mock.AnythingOfType("*domain.MatchOverride")).
+		Return(func(_ context.Context, o *domain.MatchOverride) (*domain.MatchOverride, error) { return o, nil })
+	mockRegistry := new(mocks.LLMRegistry)
+	mockRegistry.On("Judge", "").Return(new(mocks.LLMService), nil)
+
+	uc := NewAppealUseCase(mockAppealRepo, mockOverrideRepo, mockMatchRepo, mockGameRepo, mockMessageRepo, mockVerdictRepo, mockRegistry, judge.NewRegistry(), runInTx())
+	override, err := uc.Rejudge(context.Background(), "A1", "ADMIN", &domain.ResolveAppealRequest{Reason: "pineapple is not apple"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, domain.MatchStatusWon, override.FromStatus)
+	assert.Equal(t, domain.MatchStatusLost, override.ToStatus)
+	assert.Equal(t, domain.JudgeOutcomeContinue, override.Verdict.Outcome)
+	assert.Equal(t, "pineapple is not apple", override.Reason)
+	mockAppealRepo.AssertExpectations(t)
+	mockMatchRepo.AssertExpectations(t)
+}
+
+func TestAppealUseCase_RejudgeProgress(t *testing.T) {
+	const matchID = "01HQZYX3VQJQZ3Z0Z1ZMATCH01"
+	const allOf = `{"words": ["apple", "pear"], "mode": "all"}`
+
+	messages := []domain.Message{
+		{ID: "M1", MatchID: matchID, Role: domain.MessageRoleUser, Content: "Name a fruit.", TurnCount: 1},
+		{ID: "M2", MatchID: matchID, Role: domain.MessageRoleAssistant, Content: "An apple.", TurnCount: 1},
+		{ID: "M3", MatchID: matchID, Role: domain.MessageRoleUser, Content: "Another one.", TurnCount: 2},
+		{ID: "M4", MatchID: matchID, Role: domain.MessageRoleAssistant, Content: "A p-e-a-r.", TurnCount: 2},
+	}
+	progressAfterApple := &domain.JudgeProgress{Achieved: []string{"apple"}, Total: 2}
+
+	tests := []struct {
+		name       string
+		condition  string
+		strictness domain.JudgeStrictness
+		match      *domain.Match
+		verdicts   []domain.TurnVerdict
+		wantStatus domain.MatchStatus
+		wantErr    error
+	}{
+		{
+			name:       "Count the words found before the appealed turn",
+			condition:  allOf,
+			strictness: domain.JudgeStrictnessNormalized,
+			match:      &domain.Match{ID: matchID, GameID: "G1", Status: domain.MatchStatusLost, TurnCount: 2, MaxTurns: 2, JudgeProgress: progressAfterApple},
+			verdicts: []domain.TurnVerdict{
+				{ID: "V1", MessageID: "M2", TurnCount: 1, Outcome: domain.JudgeOutcomeContinue, Progress: progressAfterApple},
+				{ID: "V2", MessageID: "M4", TurnCount: 2, Outcome: domain.JudgeOutcomeContinue, Progress: progressAfterApple},
+			},
+			wantStatus: domain.MatchStatusWon,
+		},
+		{
+			name:       "Skip turns whose judge failed",
+			condition:  allOf,
+			strictness: domain.JudgeStrictnessNormalized,
+			match:      &domain.Match{ID: matchID, GameID: "G1", Status: domain.MatchStatusLost, TurnCount: 3, MaxTurns: 3, JudgeProgress: progressAfterApple},
+			verdicts: []domain.TurnVerdict{
+				{ID: "V1", MessageID: "M2", TurnCount: 1, Outcome: domain.JudgeOutcomeContinue, Progress: progressAfterApple},
+				{ID: "V0", MessageID: "M2", TurnCount: 1, Outcome: domain.JudgeOutcomeContinue, Error: "judge model unavailable"},
+				{ID: "V2", MessageID: "M4", TurnCount: 2, Outcome: domain.JudgeOutcomeContinue, Progress: progressAfterApple},
+			},
+			wantStatus: domain.MatchStatusWon,
+		},
+		{
+			name:       "Keep a win the re-judged earlier turn lets continue",
+			condition:  allOf,
+			strictness: domain.JudgeStrictnessExact,
+			match:      &domain.Match{ID: matchID, GameID: "G1", Status: domain.MatchStatusWon, TurnCount: 3, MaxTurns: 5, JudgeProgress: progressAfterApple},
+			verdicts: []domain.TurnVerdict{
+				{ID: "V1", MessageID: "M2", TurnCount: 1, Outcome: domain.JudgeOutcomeContinue, Progress: progressAfterApple},
+				{ID: "V2", MessageID: "M4", TurnCount: 2, Outcome: domain.JudgeOutcomeContinue, Progress: progressAfterApple},
+			},
+			wantStatus: domain.MatchStatusWon,
+		},
+		{
+			name:       "Refuse to take away a win with turns left",
+			condition:  allOf,
+			strictness: domain.JudgeStrictnessExact,
+			match:      &domain.Match{ID: matchID, GameID: "G1", Status: domain.MatchStatusWon, TurnCount: 2, MaxTurns: 5, JudgeProgress: progressAfterApple},
+			verdicts: []domain.TurnVerdict{
+				{ID: "V1", MessageID: "M2", TurnCount: 1, Outcome: domain.JudgeOutcomeContinue, Progress: progressAfterApple},
+				{ID: "V2", MessageID: "M4", TurnCount: 2, Outcome: domain.JudgeOutcomeWon, Progress: &domain.JudgeProgress{Achieved: []string{"apple", "pear"}, Total: 2}},
+			},
+			wantErr: domain.ErrConflict,
+		},
+		{
+			name:       "Refuse when the earlier progress was not recorded",
+			condition:  allOf,
+			strictness: domain.JudgeStrictnessNormalized,
+			match:      &domain.Match{ID: matchID, GameID: "G1", Status: domain.MatchStatusLost, TurnCount: 2, MaxTurns: 2, JudgeProgress: progressAfterApple},
+			verdicts: []domain.TurnVerdict{
+				{ID: "V1", MessageID: "M2", TurnCount: 1, Outcome: domain.JudgeOutcomeContinue},
+				{ID: "V2", MessageID: "M4", TurnCount: 2, Outcome: domain.JudgeOutcomeContinue},
+			},
+			wantErr: domain.ErrConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			appeal := &domain.Appeal{ID: "A1", MatchID: matchID, VerdictID: "V2", TurnCount: 2, Status: domain.AppealStatusPending}
+			saved := *tt.match
+
+			mockAppealRepo := new(mocks.AppealRepository)
+			mockAppealRepo.On("GetByID", mock.Anything, "A1").Return(appeal, nil)
+			mockAppealRepo.On("Resolve", mock.Anything, mock.AnythingOfType("*domain.Appeal")).Return(appeal, nil).Maybe()
+			mockMatchRepo := new(mocks.MatchRepository)
+			mockMatchRepo.On("GetByID", mock.Anything, matchID).Return(tt.match, nil)
+			mockMatchRepo.On("CompareAndSet", mock.Anything, mock.AnythingOfType("*domain.Match"), mock.Anything).
+				Return(func(_ context.Context, m *domain.Match, _ domain.MatchStatus) (*domain.Match, error) {
+					saved = *m
+					return m, nil
+				}).Maybe()
+			mockGameRepo := new(mocks.GameRepository)
+			mockGameRepo.On("GetByID", mock.Anything, "G1").Return(&domain.Game{ID: "G1", JudgeType: domain.JudgeTypeTargetWord, JudgeCondition: tt.condition, JudgeStrictness: tt.strictness}, nil)
+			mockMessageRepo := new(mocks.MessageRepository)
+			mockMessageRepo.On("GetByMatchID", mock.Anything, matchID).Return(messages, nil)
+			mockVerdictRepo := new(mocks.TurnVerdictRepository)
+			mockVerdictRepo.On("GetByMatchID", mock.Anything, matchID).Return(tt.verdicts, nil)
+			mockOverrideRepo := new(mocks.MatchOverrideRepository)
+			mockOverrideRepo.On("GetByMatchID", mock.Anything, matchID).Return([]domain.MatchOverride{}, nil)
+			mockOverrideRepo.On("Create", mock.Anything, mock.AnythingOfType("*domain.MatchOverride")).
+				Return(func(_ context.Context, o *domain.MatchOverride) (*domain.MatchOverride, error) { return o, nil }).Maybe()
+			mockRegistry := new(mocks.LLMRegistry)
+			mockRegistry.On("Judge", "").Return(new(mocks.LLMService), nil)
+
+			uc := NewAppealUseCase(mockAppealRepo, mockOverrideRepo, mockMatchRepo, mockGameRepo, mockMessageRepo, mockVerdictRepo, mockRegistry, judge.NewRegistry(), runInTx())
+			override, err := uc.Rejudge(context.Background(), "A1", "ADMIN", &domain.ResolveAppealRequest{})
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				mockAppealRepo.AssertNotCalled(t, "Resolve", mock.Anything, mock.Anything)
+				mockMatchRepo.AssertNotCalled(t, "CompareAndSet", mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, override.ToStatus)
+			assert.Equal(t, tt.wantStatus, saved.Status)
+		})
+	}
+}
+
+func TestAppealUseCase_ResolveRollsBack(t *testing.T) {
+	const matchID = "01HQZYX3VQJQZ3Z0Z1ZMATCH01"
+
+	appeal := &domain.Appeal{ID: "A1", MatchID: matchID, VerdictID: "V1", TurnCount: 1, Status: domain.AppealStatusPending}
+	match := &domain.Match{ID: matchID, GameID: "G1", Status: domain.MatchStatusLost}
+
+	mockAppealRepo := new(mocks.AppealRepository)
+	mockAppealRepo.On("GetByID", mock.Anything, "A1").Return(appeal, nil)
+	mockAppealRepo.On("Resolve", mock.Anything, mock.AnythingOfType("*domain.Appeal")).Return(appeal, nil)
+	mockMatchRepo := new(mocks.MatchRepository)
+	mockMatchRepo.On("GetByID", mock.Anything, matchID).Return(match, nil)
+	mockMatchRepo.On("CompareAndSet", mock.Anything, mock.AnythingOfType("*domain.Match"), domain.MatchStatusLost).Return(nil, domain.ErrConflict)
+	mockOverrideRepo := new(mocks.MatchOverrideRepository)
+
+	uc := NewAppealUseCase(mockAppealRepo, mockOverrideRepo, mockMatchRepo, nil, nil, nil, nil, nil, runInTx())
+	_, err := uc.SetStatus(context.Background(), "A1", "ADMIN", &domain.ResolveAppealRequest{Status: domain.MatchStatusWon, Reason: "The dashes spell the word."})
+
+	assert.ErrorIs(t, err, domain.ErrConflict)
+	assert.Equal(t, domain.MatchStatusLost, match.Status)
+	mockOverrideRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
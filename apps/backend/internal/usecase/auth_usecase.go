@@ -8,9 +8,9 @@ import (
 	"strings"
 	"time"
 
-	"google.golang.org/api/idtoken"
 	"github.com/google/uuid"
 	"github.com/oklog/ulid/v2"
+	"google.golang.org/api/idtoken"
 
 	"github.com/everyday-studio/ollm/internal/config"
 	"github.com/everyday-studio/ollm/internal/domain"
@@ -172,7 +172,7 @@ func (uc *authUseCase) RefreshToken(ctx context.Context, refreshToken string) (*
 func (uc *authUseCase) GuestLogin(ctx context.Context) (*domain.LoginResponse, error) {
 	// Generate a unique identifier for the guest
 	guestID := ulid.Make().String()
-	email := fmt.Sprintf("guest_%s@ollm.xyz", guestID)
+	email := domain.GuestEmailPrefix + guestID + domain.GuestEmailDomain
 
 	// Generate a random dummy password and hash it
 	dummyPassword := uuid.New().String()
@@ -0,0 +1,200 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/everyday-studio/ollm/internal/domain"
+	"github.com/everyday-studio/ollm/internal/kit/prompt"
+)
+
+const (
+	maxCalibrationOutput   = 10000 // characters of an example's AI output
+	calibrationConcurrency = 4     // examples judged at once, to stay under provider rate limits
+	calibrationRunHistory  = 20    // runs listed per game
+)
+
+type calibrationUseCase struct {
+	calibrationRepo domain.CalibrationRepository
+	gameRepo        domain.GameRepository
+	llmRegistry     domain.LLMRegistry
+	judgeRegistry   domain.JudgeRegistry
+}
+
+// NewCalibrationUseCase creates a new judge calibration usecase
+func NewCalibrationUseCase(calibrationRepo domain.CalibrationRepository, gameRepo domain.GameRepository, llmRegistry domain.LLMRegistry, judgeRegistry domain.JudgeRegistry) domain.CalibrationUseCase {
+	return &calibrationUseCase{
+		calibrationRepo: calibrationRepo,
+		gameRepo:        gameRepo,
+		llmRegistry:     llmRegistry,
+		judgeRegistry:   judgeRegistry,
+	}
+}
+
+// CreateExample labels an AI output of the game with the verdict its judge should reach
+func (uc *calibrationUseCase) CreateExample(ctx context.Context, gameID string, req *domain.CreateCalibrationExampleRequest) (*domain.CalibrationExample, error) {
+	if strings.TrimSpace(req.Output) == "" || len([]rune(req.Output)) > maxCalibrationOutput {
+		return nil, fmt.Errorf("%w: output must be between 1 and %d characters", domain.ErrInvalidInput, maxCalibrationOutput)
+	}
+	if req.Expected != domain.JudgeOutcomeWon && req.Expected != domain.JudgeOutcomeContinue {
+		return nil, fmt.Errorf("%w: expected verdict must be won or continue", domain.ErrInvalidInput)
+	}
+
+	if _, err := uc.gameRepo.GetByID(ctx, gameID); err != nil {
+		return nil, err
+	}
+
+	return uc.calibrationRepo.CreateExample(ctx, &domain.CalibrationExample{
+		GameID:   gameID,
+		Output:   req.Output,
+		Expected: req.Expected,
+		Note:     strings.TrimSpace(req.Note),
+	})
+}
+
+// GetExamples returns the labeled examples of the game, oldest first
+func (uc *calibrationUseCase) GetExamples(ctx context.Context, gameID string) ([]domain.CalibrationExample, error) {
+	return uc.calibrationRepo.GetExamplesByGameID(ctx, gameID)
+}
+
+// DeleteExample removes a labeled example from the game
+func (uc *calibrationUseCase) DeleteExample(ctx context.Context, gameID, id string) error {
+	return uc.calibrationRepo.DeleteExample(ctx, gameID, id)
+}
+
+// Run judges every example of the game with its judge, or with the candidate settings of the request, and stores the report.
+// A judge that fails on an example is recorded against that example; the run itself only fails if it cannot start.
+func (uc *calibrationUseCase) Run(ctx context.Context, gameID string, req *domain.RunCalibrationRequest) (*domain.CalibrationRun, error) {
+	game, err := uc.gameRepo.GetByID(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	config := domain.CalibrationJudge{
+		Type:       game.JudgeType,
+		Condition:  game.JudgeCondition,
+		Model:      game.JudgeModel,
+		Panel:      game.JudgePanel,
+		Policy:     game.JudgePolicy,
+		Quorum:     game.JudgeQuorum,
+		Strictness: game.JudgeStrictness,
+		Scope:      game.JudgeScope,
+		ScopeTurns: game.JudgeScopeTurns,
+	}
+	if req != nil {
+		if req.JudgeType != nil {
+			config.Type = *req.JudgeType
+		}
+		if req.JudgeCondition != nil {
+			config.Condition = *req.JudgeCondition
+		}
+		if req.JudgeModel != nil {
+			config.Model = *req.JudgeModel
+		}
+		// The game's panel would outvote a candidate judge, so it is judged alone unless given its own panel
+		if req.JudgeType != nil || req.JudgeModel != nil {
+			config.Panel, config.Policy, config.Quorum = nil, "", 0
+		}
+		if req.JudgePanel != nil {
+			config.Panel = *req.JudgePanel
+		}
+		if req.JudgeStrictness != nil {
+			config.Strictness = *req.JudgeStrictness
+		}
+	}
+	if err := validateJudgePanel(&domain.Game{JudgePanel: config.Panel, JudgePolicy: config.Policy, JudgeQuorum: config.Quorum}); err != nil {
+		return nil, err
+	}
+
+	// Examples are labeled against one secret, so a condition filled in per match can't be scored
+	if prompt.HasVariables(config.Condition) {
+		return nil, fmt.Errorf("%w: the judge condition uses match secrets, calibrate with a concrete condition", domain.ErrInvalidInput)
+	}
+	if err := uc.judgeRegistry.Validate(config.Type, config.Condition); err != nil {
+		return nil, err
+	}
+	judge, err := uc.judgeRegistry.Get(config.Type)
+	if err != nil {
+		return nil, err
+	}
+	judgeLLM, err := uc.llmRegistry.Judge(config.Model)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve judge model: %w", err)
+	}
+	panel, err := resolveJudgePanel(uc.llmRegistry, config.Panel)
+	if err != nil {
+		return nil, err
+	}
+
+	examples, err := uc.calibrationRepo.GetExamplesByGameID(ctx, gameID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get calibration examples: %w", err)
+	}
+	if len(examples) == 0 {
+		return nil, fmt.Errorf("%w: the game has no calibration examples", domain.ErrInvalidInput)
+	}
+
+	results := make([]domain.CalibrationResult, len(examples))
+	var eg errgroup.Group
+	eg.SetLimit(calibrationConcurrency)
+	for i, example := range examples {
+		eg.Go(func() error {
+			reply := &domain.Message{Role: domain.MessageRoleAssistant, Content: example.Output, IsVisible: true, TurnCount: 1}
+			results[i] = domain.CalibrationResult{ExampleID: example.ID, Expected: example.Expected}
+
+			verdict, err := judge.Evaluate(ctx, domain.JudgeInput{
+				Condition:  config.Condition,
+				Reply:      reply,
+				History:    []domain.Message{*reply},
+				LLM:        judgeLLM,
+				Panel:      panel,
+				Policy:     config.Policy,
+				Quorum:     config.Quorum,
+				Strictness: config.Strictness,
+				Scope:      config.Scope,
+				ScopeTurns: config.ScopeTurns,
+			})
+			if err != nil {
+				results[i].Error = err.Error()
+				return nil
+			}
+			results[i].Outcome = verdict.Outcome
+			results[i].Reason = verdict.Reason
+			results[i].PromptTokens = verdict.PromptTokens
+			results[i].CompletionTokens = verdict.CompletionTokens
+			return nil
+		})
+	}
+	_ = eg.Wait()
+
+	run := &domain.CalibrationRun{
+		GameID:  gameID,
+		Judge:   config,
+		Total:   len(results),
+		Results: results,
+	}
+	for _, r := range results {
+		run.PromptTokens += r.PromptTokens
+		run.CompletionTokens += r.CompletionTokens
+		switch {
+		case r.Error != "":
+			run.Errors++
+		case r.Correct():
+			run.Correct++
+		case r.Outcome == domain.JudgeOutcomeWon:
+			run.FalsePositives++
+		default:
+			run.FalseNegatives++
+		}
+	}
+
+	return uc.calibrationRepo.CreateRun(ctx, run)
+}
+
+// GetRuns returns the latest calibration runs of the game, newest first
+func (uc *calibrationUseCase) GetRuns(ctx context.Context, gameID string) ([]domain.CalibrationRun, error) {
+	return uc.calibrationRepo.GetRunsByGameID(ctx, gameID, calibrationRunHistory)
+}
@@ -0,0 +1,217 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/everyday-studio/ollm/internal/domain"
+	"github.com/everyday-studio/ollm/internal/domain/mocks"
+	"github.com/everyday-studio/ollm/internal/kit/judge"
+)
+
+func TestCalibrationUseCase_Run(t *testing.T) {
+	const gameID = "01HQZYX3VQJQZ3Z0ZGAME1"
+	examples := []domain.CalibrationExample{
+		{ID: "EX1", GameID: gameID, Output: "It is an apple.", Expected: domain.JudgeOutcomeWon},
+		{ID: "EX2", GameID: gameID, Output: "I will not say it.", Expected: domain.JudgeOutcomeContinue},
+		{ID: "EX3", GameID: gameID, Output: "I refuse to say apple.", Expected: domain.JudgeOutcomeContinue}, // the judge wins on it
+		{ID: "EX4", GameID: gameID, Output: "A red fruit, that one.", Expected: domain.JudgeOutcomeWon},      // the judge misses it
+	}
+	candidate := `{"words": ["apple", "red fruit"], "mode": "any"}`
+	empty := ""
+
+	tests := []struct {
+		name               string
+		req                *domain.RunCalibrationRequest
+		examples           []domain.CalibrationExample
+		wantCondition      string
+		wantCorrect        int
+		wantFalsePositives int
+		wantFalseNegatives int
+		wantErr            error
+	}{
+		{
+			name:               "Report the accuracy of the game's judge",
+			examples:           examples,
+			wantCondition:      "apple",
+			wantCorrect:        2,
+			wantFalsePositives: 1,
+			wantFalseNegatives: 1,
+		},
+		{
+			name:               "Try a candidate condition",
+			req:                &domain.RunCalibrationRequest{JudgeCondition: &candidate},
+			examples:           examples,
+			wantCondition:      candidate,
+			wantCorrect:        3,
+			wantFalsePositives: 1,
+		},
+		{
+			name:     "Reject an invalid candidate condition",
+			req:      &domain.RunCalibrationRequest{JudgeCondition: &empty},
+			examples: examples,
+			wantErr:  domain.ErrInvalidInput,
+		},
+		{
+			name:     "Refuse to run without examples",
+			examples: []domain.CalibrationExample{},
+			wantErr:  domain.ErrInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockGameRepo := new(mocks.GameRepository)
+			mockGameRepo.On("GetByID", mock.Anything, gameID).Return(&domain.Game{
+				ID:             gameID,
+				JudgeType:      domain.JudgeTypeTargetWord,
+				JudgeCondition: "apple",
+			}, nil)
+
+			mockRegistry := new(mocks.LLMRegistry)
+			mockRegistry.On("Judge", "").Return(new(mocks.LLMService), nil).Maybe()
+
+			mockRepo := new(mocks.CalibrationRepository)
+			mockRepo.On("GetExamplesByGameID", mock.Anything, gameID).Return(tt.examples, nil).Maybe()
+			mockRepo.On("CreateRun", mock.Anything, mock.AnythingOfType("*domain.CalibrationRun")).
+				Return(func(_ context.Context, run *domain.CalibrationRun) (*domain.CalibrationRun, error) { return run, nil }).Maybe()
+
+			uc := NewCalibrationUseCase(mockRepo, mockGameRepo, mockRegistry, judge.NewRegistry())
+			run, err := uc.Run(context.Background(), gameID, tt.req)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				mockRepo.AssertNotCalled(t, "CreateRun", mock.Anything, mock.Anything)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantCondition, run.Judge.Condition)
+			assert.Equal(t, len(tt.examples), run.Total)
+			assert.Equal(t, tt.wantCorrect, run.Correct)
+			assert.Equal(t, tt.wantFalsePositives, run.FalsePositives)
+			assert.Equal(t, tt.wantFalseNegatives, run.FalseNegatives)
+			assert.Zero(t, run.Errors)
+			assert.InDelta(t, float64(tt.wantCorrect)/float64(len(tt.examples)), run.Accuracy(), 1e-9)
+			if assert.Len(t, run.Results, len(tt.examples)) {
+				assert.Equal(t, "EX1", run.Results[0].ExampleID)
+			}
+		})
+	}
+}
+
+func TestCalibrationUseCase_CreateExample(t *testing.T) {
+	const gameID = "01HQZYX3VQJQZ3Z0ZGAME1"
+
+	tests := []struct {
+		name    string
+		req     *domain.CreateCalibrationExampleRequest
+		wantErr error
+	}{
+		{
+			name: "Label an output",
+			req:  &domain.CreateCalibrationExampleRequest{Output: "It is an apple.", Expected: domain.JudgeOutcomeWon, Note: "  plain  "},
+		},
+		{
+			name:    "Reject an empty output",
+			req:     &domain.CreateCalibrationExampleRequest{Output: "  ", Expected: domain.JudgeOutcomeWon},
+			wantErr: domain.ErrInvalidInput,
+		},
+		{
+			name:    "Reject an unknown verdict",
+			req:     &domain.CreateCalibrationExampleRequest{Output: "It is an apple.", Expected: "lost"},
+			wantErr: domain.ErrInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockGameRepo := new(mocks.GameRepository)
+			mockGameRepo.On("GetByID", mock.Anything, gameID).Return(&domain.Game{ID: gameID}, nil).Maybe()
+
+			mockRepo := new(mocks.CalibrationRepository)
+			mockRepo.On("CreateExample", mock.Anything, mock.AnythingOfType("*domain.CalibrationExample")).
+				Return(func(_ context.Context, e *domain.CalibrationExample) (*domain.CalibrationExample, error) {
+					return e, nil
+				}).Maybe()
+
+			uc := NewCalibrationUseCase(mockRepo, mockGameRepo, nil, judge.NewRegistry())
+			example, err := uc.CreateExample(context.Background(), gameID, tt.req)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				mockRepo.AssertNotCalled(t, "CreateExample", mock.Anything, mock.Anything)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, gameID, example.GameID)
+			assert.Equal(t, "plain", example.Note)
+		})
+	}
+}
+
+func TestCalibrationUseCase_Run_JudgeSettings(t *testing.T) {
+	const gameID = "01HQZYX3VQJQZ3Z0ZGAME1"
+	candidateModel := "candidate"
+	candidatePanel := []string{"candidate", "llama-3"}
+
+	tests := []struct {
+		name       string
+		req        *domain.RunCalibrationRequest
+		wantPanel  []string
+		wantPolicy domain.JudgePolicy
+	}{
+		{
+			name:       "Judge with the game's panel and scope",
+			wantPanel:  []string{"gpt-4o", "llama-3"},
+			wantPolicy: domain.JudgePolicyUnanimous,
+		},
+		{
+			name: "Judge a candidate model alone",
+			req:  &domain.RunCalibrationRequest{JudgeModel: &candidateModel},
+		},
+		{
+			name:      "Judge a candidate model with a candidate panel",
+			req:       &domain.RunCalibrationRequest{JudgeModel: &candidateModel, JudgePanel: &candidatePanel},
+			wantPanel: candidatePanel,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockGameRepo := new(mocks.GameRepository)
+			mockGameRepo.On("GetByID", mock.Anything, gameID).Return(&domain.Game{
+				ID:              gameID,
+				JudgeType:       domain.JudgeTypeTargetWord,
+				JudgeCondition:  "apple",
+				JudgePanel:      []string{"gpt-4o", "llama-3"},
+				JudgePolicy:     domain.JudgePolicyUnanimous,
+				JudgeScope:      domain.JudgeScopeLastTurns,
+				JudgeScopeTurns: 3,
+			}, nil)
+
+			mockRegistry := new(mocks.LLMRegistry)
+			mockRegistry.On("Judge", mock.Anything).Return(new(mocks.LLMService), nil)
+
+			mockRepo := new(mocks.CalibrationRepository)
+			mockRepo.On("GetExamplesByGameID", mock.Anything, gameID).Return([]domain.CalibrationExample{
+				{ID: "EX1", GameID: gameID, Output: "It is an apple.", Expected: domain.JudgeOutcomeWon},
+			}, nil)
+			mockRepo.On("CreateRun", mock.Anything, mock.AnythingOfType("*domain.CalibrationRun")).
+				Return(func(_ context.Context, run *domain.CalibrationRun) (*domain.CalibrationRun, error) { return run, nil })
+
+			uc := NewCalibrationUseCase(mockRepo, mockGameRepo, mockRegistry, judge.NewRegistry())
+			run, err := uc.Run(context.Background(), gameID, tt.req)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantPanel, run.Judge.Panel)
+			assert.Equal(t, tt.wantPolicy, run.Judge.Policy)
+			assert.Equal(t, domain.JudgeScopeLastTurns, run.Judge.Scope)
+			assert.Equal(t, 3, run.Judge.ScopeTurns)
+			// Only the judge model and the panel of the run are resolved
+			mockRegistry.AssertNumberOfCalls(t, "Judge", 1+len(tt.wantPanel))
+		})
+	}
+}
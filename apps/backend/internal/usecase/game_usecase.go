@@ -3,21 +3,138 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
 
 	"github.com/everyday-studio/ollm/internal/domain"
+	"github.com/everyday-studio/ollm/internal/kit/prompt"
 )
 
 type gameUseCase struct {
-	gameRepo domain.GameRepository
+	gameRepo      domain.GameRepository
+	llmRegistry   domain.LLMRegistry
+	judgeRegistry domain.JudgeRegistry
 }
 
 // NewGameUseCase creates a new game use case
-func NewGameUseCase(gameRepo domain.GameRepository) domain.GameUseCase {
+func NewGameUseCase(gameRepo domain.GameRepository, llmRegistry domain.LLMRegistry, judgeRegistry domain.JudgeRegistry) domain.GameUseCase {
 	return &gameUseCase{
-		gameRepo: gameRepo,
+		gameRepo:      gameRepo,
+		llmRegistry:   llmRegistry,
+		judgeRegistry: judgeRegistry,
 	}
 }
 
+// maxJudgeScopeTurns caps the last N turns scope; longer windows are better served by the transcript scope
+const maxJudgeScopeTurns = 50
+
+// validateJudge checks that the game's judge type is registered and its condition is valid for the judge
+func (uc *gameUseCase) validateJudge(game *domain.Game) error {
+	switch game.JudgeStrictness {
+	case "", domain.JudgeStrictnessExact, domain.JudgeStrictnessNormalized, domain.JudgeStrictnessDecoded:
+	default:
+		return fmt.Errorf("%w: unknown judge strictness %q", domain.ErrInvalidInput, game.JudgeStrictness)
+	}
+
+	switch game.JudgeScope {
+	case "", domain.JudgeScopeLastReply, domain.JudgeScopeTranscript:
+	case domain.JudgeScopeLastTurns:
+		if game.JudgeScopeTurns < 1 || game.JudgeScopeTurns > maxJudgeScopeTurns {
+			return fmt.Errorf("%w: judge scope turns must be between 1 and %d", domain.ErrInvalidInput, maxJudgeScopeTurns)
+		}
+	default:
+		return fmt.Errorf("%w: unknown judge scope %q", domain.ErrInvalidInput, game.JudgeScope)
+	}
+
+	// A templated condition is checked the way a match will see it, with secrets drawn from the game's generators
+	sample, err := prompt.GenerateSecrets(game.Secrets)
+	if err != nil {
+		return err
+	}
+	if err := uc.judgeRegistry.Validate(game.JudgeType, prompt.RenderCondition(game.JudgeType, game.JudgeCondition, sample)); err != nil {
+		return err
+	}
+	if !prompt.HasVariables(game.JudgeCondition) {
+		return nil
+	}
+
+	// Every word and option a match can draw must make a valid condition, not just the one drawn above
+	for _, name := range slices.Sorted(maps.Keys(game.Secrets)) {
+		generator := game.Secrets[name]
+		for _, value := range slices.Concat(generator.Words, generator.Options) {
+			vars := maps.Clone(sample)
+			vars[name] = value
+			if err := uc.judgeRegistry.Validate(game.JudgeType, prompt.RenderCondition(game.JudgeType, game.JudgeCondition, vars)); err != nil {
+				return fmt.Errorf("%w (secret %s = %q)", err, name, value)
+			}
+		}
+	}
+	return nil
+}
+
+// validateSecrets checks the game's secret generators, which must not shadow the variables every match has
+func validateSecrets(game *domain.Game) error {
+	for name := range firstMessageVars(&domain.User{}, game, nil) {
+		if _, ok := game.Secrets[name]; ok {
+			return fmt.Errorf("%w: secret name %q is reserved", domain.ErrInvalidInput, name)
+		}
+	}
+	return prompt.ValidateSecrets(game.Secrets)
+}
+
+// validateModelSettings checks the per-game LLM settings against the configured models
+func (uc *gameUseCase) validateModelSettings(game *domain.Game) error {
+	if game.Temperature != nil && (*game.Temperature < 0 || *game.Temperature > 2) {
+		return fmt.Errorf("%w: temperature must be between 0 and 2", domain.ErrInvalidInput)
+	}
+	if game.MaxTokens < 0 {
+		return fmt.Errorf("%w: max_tokens must not be negative", domain.ErrInvalidInput)
+	}
+	if game.IdleTTLMinutes < 0 {
+		return fmt.Errorf("%w: idle_ttl_minutes must not be negative", domain.ErrInvalidInput)
+	}
+
+	models := uc.llmRegistry.Models()
+	if game.ChatModel != "" && !slices.Contains(models, game.ChatModel) {
+		return fmt.Errorf("%w: unknown chat model %q", domain.ErrInvalidInput, game.ChatModel)
+	}
+	if game.JudgeModel != "" && !slices.Contains(models, game.JudgeModel) {
+		return fmt.Errorf("%w: unknown judge model %q", domain.ErrInvalidInput, game.JudgeModel)
+	}
+	for _, model := range game.JudgePanel {
+		if !slices.Contains(models, model) {
+			return fmt.Errorf("%w: unknown judge panel model %q", domain.ErrInvalidInput, model)
+		}
+	}
+	return nil
+}
+
+// maxJudgePanel caps the consensus panel, since every panelist is an LLM call on every turn
+const maxJudgePanel = 5
+
+// validateJudgePanel checks the consensus panel of the game and the policy its votes are decided by
+func validateJudgePanel(game *domain.Game) error {
+	if len(game.JudgePanel) > maxJudgePanel {
+		return fmt.Errorf("%w: judge panel must not have more than %d models", domain.ErrInvalidInput, maxJudgePanel)
+	}
+	for i, model := range game.JudgePanel {
+		if slices.Contains(game.JudgePanel[:i], model) {
+			return fmt.Errorf("%w: judge panel lists model %q twice", domain.ErrInvalidInput, model)
+		}
+	}
+
+	switch game.JudgePolicy {
+	case "", domain.JudgePolicyMajority, domain.JudgePolicyUnanimous:
+	case domain.JudgePolicyQuorum:
+		if game.JudgeQuorum < 1 || game.JudgeQuorum > len(game.JudgePanel) {
+			return fmt.Errorf("%w: judge quorum must be between 1 and the size of the judge panel", domain.ErrInvalidInput)
+		}
+	default:
+		return fmt.Errorf("%w: unknown judge policy %q", domain.ErrInvalidInput, game.JudgePolicy)
+	}
+	return nil
+}
+
 // Create creates a new game with the provided request data
 func (uc *gameUseCase) Create(ctx context.Context, req *domain.CreateGameRequest) (*domain.Game, error) {
 	maxTurns := req.MaxTurns
@@ -25,17 +142,47 @@ func (uc *gameUseCase) Create(ctx context.Context, req *domain.CreateGameRequest
 		maxTurns = 5 // Default to 5 turns if not specified
 	}
 
+	judgeType := req.JudgeType
+	if judgeType == "" {
+		judgeType = domain.JudgeTypeTargetWord
+	}
+
 	game := &domain.Game{
-		Title:          req.Title,
-		Description:    req.Description,
-		AuthorID:       req.AuthorID,
-		Status:         domain.GameStatusActive,
-		IsPublic:       true,
-		SystemPrompt:   req.SystemPrompt,
-		FirstMessage:   req.FirstMessage,
-		JudgeType:      req.JudgeType,
-		JudgeCondition: req.JudgeCondition,
-		MaxTurns:       maxTurns,
+		Title:           req.Title,
+		Description:     req.Description,
+		AuthorID:        req.AuthorID,
+		Status:          domain.GameStatusActive,
+		IsPublic:        true,
+		SystemPrompt:    req.SystemPrompt,
+		FirstMessage:    req.FirstMessage,
+		JudgeType:       judgeType,
+		JudgeCondition:  req.JudgeCondition,
+		MaxTurns:        maxTurns,
+		ChatModel:       req.ChatModel,
+		JudgeModel:      req.JudgeModel,
+		JudgePanel:      req.JudgePanel,
+		JudgePolicy:     req.JudgePolicy,
+		JudgeQuorum:     req.JudgeQuorum,
+		JudgeStrictness: req.JudgeStrictness,
+		JudgeScope:      req.JudgeScope,
+		JudgeScopeTurns: req.JudgeScopeTurns,
+		Temperature:     req.Temperature,
+		MaxTokens:       req.MaxTokens,
+		Secrets:         req.Secrets,
+		IdleTTLMinutes:  req.IdleTTLMinutes,
+	}
+
+	if err := uc.validateModelSettings(game); err != nil {
+		return nil, err
+	}
+	if err := validateSecrets(game); err != nil {
+		return nil, err
+	}
+	if err := uc.validateJudge(game); err != nil {
+		return nil, err
+	}
+	if err := validateJudgePanel(game); err != nil {
+		return nil, err
 	}
 
 	createdGame, err := uc.gameRepo.Create(ctx, game)
@@ -130,6 +277,69 @@ func (uc *gameUseCase) Update(ctx context.Context, id string, req *domain.Update
 		existingGame.MaxTurns = *req.MaxTurns
 	}
 
+	if req.ChatModel != nil {
+		existingGame.ChatModel = *req.ChatModel
+	}
+
+	if req.JudgeModel != nil {
+		existingGame.JudgeModel = *req.JudgeModel
+	}
+
+	if req.JudgePanel != nil {
+		existingGame.JudgePanel = *req.JudgePanel
+	}
+
+	if req.JudgePolicy != nil {
+		existingGame.JudgePolicy = *req.JudgePolicy
+	}
+
+	if req.JudgeQuorum != nil {
+		existingGame.JudgeQuorum = *req.JudgeQuorum
+	}
+
+	if req.JudgeStrictness != nil {
+		existingGame.JudgeStrictness = *req.JudgeStrictness
+	}
+
+	if req.JudgeScope != nil {
+		existingGame.JudgeScope = *req.JudgeScope
+	}
+
+	if req.JudgeScopeTurns != nil {
+		existingGame.JudgeScopeTurns = *req.JudgeScopeTurns
+	}
+
+	if req.Temperature != nil {
+		existingGame.Temperature = req.Temperature
+	} else if req.ClearTemperature {
+		existingGame.Temperature = nil
+	}
+
+	if req.MaxTokens != nil {
+		existingGame.MaxTokens = *req.MaxTokens
+	}
+
+	if req.Secrets != nil {
+		existingGame.Secrets = *req.Secrets
+	}
+
+	if req.IdleTTLMinutes != nil {
+		existingGame.IdleTTLMinutes = *req.IdleTTLMinutes
+	}
+
+	if err := uc.validateModelSettings(existingGame); err != nil {
+		return nil, err
+	}
+	if err := validateSecrets(existingGame); err != nil {
+		return nil, err
+	}
+	if err := uc.validateJudge(existingGame); err != nil {
+		return nil, err
+	}
+	if err := validateJudgePanel(existingGame); err != nil {
+		return nil, err
+	}
+
 	updatedGame, err := uc.gameRepo.Update(ctx, existingGame)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update game: %w", err)
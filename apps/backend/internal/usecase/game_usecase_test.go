@@ -9,8 +9,28 @@ import (
 
 	"github.com/everyday-studio/ollm/internal/domain"
 	"github.com/everyday-studio/ollm/internal/domain/mocks"
+	"github.com/everyday-studio/ollm/internal/kit/judge"
 )
 
+// anyModel returns an LLM registry that serves the models the game tests configure.
+func anyModel() *mocks.LLMRegistry {
+	m := new(mocks.LLMRegistry)
+	m.On("Models").Return([]string{"gpt-4o", "gpt-4o-mini"}).Maybe()
+	return m
+}
+
+// acceptJudges returns a judge registry that accepts every judge type and condition.
+func acceptJudges() *mocks.JudgeRegistry {
+	m := new(mocks.JudgeRegistry)
+	m.On("Validate", mock.Anything, mock.Anything).Return(nil).Maybe()
+	return m
+}
+
+// temperature returns a pointer to a game temperature.
+func temperature(v float64) *float64 {
+	return &v
+}
+
 func TestGameUseCase_Create(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -66,7 +86,7 @@ func TestGameUseCase_Create(t *testing.T) {
 			// Use mock.Anything for the game argument because UseCase constructs it internally
 			mockRepo.On("Create", mock.Anything, mock.AnythingOfType("*domain.Game")).Return(tt.mockReturn, tt.mockError)
 
-			uc := NewGameUseCase(mockRepo)
+			uc := NewGameUseCase(mockRepo, anyModel(), acceptJudges())
 			ctx := context.Background()
 			result, err := uc.Create(ctx, tt.req)
 
@@ -83,6 +103,295 @@ func TestGameUseCase_Create(t *testing.T) {
 	}
 }
 
+func TestGameUseCase_Create_ModelSettings(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *domain.CreateGameRequest
+		wantErr error
+	}{
+		{
+			name: "Accept configured models",
+			req: &domain.CreateGameRequest{
+				Title:       "Adventure Quest",
+				ChatModel:   "gpt-4o-mini",
+				JudgeModel:  "gpt-4o",
+				Temperature: temperature(0.9),
+				MaxTokens:   300,
+			},
+			wantErr: nil,
+		},
+		{
+			name:    "Accept deterministic temperature",
+			req:     &domain.CreateGameRequest{Title: "Adventure Quest", Temperature: temperature(0)},
+			wantErr: nil,
+		},
+		{
+			name:    "Reject unknown chat model",
+			req:     &domain.CreateGameRequest{Title: "Adventure Quest", ChatModel: "gpt-2"},
+			wantErr: domain.ErrInvalidInput,
+		},
+		{
+			name:    "Reject unknown judge model",
+			req:     &domain.CreateGameRequest{Title: "Adventure Quest", JudgeModel: "gpt-2"},
+			wantErr: domain.ErrInvalidInput,
+		},
+		{
+			name:    "Reject temperature out of range",
+			req:     &domain.CreateGameRequest{Title: "Adventure Quest", Temperature: temperature(2.5)},
+			wantErr: domain.ErrInvalidInput,
+		},
+		{
+			name:    "Reject negative max tokens",
+			req:     &domain.CreateGameRequest{Title: "Adventure Quest", MaxTokens: -1},
+			wantErr: domain.ErrInvalidInput,
+		},
+		{
+			name:    "Reject negative idle TTL",
+			req:     &domain.CreateGameRequest{Title: "Adventure Quest", IdleTTLMinutes: -5},
+			wantErr: domain.ErrInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(mocks.GameRepository)
+			mockRegistry := new(mocks.LLMRegistry)
+			mockRegistry.On("Models").Return([]string{"gpt-4o", "gpt-4o-mini"}).Maybe()
+			if tt.wantErr == nil {
+				mockRepo.On("Create", mock.Anything, mock.AnythingOfType("*domain.Game")).
+					Return(func(_ context.Context, g *domain.Game) (*domain.Game, error) { return g, nil })
+			}
+
+			uc := NewGameUseCase(mockRepo, mockRegistry, acceptJudges())
+			result, err := uc.Create(context.Background(), tt.req)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.req.ChatModel, result.ChatModel)
+				assert.Equal(t, tt.req.JudgeModel, result.JudgeModel)
+				assert.Equal(t, tt.req.Temperature, result.Temperature)
+				assert.Equal(t, tt.req.MaxTokens, result.MaxTokens)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGameUseCase_Create_Judge(t *testing.T) {
+	tests := []struct {
+		name     string
+		req      *domain.CreateGameRequest
+		wantType domain.JudgeType
+		wantErr  error
+	}{
+		{
+			name:     "Accept a registered judge",
+			req:      &domain.CreateGameRequest{Title: "Adventure Quest", JudgeType: domain.JudgeTypeLLMJudge, JudgeCondition: "the AI says the password"},
+			wantType: domain.JudgeTypeLLMJudge,
+		},
+		{
+			name:     "Default to the target word judge",
+			req:      &domain.CreateGameRequest{Title: "Adventure Quest", JudgeCondition: "apple"},
+			wantType: domain.JudgeTypeTargetWord,
+		},
+		{
+			name:     "Accept a judge strictness",
+			req:      &domain.CreateGameRequest{Title: "Adventure Quest", JudgeCondition: "apple", JudgeStrictness: domain.JudgeStrictnessDecoded},
+			wantType: domain.JudgeTypeTargetWord,
+		},
+		{
+			name:    "Reject an unknown judge strictness",
+			req:     &domain.CreateGameRequest{Title: "Adventure Quest", JudgeCondition: "apple", JudgeStrictness: "paranoid"},
+			wantErr: domain.ErrInvalidInput,
+		},
+		{
+			name:    "Reject an unknown judge type",
+			req:     &domain.CreateGameRequest{Title: "Adventure Quest", JudgeType: "coin_flip", JudgeCondition: "heads"},
+			wantErr: domain.ErrInvalidInput,
+		},
+		{
+			name:    "Reject an empty judge condition",
+			req:     &domain.CreateGameRequest{Title: "Adventure Quest", JudgeType: domain.JudgeTypeTargetWord},
+			wantErr: domain.ErrInvalidInput,
+		},
+		{
+			name: "Accept a last turns judge scope",
+			req: &domain.CreateGameRequest{Title: "Adventure Quest", JudgeType: domain.JudgeTypeLLMJudge, JudgeCondition: "the AI breaks three rules",
+				JudgeScope: domain.JudgeScopeLastTurns, JudgeScopeTurns: 3},
+			wantType: domain.JudgeTypeLLMJudge,
+		},
+		{
+			name: "Reject a last turns judge scope without turns",
+			req: &domain.CreateGameRequest{Title: "Adventure Quest", JudgeType: domain.JudgeTypeLLMJudge, JudgeCondition: "the AI breaks three rules",
+				JudgeScope: domain.JudgeScopeLastTurns},
+			wantErr: domain.ErrInvalidInput,
+		},
+		{
+			name:    "Reject an unknown judge scope",
+			req:     &domain.CreateGameRequest{Title: "Adventure Quest", JudgeCondition: "apple", JudgeScope: "everything"},
+			wantErr: domain.ErrInvalidInput,
+		},
+		{
+			name: "Accept a composite condition",
+			req: &domain.CreateGameRequest{Title: "Adventure Quest", JudgeType: domain.JudgeTypeComposite,
+				JudgeCondition: `{"op": "and", "args": [{"type": "target_word", "condition": "apple"}, {"type": "regex", "condition": "^\\{"}]}`},
+			wantType: domain.JudgeTypeComposite,
+		},
+		{
+			name: "Reject a composite condition with an invalid leaf",
+			req: &domain.CreateGameRequest{Title: "Adventure Quest", JudgeType: domain.JudgeTypeComposite,
+				JudgeCondition: `{"op": "or", "args": [{"type": "llm_judge", "condition": "the AI breaks its persona"}, {"type": "regex", "condition": "[a-z"}]}`},
+			wantErr: domain.ErrInvalidInput,
+		},
+		{
+			name: "Accept a condition filled from match secrets",
+			req: &domain.CreateGameRequest{Title: "Adventure Quest", JudgeCondition: `{"words": ["{{password}}"], "mode": "any"}`,
+				Secrets: map[string]domain.SecretGenerator{"password": {Type: domain.SecretTypeWord, Words: []string{"apple", "banana"}}}},
+			wantType: domain.JudgeTypeTargetWord,
+		},
+		{
+			name: "Reject a condition that one of the secret's words breaks",
+			req: &domain.CreateGameRequest{Title: "Adventure Quest", JudgeCondition: `{"words": ["{{password}}"], "mode": "any"}`,
+				Secrets: map[string]domain.SecretGenerator{"password": {Type: domain.SecretTypeWord, Words: []string{"apple", "banana", " ", "cherry"}}}},
+			wantErr: domain.ErrInvalidInput,
+		},
+		{
+			name: "Accept a regex filled with secrets that have special characters",
+			req: &domain.CreateGameRequest{Title: "Adventure Quest", JudgeType: domain.JudgeTypeRegex, JudgeCondition: `(?i)\b{{password}}\b`,
+				Secrets: map[string]domain.SecretGenerator{"password": {Type: domain.SecretTypeChoice, Options: []string{"c++", "(x", "[a-z"}}}},
+			wantType: domain.JudgeTypeRegex,
+		},
+		{
+			name: "Accept a JSON schema filled with secrets that have quotes",
+			req: &domain.CreateGameRequest{Title: "Adventure Quest", JudgeType: domain.JudgeTypeJSONSchema, JudgeCondition: `{"type": "object", "properties": {"code": {"const": "{{password}}"}}}`,
+				Secrets: map[string]domain.SecretGenerator{"password": {Type: domain.SecretTypeWord, Words: []string{`say "please"`, `back\slash`}}}},
+			wantType: domain.JudgeTypeJSONSchema,
+		},
+		{
+			name: "Reject a secret named like a built-in variable",
+			req: &domain.CreateGameRequest{Title: "Adventure Quest", JudgeCondition: "{{nickname}}",
+				Secrets: map[string]domain.SecretGenerator{"nickname": {Type: domain.SecretTypeWord, Words: []string{"apple"}}}},
+			wantErr: domain.ErrInvalidInput,
+		},
+		{
+			name: "Reject a secret that can't be drawn",
+			req: &domain.CreateGameRequest{Title: "Adventure Quest", JudgeCondition: "{{pin}}",
+				Secrets: map[string]domain.SecretGenerator{"pin": {Type: domain.SecretTypeCode}}},
+			wantErr: domain.ErrInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(mocks.GameRepository)
+			if tt.wantErr == nil {
+				mockRepo.On("Create", mock.Anything, mock.AnythingOfType("*domain.Game")).
+					Return(func(_ context.Context, g *domain.Game) (*domain.Game, error) { return g, nil })
+			}
+
+			uc := NewGameUseCase(mockRepo, anyModel(), judge.NewRegistry())
+			result, err := uc.Create(context.Background(), tt.req)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantType, result.JudgeType)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGameUseCase_Create_JudgePanel(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *domain.CreateGameRequest
+		wantErr error
+	}{
+		{
+			name:    "Accept a majority panel",
+			req:     &domain.CreateGameRequest{Title: "Adventure Quest", JudgePanel: []string{"gpt-4o", "gpt-4o-mini", "llama-3"}},
+			wantErr: nil,
+		},
+		{
+			name:    "Accept a quorum within the panel",
+			req:     &domain.CreateGameRequest{Title: "Adventure Quest", JudgePanel: []string{"gpt-4o", "llama-3"}, JudgePolicy: domain.JudgePolicyQuorum, JudgeQuorum: 2},
+			wantErr: nil,
+		},
+		{
+			name:    "Reject an unknown panel model",
+			req:     &domain.CreateGameRequest{Title: "Adventure Quest", JudgePanel: []string{"gpt-4o", "gpt-2"}},
+			wantErr: domain.ErrInvalidInput,
+		},
+		{
+			name:    "Reject a model listed twice",
+			req:     &domain.CreateGameRequest{Title: "Adventure Quest", JudgePanel: []string{"gpt-4o", "gpt-4o"}},
+			wantErr: domain.ErrInvalidInput,
+		},
+		{
+			name:    "Reject a quorum larger than the panel",
+			req:     &domain.CreateGameRequest{Title: "Adventure Quest", JudgePanel: []string{"gpt-4o", "llama-3"}, JudgePolicy: domain.JudgePolicyQuorum, JudgeQuorum: 3},
+			wantErr: domain.ErrInvalidInput,
+		},
+		{
+			name:    "Reject an unknown policy",
+			req:     &domain.CreateGameRequest{Title: "Adventure Quest", JudgePanel: []string{"gpt-4o"}, JudgePolicy: "dictator"},
+			wantErr: domain.ErrInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(mocks.GameRepository)
+			mockRegistry := new(mocks.LLMRegistry)
+			mockRegistry.On("Models").Return([]string{"gpt-4o", "gpt-4o-mini", "llama-3"}).Maybe()
+			if tt.wantErr == nil {
+				mockRepo.On("Create", mock.Anything, mock.AnythingOfType("*domain.Game")).
+					Return(func(_ context.Context, g *domain.Game) (*domain.Game, error) { return g, nil })
+			}
+
+			uc := NewGameUseCase(mockRepo, mockRegistry, acceptJudges())
+			result, err := uc.Create(context.Background(), tt.req)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.req.JudgePanel, result.JudgePanel)
+				assert.Equal(t, tt.req.JudgePolicy, result.JudgePolicy)
+				assert.Equal(t, tt.req.JudgeQuorum, result.JudgeQuorum)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGameUseCase_Update_RejectsUnknownJudge(t *testing.T) {
+	mockRepo := new(mocks.GameRepository)
+	mockRepo.On("GetByID", mock.Anything, "01HQZYX3VQJQZ3Z0Z1Z2GAME01").Return(&domain.Game{
+		ID:             "01HQZYX3VQJQZ3Z0Z1Z2GAME01",
+		JudgeType:      domain.JudgeTypeTargetWord,
+		JudgeCondition: "apple",
+	}, nil)
+
+	judgeType := domain.JudgeType("coin_flip")
+	uc := NewGameUseCase(mockRepo, anyModel(), judge.NewRegistry())
+	result, err := uc.Update(context.Background(), "01HQZYX3VQJQZ3Z0Z1Z2GAME01", &domain.UpdateGameRequest{JudgeType: &judgeType})
+
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	assert.Nil(t, result)
+	mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
 func TestGameUseCase_GetByID(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -121,7 +430,7 @@ func TestGameUseCase_GetByID(t *testing.T) {
 			mockRepo := new(mocks.GameRepository)
 			mockRepo.On("GetByID", mock.Anything, tt.inputID).Return(tt.mockReturn, tt.mockError)
 
-			uc := NewGameUseCase(mockRepo)
+			uc := NewGameUseCase(mockRepo, anyModel(), acceptJudges())
 			ctx := context.Background()
 			result, err := uc.GetByID(ctx, tt.inputID)
 
@@ -180,7 +489,7 @@ func TestGameUseCase_GetPaginated(t *testing.T) {
 				mockRepo.On("GetPaginated", mock.Anything, 1, 10, mock.Anything).Return(tt.mockReturn, nil)
 			}
 
-			uc := NewGameUseCase(mockRepo)
+			uc := NewGameUseCase(mockRepo, anyModel(), acceptJudges())
 			ctx := context.Background()
 			result, err := uc.GetPaginated(ctx, 1, 10, nil)
 
@@ -295,7 +604,7 @@ func TestGameUseCase_Update(t *testing.T) {
 				mockRepo.On("Update", mock.Anything, mock.AnythingOfType("*domain.Game")).Return(tt.mockUpdReturn, tt.mockUpdError)
 			}
 
-			uc := NewGameUseCase(mockRepo)
+			uc := NewGameUseCase(mockRepo, anyModel(), acceptJudges())
 			ctx := context.Background()
 			result, err := uc.Update(ctx, tt.inputID, tt.req)
 
@@ -314,6 +623,51 @@ func TestGameUseCase_Update(t *testing.T) {
 	}
 }
 
+func TestGameUseCase_Update_Temperature(t *testing.T) {
+	tests := []struct {
+		name     string
+		req      *domain.UpdateGameRequest
+		existing *float64
+		want     *float64
+	}{
+		{
+			name:     "Set deterministic temperature",
+			req:      &domain.UpdateGameRequest{Temperature: temperature(0)},
+			existing: temperature(0.9),
+			want:     temperature(0),
+		},
+		{
+			name:     "Clear temperature to the model default",
+			req:      &domain.UpdateGameRequest{ClearTemperature: true},
+			existing: temperature(0.9),
+			want:     nil,
+		},
+		{
+			name:     "Keep temperature when it is not sent",
+			req:      &domain.UpdateGameRequest{},
+			existing: temperature(0.9),
+			want:     temperature(0.9),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(mocks.GameRepository)
+			mockRepo.On("GetByID", mock.Anything, "01HQZYX3VQJQZ3Z0Z1Z2GAME01").
+				Return(&domain.Game{ID: "01HQZYX3VQJQZ3Z0Z1Z2GAME01", Title: "Adventure Quest", Temperature: tt.existing}, nil)
+			mockRepo.On("Update", mock.Anything, mock.AnythingOfType("*domain.Game")).
+				Return(func(_ context.Context, g *domain.Game) (*domain.Game, error) { return g, nil })
+
+			uc := NewGameUseCase(mockRepo, anyModel(), acceptJudges())
+			result, err := uc.Update(context.Background(), "01HQZYX3VQJQZ3Z0Z1Z2GAME01", tt.req)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, result.Temperature)
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
 func TestGameUseCase_Delete(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -340,7 +694,7 @@ func TestGameUseCase_Delete(t *testing.T) {
 			mockRepo := new(mocks.GameRepository)
 			mockRepo.On("Delete", mock.Anything, tt.inputID).Return(tt.mockError)
 
-			uc := NewGameUseCase(mockRepo)
+			uc := NewGameUseCase(mockRepo, anyModel(), acceptJudges())
 			ctx := context.Background()
 			err := uc.Delete(ctx, tt.inputID)
 
@@ -0,0 +1,20 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/everyday-studio/ollm/internal/domain"
+)
+
+// resolveJudgePanel resolves the judge models of a consensus panel; an empty list is a game without a panel.
+func resolveJudgePanel(llmRegistry domain.LLMRegistry, models []string) ([]domain.JudgePanelist, error) {
+	panel := make([]domain.JudgePanelist, 0, len(models))
+	for _, model := range models {
+		panelLLM, err := llmRegistry.Judge(model)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve judge panel model %q: %w", model, err)
+		}
+		panel = append(panel, domain.JudgePanelist{Model: model, LLM: panelLLM})
+	}
+	return panel, nil
+}
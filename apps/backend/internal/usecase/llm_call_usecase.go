@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/everyday-studio/ollm/internal/domain"
+)
+
+const (
+	maxSpendReportDays = 365
+	spendReportTopN    = 10 // users and games listed in a spend report
+)
+
+type llmCallUseCase struct {
+	llmCallRepo domain.LLMCallRepository
+}
+
+// NewLLMCallUseCase creates a new LLM call audit usecase
+func NewLLMCallUseCase(llmCallRepo domain.LLMCallRepository) domain.LLMCallUseCase {
+	return &llmCallUseCase{
+		llmCallRepo: llmCallRepo,
+	}
+}
+
+// GetByMatchID returns every LLM call made for the match, oldest first
+func (uc *llmCallUseCase) GetByMatchID(ctx context.Context, matchID string) ([]domain.LLMCall, error) {
+	if matchID == "" {
+		return nil, domain.ErrInvalidInput
+	}
+	return uc.llmCallRepo.GetByMatchID(ctx, matchID)
+}
+
+// GetSpendReport aggregates the LLM spend of the given number of days (UTC), ending with today.
+func (uc *llmCallUseCase) GetSpendReport(ctx context.Context, days int) (*domain.SpendReport, error) {
+	if days < 1 || days > maxSpendReportDays {
+		return nil, fmt.Errorf("%w: days must be between 1 and %d", domain.ErrInvalidInput, maxSpendReportDays)
+	}
+
+	to := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, 1)
+	from := to.AddDate(0, 0, -days)
+
+	byDay, err := uc.llmCallRepo.GetSpendByDay(ctx, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get spend by day: %w", err)
+	}
+	byUser, err := uc.llmCallRepo.GetSpendByUser(ctx, from, to, spendReportTopN)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get spend by user: %w", err)
+	}
+	byGame, err := uc.llmCallRepo.GetSpendByGame(ctx, from, to, spendReportTopN)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get spend by game: %w", err)
+	}
+
+	total := domain.SpendSummary{Key: "total", Label: "Total"}
+	for _, d := range byDay {
+		total.Calls += d.Calls
+		total.PromptTokens += d.PromptTokens
+		total.CompletionTokens += d.CompletionTokens
+		total.CostUSD += d.CostUSD
+	}
+
+	return &domain.SpendReport{
+		From:   from,
+		To:     to,
+		Total:  total,
+		ByDay:  byDay,
+		ByUser: byUser,
+		ByGame: byGame,
+	}, nil
+}
@@ -0,0 +1,94 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/everyday-studio/ollm/internal/domain"
+	"github.com/everyday-studio/ollm/internal/domain/mocks"
+)
+
+func TestLLMCallUseCase_GetByMatchID(t *testing.T) {
+	tests := []struct {
+		name      string
+		matchID   string
+		setupMock func(repo *mocks.LLMCallRepository)
+		wantLen   int
+		wantErr   error
+	}{
+		{
+			name:    "Return the calls of the match",
+			matchID: "match-1",
+			setupMock: func(repo *mocks.LLMCallRepository) {
+				repo.On("GetByMatchID", context.Background(), "match-1").Return([]domain.LLMCall{
+					{ID: "call-1", Purpose: domain.LLMCallPurposeChat},
+					{ID: "call-2", Purpose: domain.LLMCallPurposeJudge},
+				}, nil)
+			},
+			wantLen: 2,
+		},
+		{
+			name:      "Reject an empty match ID",
+			matchID:   "",
+			setupMock: func(repo *mocks.LLMCallRepository) {},
+			wantErr:   domain.ErrInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := new(mocks.LLMCallRepository)
+			tt.setupMock(repo)
+			uc := NewLLMCallUseCase(repo)
+
+			calls, err := uc.GetByMatchID(context.Background(), tt.matchID)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, calls, tt.wantLen)
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestLLMCallUseCase_GetSpendReport(t *testing.T) {
+	t.Run("Aggregate the total from the days", func(t *testing.T) {
+		repo := new(mocks.LLMCallRepository)
+		repo.On("GetSpendByDay", mock.Anything, mock.Anything, mock.Anything).Return([]domain.SpendSummary{
+			{Key: "2026-04-01", Calls: 3, PromptTokens: 300, CompletionTokens: 30, CostUSD: 0.5},
+			{Key: "2026-04-02", Calls: 1, PromptTokens: 100, CompletionTokens: 10, CostUSD: 0.25},
+		}, nil)
+		repo.On("GetSpendByUser", mock.Anything, mock.Anything, mock.Anything, spendReportTopN).Return([]domain.SpendSummary{{Key: "user-1", CostUSD: 0.75}}, nil)
+		repo.On("GetSpendByGame", mock.Anything, mock.Anything, mock.Anything, spendReportTopN).Return([]domain.SpendSummary{{Key: "game-1", CostUSD: 0.75}}, nil)
+		uc := NewLLMCallUseCase(repo)
+
+		report, err := uc.GetSpendReport(context.Background(), 7)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 4, report.Total.Calls)
+		assert.Equal(t, int64(400), report.Total.PromptTokens)
+		assert.Equal(t, int64(40), report.Total.CompletionTokens)
+		assert.InDelta(t, 0.75, report.Total.CostUSD, 1e-9)
+		assert.Equal(t, 7*24*time.Hour, report.To.Sub(report.From))
+		assert.True(t, report.To.After(time.Now()))
+		assert.Len(t, report.ByUser, 1)
+		assert.Len(t, report.ByGame, 1)
+	})
+
+	t.Run("Reject an invalid range", func(t *testing.T) {
+		uc := NewLLMCallUseCase(new(mocks.LLMCallRepository))
+
+		_, err := uc.GetSpendReport(context.Background(), 0)
+		assert.ErrorIs(t, err, domain.ErrInvalidInput)
+
+		_, err = uc.GetSpendReport(context.Background(), maxSpendReportDays+1)
+		assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	})
+}
@@ -3,25 +3,42 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/everyday-studio/ollm/internal/domain"
+	"github.com/everyday-studio/ollm/internal/kit/prompt"
 )
 
 type matchUseCase struct {
-	matchRepo domain.MatchRepository
-	gameRepo  domain.GameRepository
+	matchRepo   domain.MatchRepository
+	gameRepo    domain.GameRepository
+	quotaUC     domain.QuotaUseCase
+	verdictRepo domain.TurnVerdictRepository
+	messageRepo domain.MessageRepository
+	userRepo    domain.UserRepository
+	txManager   domain.TxManager
 }
 
 // NewMatchUseCase creates a new match use case
-func NewMatchUseCase(matchRepo domain.MatchRepository, gameRepo domain.GameRepository) domain.MatchUseCase {
+func NewMatchUseCase(matchRepo domain.MatchRepository, gameRepo domain.GameRepository, quotaUC domain.QuotaUseCase, verdictRepo domain.TurnVerdictRepository, messageRepo domain.MessageRepository, userRepo domain.UserRepository, txManager domain.TxManager) domain.MatchUseCase {
 	return &matchUseCase{
-		matchRepo: matchRepo,
-		gameRepo:  gameRepo,
+		matchRepo:   matchRepo,
+		gameRepo:    gameRepo,
+		quotaUC:     quotaUC,
+		verdictRepo: verdictRepo,
+		messageRepo: messageRepo,
+		userRepo:    userRepo,
+		txManager:   txManager,
 	}
 }
 
 // Create creates a new match with the provided request data
 func (uc *matchUseCase) Create(ctx context.Context, req *domain.CreateMatchRequest) (*domain.Match, error) {
+	// Don't start a match the user has no budget left to play
+	if err := uc.quotaUC.Check(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+
 	// Get game to copy max_turns
 	game, err := uc.gameRepo.GetByID(ctx, req.GameID)
 	if err != nil {
@@ -38,6 +55,12 @@ func (uc *matchUseCase) Create(ctx context.Context, req *domain.CreateMatchReque
 		return nil, fmt.Errorf("%w: maximum number of active matches (5) for this game reached", domain.ErrConflict)
 	}
 
+	// Every match gets its own secrets so one leaked password doesn't give the game away
+	secrets, err := prompt.GenerateSecrets(game.Secrets)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate match secrets: %w", err)
+	}
+
 	match := &domain.Match{
 		UserID:      req.UserID,
 		GameID:      req.GameID,
@@ -45,16 +68,65 @@ func (uc *matchUseCase) Create(ctx context.Context, req *domain.CreateMatchReque
 		MaxTurns:    game.MaxTurns,
 		TotalTokens: 0,
 		TurnCount:   0,
+		Secrets:     secrets,
 	}
 
-	createdMatch, err := uc.matchRepo.Create(ctx, match)
+	// A match the model would play without the greeting the player saw is not worth keeping
+	var createdMatch *domain.Match
+	err = uc.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		createdMatch, err = uc.matchRepo.Create(ctx, match)
+		if err != nil {
+			return fmt.Errorf("failed to create match: %w", err)
+		}
+		return uc.greet(ctx, createdMatch, game)
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to create match: %w", err)
+		return nil, err
 	}
 
 	return createdMatch, nil
 }
 
+// greet saves the game's first message as the turn 0 assistant message of the match,
+// so the model, the judge and the transcript all see the greeting the player saw.
+func (uc *matchUseCase) greet(ctx context.Context, match *domain.Match, game *domain.Game) error {
+	if strings.TrimSpace(game.FirstMessage) == "" {
+		return nil
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, match.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to get player for first message: %w", err)
+	}
+
+	greeting := &domain.Message{
+		MatchID:   match.ID,
+		Role:      domain.MessageRoleAssistant,
+		Content:   prompt.Render(game.FirstMessage, firstMessageVars(user, game, match.Secrets)),
+		IsVisible: true,
+		TurnCount: 0,
+	}
+	if _, err := uc.messageRepo.Create(ctx, greeting); err != nil {
+		return fmt.Errorf("failed to save first message: %w", err)
+	}
+
+	return nil
+}
+
+// firstMessageVars are the template variables a game's first message can use: the match's secrets,
+// the player's nickname and the game's title
+func firstMessageVars(user *domain.User, game *domain.Game, secrets map[string]string) map[string]string {
+	vars := map[string]string{
+		"nickname": user.Name,
+		"game":     game.Title,
+	}
+	for name, value := range secrets {
+		vars[name] = value
+	}
+	return vars
+}
+
 // GetByID retrieves a match by its ID and validates ownership
 func (uc *matchUseCase) GetByID(ctx context.Context, id string, userID string) (*domain.Match, error) {
 	match, err := uc.matchRepo.GetByID(ctx, id)
@@ -81,29 +153,59 @@ func (uc *matchUseCase) GetByUserIDAndGameID(ctx context.Context, userID string,
 
 // Resign allows a user to voluntarily forfeit a match
 func (uc *matchUseCase) Resign(ctx context.Context, id string, userID string) error {
+	// 검증과 기권을 한 트랜잭션에서 처리
+	return uc.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		match, err := uc.matchRepo.GetByID(ctx, id)
+		if err != nil {
+			return fmt.Errorf("failed to get match for resignation: %w", err)
+		}
+
+		if match.UserID != userID {
+			return domain.ErrForbidden
+		}
+
+		if match.Status != domain.MatchStatusActive {
+			return fmt.Errorf("%w: match is not active", domain.ErrConflict)
+		}
+
+		// 진행 중인 턴이 먼저 매치를 잠갔다면 ErrConflict로 거절
+		match.Status = domain.MatchStatusResigned
+		if _, err := uc.matchRepo.CompareAndSet(ctx, match, domain.MatchStatusActive); err != nil {
+			return fmt.Errorf("failed to update match status to resigned: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// Delete removes a match by its ID
+func (uc *matchUseCase) Delete(ctx context.Context, id string) error {
+	return uc.matchRepo.Delete(ctx, id)
+}
+
+// GetVerdicts retrieves the judge verdicts of a finished match after validating ownership.
+// Verdicts stay hidden while the match is in play so they can't be used to probe the judge.
+func (uc *matchUseCase) GetVerdicts(ctx context.Context, id string, userID string) ([]domain.TurnVerdict, error) {
 	match, err := uc.matchRepo.GetByID(ctx, id)
 	if err != nil {
-		return fmt.Errorf("failed to get match for resignation: %w", err)
+		return nil, fmt.Errorf("failed to get match for verdicts: %w", err)
 	}
 
 	if match.UserID != userID {
-		return domain.ErrForbidden
+		return nil, domain.ErrForbidden
 	}
 
-	if match.Status != domain.MatchStatusActive {
-		return fmt.Errorf("%w: match is not active", domain.ErrConflict)
+	if !match.IsOver() {
+		return nil, fmt.Errorf("%w: verdicts are shown once the match is over", domain.ErrConflict)
 	}
 
-	match.Status = domain.MatchStatusResigned
-	_, err = uc.matchRepo.Update(ctx, match)
-	if err != nil {
-		return fmt.Errorf("failed to update match status to resigned: %w", err)
-	}
-
-	return nil
+	return uc.verdictRepo.GetByMatchID(ctx, id)
 }
 
-// Delete removes a match by its ID
-func (uc *matchUseCase) Delete(ctx context.Context, id string) error {
-	return uc.matchRepo.Delete(ctx, id)
+// GetVerdictsByMatchID retrieves the judge verdicts of any match
+func (uc *matchUseCase) GetVerdictsByMatchID(ctx context.Context, matchID string) ([]domain.TurnVerdict, error) {
+	if matchID == "" {
+		return nil, fmt.Errorf("%w: match id is required", domain.ErrInvalidInput)
+	}
+	return uc.verdictRepo.GetByMatchID(ctx, matchID)
 }
@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -15,6 +16,7 @@ func TestMatchUseCase_Create(t *testing.T) {
 	tests := []struct {
 		name         string
 		req          *domain.CreateMatchRequest
+		mockQuotaErr error
 		mockGameRet  *domain.Game
 		mockGameErr  error
 		mockCountRet int
@@ -34,7 +36,7 @@ func TestMatchUseCase_Create(t *testing.T) {
 				ID:       "01HQZYX3VQJQZ3Z0Z1Z2ZGAME1",
 				MaxTurns: 10,
 			},
-			mockGameErr: nil,
+			mockGameErr:  nil,
 			mockCountRet: 0,
 			mockCountErr: nil,
 			mockMatchRet: &domain.Match{
@@ -91,6 +93,16 @@ func TestMatchUseCase_Create(t *testing.T) {
 			want:         nil,
 			wantErr:      true,
 		},
+		{
+			name: "Fail to create match due to exhausted quota",
+			req: &domain.CreateMatchRequest{
+				UserID: "01HQZYX3VQJQZ3Z0Z1Z2ZUSER1",
+				GameID: "01HQZYX3VQJQZ3Z0Z1Z2ZGAME1",
+			},
+			mockQuotaErr: domain.ErrQuotaExceeded,
+			want:         nil,
+			wantErr:      true,
+		},
 		{
 			name: "Fail to create match due to too many active matches",
 			req: &domain.CreateMatchRequest{
@@ -115,17 +127,21 @@ func TestMatchUseCase_Create(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mockGameRepo := new(mocks.GameRepository)
 			mockMatchRepo := new(mocks.MatchRepository)
+			mockQuotaUC := new(mocks.QuotaUseCase)
 
-			mockGameRepo.On("GetByID", mock.Anything, tt.req.GameID).Return(tt.mockGameRet, tt.mockGameErr)
+			mockQuotaUC.On("Check", mock.Anything, tt.req.UserID).Return(tt.mockQuotaErr)
+			if tt.mockQuotaErr == nil {
+				mockGameRepo.On("GetByID", mock.Anything, tt.req.GameID).Return(tt.mockGameRet, tt.mockGameErr)
+			}
 
-			if tt.mockGameErr == nil {
+			if tt.mockQuotaErr == nil && tt.mockGameErr == nil {
 				mockMatchRepo.On("CountByUserIDGameIDAndStatus", mock.Anything, tt.req.UserID, tt.req.GameID, domain.MatchStatusActive).Return(tt.mockCountRet, tt.mockCountErr)
 				if tt.mockCountErr == nil && tt.mockCountRet < 5 {
 					mockMatchRepo.On("Create", mock.Anything, mock.AnythingOfType("*domain.Match")).Return(tt.mockMatchRet, tt.mockMatchErr)
 				}
 			}
 
-			uc := NewMatchUseCase(mockMatchRepo, mockGameRepo)
+			uc := NewMatchUseCase(mockMatchRepo, mockGameRepo, mockQuotaUC, nil, nil, nil, runInTx())
 			ctx := context.Background()
 			result, err := uc.Create(ctx, tt.req)
 
@@ -145,6 +161,73 @@ func TestMatchUseCase_Create(t *testing.T) {
 	}
 }
 
+func TestMatchUseCase_CreateSavesFirstMessage(t *testing.T) {
+	req := &domain.CreateMatchRequest{UserID: "01HQZYX3VQJQZ3Z0Z1Z2ZUSER1", GameID: "01HQZYX3VQJQZ3Z0Z1Z2ZGAME1"}
+	game := &domain.Game{ID: req.GameID, Title: "The Vault", MaxTurns: 10, FirstMessage: "Hello {{nickname}}, welcome to {{game}}. I am the {{persona}}.",
+		Secrets: map[string]domain.SecretGenerator{"persona": {Type: domain.SecretTypeChoice, Options: []string{"pirate"}}}}
+	match := &domain.Match{ID: "01HQZYX3VQJQZ3Z0Z1ZMATCH01", UserID: req.UserID, GameID: req.GameID, Status: domain.MatchStatusActive, MaxTurns: 10}
+
+	tests := []struct {
+		name       string
+		mockMsgErr error
+		wantErr    bool
+	}{
+		{name: "Save the greeting as turn 0"},
+		{name: "Roll back the match when the greeting can't be saved", mockMsgErr: domain.ErrInternal, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockGameRepo := new(mocks.GameRepository)
+			mockMatchRepo := new(mocks.MatchRepository)
+			mockQuotaUC := new(mocks.QuotaUseCase)
+			mockMessageRepo := new(mocks.MessageRepository)
+			mockUserRepo := new(mocks.UserRepository)
+
+			mockQuotaUC.On("Check", mock.Anything, req.UserID).Return(nil)
+			mockGameRepo.On("GetByID", mock.Anything, req.GameID).Return(game, nil)
+			mockMatchRepo.On("CountByUserIDGameIDAndStatus", mock.Anything, req.UserID, req.GameID, domain.MatchStatusActive).Return(0, nil)
+			// 매치마다 뽑은 시크릿이 매치와 함께 저장되어야 함
+			mockMatchRepo.On("Create", mock.Anything, mock.MatchedBy(func(m *domain.Match) bool {
+				return m.Secrets["persona"] == "pirate"
+			})).Return(func(_ context.Context, m *domain.Match) (*domain.Match, error) {
+				m.ID = match.ID
+				return m, nil
+			})
+			mockUserRepo.On("GetByID", mock.Anything, req.UserID).Return(&domain.User{ID: req.UserID, Name: "용감한올름"}, nil)
+
+			var greeting *domain.Message
+			mockMessageRepo.On("Create", mock.Anything, mock.AnythingOfType("*domain.Message")).
+				Run(func(args mock.Arguments) { greeting = args.Get(1).(*domain.Message) }).
+				Return(func(_ context.Context, msg *domain.Message) (*domain.Message, error) {
+					if tt.mockMsgErr != nil {
+						return nil, tt.mockMsgErr
+					}
+					return msg, nil
+				})
+
+			uc := NewMatchUseCase(mockMatchRepo, mockGameRepo, mockQuotaUC, nil, mockMessageRepo, mockUserRepo, runInTx())
+			result, err := uc.Create(context.Background(), req)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, match.ID, result.ID)
+			}
+			if assert.NotNil(t, greeting) {
+				assert.Equal(t, match.ID, greeting.MatchID)
+				assert.Equal(t, domain.MessageRoleAssistant, greeting.Role)
+				assert.Equal(t, 0, greeting.TurnCount)
+				assert.True(t, greeting.IsVisible)
+				assert.Equal(t, "Hello 용감한올름, welcome to The Vault. I am the pirate.", greeting.Content)
+			}
+			mockMatchRepo.AssertExpectations(t)
+		})
+	}
+}
+
 func TestMatchUseCase_GetByID(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -202,7 +285,7 @@ func TestMatchUseCase_GetByID(t *testing.T) {
 
 			mockMatchRepo.On("GetByID", mock.Anything, tt.matchID).Return(tt.mockReturn, tt.mockError)
 
-			uc := NewMatchUseCase(mockMatchRepo, mockGameRepo)
+			uc := NewMatchUseCase(mockMatchRepo, mockGameRepo, nil, nil, nil, nil, nil)
 			ctx := context.Background()
 			result, err := uc.GetByID(ctx, tt.matchID, tt.userID)
 
@@ -299,6 +382,19 @@ func TestMatchUseCase_Resign(t *testing.T) {
 			wantErr:      true,
 			checkErrType: domain.ErrInternal,
 		},
+		{
+			name:    "Fail to resign while a turn locked the match first",
+			matchID: "01HQZYX3VQJQZ3Z0Z1ZMATCH01",
+			userID:  "01HQZYX3VQJQZ3Z0Z1Z2ZUSER1",
+			mockGetRet: &domain.Match{
+				ID:     "01HQZYX3VQJQZ3Z0Z1ZMATCH01",
+				UserID: "01HQZYX3VQJQZ3Z0Z1Z2ZUSER1",
+				Status: domain.MatchStatusActive,
+			},
+			mockUpdErr:   fmt.Errorf("%w: match is generating, not active", domain.ErrConflict),
+			wantErr:      true,
+			checkErrType: domain.ErrConflict,
+		},
 	}
 
 	for _, tt := range tests {
@@ -308,12 +404,14 @@ func TestMatchUseCase_Resign(t *testing.T) {
 
 			mockMatchRepo.On("GetByID", mock.Anything, tt.matchID).Return(tt.mockGetRet, tt.mockGetErr)
 
-			// If it fetches successfully, belongs to the user, and is active, Update is called
+			// If it fetches successfully, belongs to the user, and is active, the active -> resigned transition is attempted
 			if tt.mockGetErr == nil && tt.mockGetRet != nil && tt.mockGetRet.UserID == tt.userID && tt.mockGetRet.Status == domain.MatchStatusActive {
-				mockMatchRepo.On("Update", mock.Anything, mock.AnythingOfType("*domain.Match")).Return(tt.mockUpdRet, tt.mockUpdErr)
+				mockMatchRepo.On("CompareAndSet", mock.Anything, mock.MatchedBy(func(m *domain.Match) bool {
+					return m.Status == domain.MatchStatusResigned
+				}), domain.MatchStatusActive).Return(tt.mockUpdRet, tt.mockUpdErr)
 			}
 
-			uc := NewMatchUseCase(mockMatchRepo, mockGameRepo)
+			uc := NewMatchUseCase(mockMatchRepo, mockGameRepo, nil, nil, nil, nil, runInTx())
 			ctx := context.Background()
 			err := uc.Resign(ctx, tt.matchID, tt.userID)
 
@@ -359,7 +457,7 @@ func TestMatchUseCase_Delete(t *testing.T) {
 
 			mockMatchRepo.On("Delete", mock.Anything, tt.matchID).Return(tt.mockError)
 
-			uc := NewMatchUseCase(mockMatchRepo, mockGameRepo)
+			uc := NewMatchUseCase(mockMatchRepo, mockGameRepo, nil, nil, nil, nil, nil)
 			ctx := context.Background()
 			err := uc.Delete(ctx, tt.matchID)
 
@@ -369,3 +467,57 @@ func TestMatchUseCase_Delete(t *testing.T) {
 		})
 	}
 }
+
+func TestMatchUseCase_GetVerdicts(t *testing.T) {
+	matchID := "01HQZYX3VQJQZ3Z0Z1ZMATCH01"
+	userID := "01HQZYX3VQJQZ3Z0Z1Z2ZUSER1"
+
+	tests := []struct {
+		name    string
+		userID  string
+		status  domain.MatchStatus
+		wantLen int
+		wantErr error
+	}{
+		{
+			name:    "Show the verdicts of a finished match",
+			userID:  userID,
+			status:  domain.MatchStatusLost,
+			wantLen: 1,
+		},
+		{
+			name:    "Hide the verdicts while the match is in play",
+			userID:  userID,
+			status:  domain.MatchStatusActive,
+			wantErr: domain.ErrConflict,
+		},
+		{
+			name:    "Reject other users",
+			userID:  "01HQZYX3VQJQZ3Z0Z1Z2ZUSER2",
+			status:  domain.MatchStatusWon,
+			wantErr: domain.ErrForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockMatchRepo := new(mocks.MatchRepository)
+			mockVerdictRepo := new(mocks.TurnVerdictRepository)
+			mockMatchRepo.On("GetByID", mock.Anything, matchID).Return(&domain.Match{ID: matchID, UserID: userID, Status: tt.status}, nil)
+			mockVerdictRepo.On("GetByMatchID", mock.Anything, matchID).Return([]domain.TurnVerdict{
+				{MatchID: matchID, TurnCount: 1, Outcome: domain.JudgeOutcomeContinue, Reason: "The AI refused."},
+			}, nil).Maybe()
+
+			uc := NewMatchUseCase(mockMatchRepo, nil, nil, mockVerdictRepo, nil, nil, nil)
+			verdicts, err := uc.GetVerdicts(context.Background(), matchID, tt.userID)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				mockVerdictRepo.AssertNotCalled(t, "GetByMatchID", mock.Anything, mock.Anything)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, verdicts, tt.wantLen)
+		})
+	}
+}
@@ -2,40 +2,171 @@ package usecase
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
-	"strings"
+	"slices"
+	"time"
 
 	"golang.org/x/sync/errgroup"
 
+	"github.com/everyday-studio/ollm/internal/config"
 	"github.com/everyday-studio/ollm/internal/domain"
+	"github.com/everyday-studio/ollm/internal/kit/contexts"
+	"github.com/everyday-studio/ollm/internal/kit/prompt"
 )
 
 type messageUseCase struct {
-	messageRepo     domain.MessageRepository
-	matchRepo       domain.MatchRepository
-	llmService      domain.LLMService
-	judgeLLMService domain.LLMService
-	gameRepo        domain.GameRepository
+	messageRepo      domain.MessageRepository
+	matchRepo        domain.MatchRepository
+	llmRegistry      domain.LLMRegistry
+	gameRepo         domain.GameRepository
+	quotaUC          domain.QuotaUseCase
+	judgeRegistry    domain.JudgeRegistry
+	verdictRepo      domain.TurnVerdictRepository
+	idempotencyRepo  domain.IdempotencyRepository
+	txManager        domain.TxManager
+	idempotencyLease time.Duration
 }
 
 func NewMessageUseCase(
 	messageRepo domain.MessageRepository,
 	matchRepo domain.MatchRepository,
-	llmService domain.LLMService,
-	judgeLLMService domain.LLMService,
+	llmRegistry domain.LLMRegistry,
 	gameRepo domain.GameRepository,
+	quotaUC domain.QuotaUseCase,
+	judgeRegistry domain.JudgeRegistry,
+	verdictRepo domain.TurnVerdictRepository,
+	idempotencyRepo domain.IdempotencyRepository,
+	txManager domain.TxManager,
+	cfg *config.Config,
 ) domain.MessageUseCase {
 	return &messageUseCase{
-		messageRepo:     messageRepo,
-		matchRepo:       matchRepo,
-		llmService:      llmService,
-		judgeLLMService: judgeLLMService,
-		gameRepo:        gameRepo,
+		messageRepo:      messageRepo,
+		matchRepo:        matchRepo,
+		llmRegistry:      llmRegistry,
+		gameRepo:         gameRepo,
+		quotaUC:          quotaUC,
+		judgeRegistry:    judgeRegistry,
+		verdictRepo:      verdictRepo,
+		idempotencyRepo:  idempotencyRepo,
+		txManager:        txManager,
+		idempotencyLease: idempotencyLeaseFactor * generatingTimeout(cfg),
 	}
 }
 
+// idempotencyLeaseFactor sets how long a key without a result turns retries away, in sweeper generating timeouts.
+// The lease outlasts the timeout, so a turn still being played keeps its key and a turn abandoned by a crash frees it.
+const idempotencyLeaseFactor = 3
+
+// settleFunc records the result of a turn in the transaction that saves the turn.
+type settleFunc func(ctx context.Context, result *domain.TurnResult) error
+
+// generateFunc produces the AI reply for the given conversation history with the game's chat model.
+type generateFunc func(ctx context.Context, chatLLM domain.LLMService, history []domain.Message) (*domain.LLMResponse, error)
+
+// turnModels holds the LLMs and the judge a game plays its turns with.
+type turnModels struct {
+	chatLLM  domain.LLMService
+	judgeLLM domain.LLMService
+	panel    []domain.JudgePanelist
+	judge    domain.Judge
+}
+
 // Create handles the core game turn
 func (uc *messageUseCase) Create(ctx context.Context, matchID string, userID string, req *domain.CreateMessageRequest) (*domain.Message, error) {
+	result, err := uc.playOnce(ctx, matchID, userID, req, func(settle settleFunc) (*domain.TurnResult, error) {
+		return uc.playTurn(ctx, matchID, userID, req, settle, func(ctx context.Context, chatLLM domain.LLMService, history []domain.Message) (*domain.LLMResponse, error) {
+			return chatLLM.GenerateResponse(ctx, history)
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.Message, nil
+}
+
+// CreateStream handles the core game turn while streaming the AI reply through onDelta
+func (uc *messageUseCase) CreateStream(ctx context.Context, matchID string, userID string, req *domain.CreateMessageRequest, onDelta func(delta string) error) (*domain.TurnResult, error) {
+	return uc.playOnce(ctx, matchID, userID, req, func(settle settleFunc) (*domain.TurnResult, error) {
+		return uc.playTurn(ctx, matchID, userID, req, settle, func(ctx context.Context, chatLLM domain.LLMService, history []domain.Message) (*domain.LLMResponse, error) {
+			return chatLLM.StreamResponse(ctx, history, onDelta)
+		})
+	})
+}
+
+// playOnce runs play unless the user already sent req's idempotency key: a completed request
+// is answered with its stored result and a request still in flight is rejected with ErrConflict.
+// A key sent again with a different match or content is rejected with ErrInvalidInput.
+// play is given the settleFunc that stores the result with the turn.
+func (uc *messageUseCase) playOnce(ctx context.Context, matchID string, userID string, req *domain.CreateMessageRequest, play func(settle settleFunc) (*domain.TurnResult, error)) (*domain.TurnResult, error) {
+	if req.IdempotencyKey == "" {
+		return play(nil)
+	}
+
+	match, err := uc.matchRepo.GetByID(ctx, matchID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get match for authorization: %w", err)
+	}
+	if match.UserID != userID {
+		return nil, domain.ErrForbidden
+	}
+
+	fingerprint := turnFingerprint(matchID, req.Content)
+	record, reserved, err := uc.idempotencyRepo.Reserve(ctx, &domain.IdempotencyKey{
+		UserID:      userID,
+		Key:         req.IdempotencyKey,
+		MatchID:     matchID,
+		Fingerprint: fingerprint,
+	}, time.Now().Add(-uc.idempotencyLease))
+	if err != nil {
+		return nil, fmt.Errorf("failed to reserve idempotency key: %w", err)
+	}
+	if !reserved {
+		switch {
+		case record.Fingerprint != fingerprint:
+			return nil, fmt.Errorf("%w: idempotency key was already used for another request", domain.ErrInvalidInput)
+		case record.Result == nil:
+			return nil, fmt.Errorf("%w: a request with this idempotency key is still in progress", domain.ErrConflict)
+		}
+		// 같은 요청의 재전송: LLM을 다시 호출하지 않고 처음 결과를 그대로 돌려줌
+		return record.Result, nil
+	}
+
+	// 결과는 턴과 같은 트랜잭션에 저장되므로, 저장된 턴은 재전송에 항상 그 결과로 답함
+	result, err := play(func(ctx context.Context, result *domain.TurnResult) error {
+		if err := uc.idempotencyRepo.Complete(ctx, userID, req.IdempotencyKey, record.Token, result); err != nil {
+			return fmt.Errorf("failed to store idempotent result: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		// 결과가 없는 키는 풀어 두어 같은 키로 다시 시도할 수 있게 함
+		if releaseErr := uc.idempotencyRepo.Release(context.WithoutCancel(ctx), userID, req.IdempotencyKey, record.Token); releaseErr != nil {
+			// 풀지 못한 키는 임대 시간이 지날 때까지 재시도를 거절함
+			contexts.GetLogger(ctx).Warn("failed to release idempotency key",
+				"match_id", matchID,
+				"user_id", userID,
+				"lease", uc.idempotencyLease,
+				"error", releaseErr,
+			)
+		}
+		return nil, err
+	}
+	return result, nil
+}
+
+// turnFingerprint identifies a turn request by its match and content
+func turnFingerprint(matchID string, content string) string {
+	sum := sha256.Sum256([]byte(matchID + "\x00" + content))
+	return hex.EncodeToString(sum[:])
+}
+
+// playTurn runs a full turn: locks the match, saves the user message, generates the AI reply
+// with generate, then judges the reply and produces prompt advice concurrently.
+// settle, if set, is run in the transaction that saves the turn.
+func (uc *messageUseCase) playTurn(ctx context.Context, matchID string, userID string, req *domain.CreateMessageRequest, settle settleFunc, generate generateFunc) (*domain.TurnResult, error) {
 	// ==========================================
 	// 1. 검증 및 상태 락 (Validation & Lock)
 	// ==========================================
@@ -50,29 +181,24 @@ func (uc *messageUseCase) Create(ctx context.Context, matchID string, userID str
 		return nil, domain.ErrConflict
 	}
 
-	match.Status = domain.MatchStatusGenerating
-	if _, err := uc.matchRepo.Update(ctx, match); err != nil {
-		return nil, fmt.Errorf("failed to lock match state: %w", err)
+	// 일일 한도를 넘었으면 LLM을 호출하기 전에 거절
+	if err := uc.quotaUC.Check(ctx, userID); err != nil {
+		return nil, err
 	}
 
-	// ==========================================
-	// 2. 롤백 안전장치 (Safety Net)
-	// ==========================================
-	userMessageSaved := false
-
-	defer func() {
-		if match.Status == domain.MatchStatusGenerating {
-			if userMessageSaved {
-				match.Status = domain.MatchStatusError
-			} else {
-				match.Status = domain.MatchStatusActive
-			}
-			_, _ = uc.matchRepo.Update(context.WithoutCancel(ctx), match)
-		}
-	}()
+	game, err := uc.gameRepo.GetByID(ctx, match.GameID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get game for system prompt: %w", err)
+	}
+
+	// 게임별 모델 설정에 따라 대화/심판 LLM 선택 (프로바이더 장애 시 턴을 시작하지 않고 거절)
+	models, err := uc.resolveModels(game)
+	if err != nil {
+		return nil, err
+	}
 
 	// ==========================================
-	// 3. 유저 데이터 저장 및 대화 컨텍스트 구성
+	// 2. 상태 락 및 유저 메시지 저장 (Unit of Work)
 	// ==========================================
 	currentTurn := match.TurnCount + 1
 
@@ -85,50 +211,207 @@ func (uc *messageUseCase) Create(ctx context.Context, matchID string, userID str
 		TokenCount: 0,
 	}
 
-	if _, err := uc.messageRepo.Create(ctx, userMsg); err != nil {
-		return nil, fmt.Errorf("failed to save user message: %w", err)
+	// 읽은 뒤 다른 요청이 먼저 턴을 시작했다면 ErrConflict로 거절 (동시에 두 턴이 진행되지 않음)
+	// 락과 유저 메시지는 함께 커밋되어 메시지 저장에 실패하면 락도 풀림
+	locked := *match
+	locked.Status = domain.MatchStatusGenerating
+	err = uc.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		if _, err := uc.matchRepo.CompareAndSet(ctx, &locked, domain.MatchStatusActive); err != nil {
+			return fmt.Errorf("failed to lock match state: %w", err)
+		}
+		if _, err := uc.messageRepo.Create(ctx, userMsg); err != nil {
+			return fmt.Errorf("failed to save user message: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
-
-	userMessageSaved = true
+	*match = locked
 	match.TurnCount = currentTurn
 
-	// 대화 내역 및 게임 시스템 프롬프트 조회
+	// ==========================================
+	// 3. 롤백 안전장치 (Safety Net)
+	// ==========================================
+	defer uc.failTurn(ctx, match)
+
+	// 대화 내역 조회
 	history, err := uc.messageRepo.GetByMatchID(ctx, matchID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get match history: %w", err)
 	}
 
+	result, err := uc.completeTurn(ctx, match, game, models, userMsg, history, settle, generate)
+	if errors.Is(err, domain.ErrLLMUnavailable) {
+		// 프로바이더 장애: 유저 메시지를 되돌리고 매치를 다시 진행 가능한 상태로 복구 (둘 다 되돌리거나 둘 다 남김)
+		reopened := *match
+		reopened.Status = domain.MatchStatusActive
+		reopened.TurnCount = currentTurn - 1
+		rollbackErr := uc.txManager.WithinTx(context.WithoutCancel(ctx), func(ctx context.Context) error {
+			if err := uc.messageRepo.Delete(ctx, userMsg.ID); err != nil {
+				return fmt.Errorf("failed to delete user message: %w", err)
+			}
+			if _, err := uc.matchRepo.CompareAndSet(ctx, &reopened, domain.MatchStatusGenerating); err != nil {
+				return fmt.Errorf("failed to reopen match: %w", err)
+			}
+			return nil
+		})
+		if rollbackErr != nil {
+			return nil, fmt.Errorf("llm unavailable and turn rollback failed: %v (original: %w)", rollbackErr, err)
+		}
+		*match = reopened
+	}
+	return result, err
+}
+
+// failTurn leaves a match still generating after its turn in error, so the player can retry the turn.
+// If that fails too, the match stays generating until the sweeper recovers it.
+func (uc *messageUseCase) failTurn(ctx context.Context, match *domain.Match) {
+	if match.Status != domain.MatchStatusGenerating {
+		return
+	}
+	match.Status = domain.MatchStatusError
+	if _, err := uc.matchRepo.CompareAndSet(context.WithoutCancel(ctx), match, domain.MatchStatusGenerating); err != nil {
+		contexts.GetLogger(ctx).Warn("failed to mark turn as failed; left for the sweeper",
+			"match_id", match.ID,
+			"turn_count", match.TurnCount,
+			"error", err,
+		)
+	}
+}
+
+// Retry regenerates the reply to the last user message of a match left in error by a failed turn,
+// then judges it as a normal turn. The turn was already counted, so no quota is charged again.
+func (uc *messageUseCase) Retry(ctx context.Context, matchID string, userID string) (*domain.TurnResult, error) {
+	match, err := uc.matchRepo.GetByID(ctx, matchID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get match for authorization: %w", err)
+	}
+	if match.UserID != userID {
+		return nil, domain.ErrForbidden
+	}
+	if match.Status != domain.MatchStatusError {
+		return nil, fmt.Errorf("%w: only a failed turn can be retried", domain.ErrConflict)
+	}
+
 	game, err := uc.gameRepo.GetByID(ctx, match.GameID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get game for system prompt: %w", err)
 	}
+	models, err := uc.resolveModels(game)
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := uc.messageRepo.GetByMatchID(ctx, matchID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get match history: %w", err)
+	}
+
+	// 실패한 턴의 유저 메시지를 찾고, 그 턴에 남은 AI 답변은 지우고 새로 생성 (판정도 함께 삭제됨)
+	var userMsg *domain.Message
+	var stale []string
+	retained := make([]domain.Message, 0, len(history))
+	for i := range history {
+		msg := history[i]
+		if msg.TurnCount == match.TurnCount && msg.Role == domain.MessageRoleAssistant {
+			stale = append(stale, msg.ID)
+			continue
+		}
+		if msg.TurnCount == match.TurnCount && msg.Role == domain.MessageRoleUser {
+			userMsg = &history[i]
+		}
+		retained = append(retained, msg)
+	}
+
+	// 동시에 들어온 재시도 중 하나만 매치를 잠글 수 있고, 잠금과 남은 답변 삭제는 함께 커밋됨
+	// 답할 메시지가 없으면 재시도할 턴이 없으므로 매치를 다시 진행 가능한 상태로 돌림
+	locked := *match
+	locked.Status = domain.MatchStatusGenerating
+	if userMsg == nil {
+		locked.Status = domain.MatchStatusActive
+	}
+	err = uc.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		if _, err := uc.matchRepo.CompareAndSet(ctx, &locked, domain.MatchStatusError); err != nil {
+			return fmt.Errorf("failed to lock match state: %w", err)
+		}
+		for _, id := range stale {
+			if err := uc.messageRepo.Delete(ctx, id); err != nil {
+				return fmt.Errorf("failed to delete stale ai message: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	*match = locked
+	if userMsg == nil {
+		return nil, fmt.Errorf("%w: the failed turn has no message to answer", domain.ErrConflict)
+	}
+
+	// 재시도가 실패하면 다시 재시도할 수 있도록 error 상태로 복구
+	defer uc.failTurn(ctx, match)
+
+	return uc.completeTurn(ctx, match, game, models, userMsg, retained, nil, func(ctx context.Context, chatLLM domain.LLMService, history []domain.Message) (*domain.LLMResponse, error) {
+		return chatLLM.GenerateResponse(ctx, history)
+	})
+}
+
+// resolveModels selects the chat LLM, the judge LLMs and the judge a game is played with
+func (uc *messageUseCase) resolveModels(game *domain.Game) (*turnModels, error) {
+	chatLLM, err := uc.llmRegistry.Chat(game.ChatModel, game.ChatParams())
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve chat model: %w", err)
+	}
+	judgeLLM, err := uc.llmRegistry.Judge(game.JudgeModel)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve judge model: %w", err)
+	}
+	panel, err := resolveJudgePanel(uc.llmRegistry, game.JudgePanel)
+	if err != nil {
+		return nil, err
+	}
+	judge, err := uc.judgeRegistry.Get(game.JudgeType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve judge: %w", err)
+	}
+	return &turnModels{chatLLM: chatLLM, judgeLLM: judgeLLM, panel: panel, judge: judge}, nil
+}
+
+// completeTurn answers userMsg of a match locked in generating: it generates the AI reply with generate,
+// then judges the reply and produces prompt advice concurrently. history is the conversation up to userMsg.
+// The messages and the match are saved in one transaction once the turn is judged, with settle if it is set;
+// a failure leaves the match generating for the caller to settle.
+func (uc *messageUseCase) completeTurn(ctx context.Context, match *domain.Match, game *domain.Game, models *turnModels, userMsg *domain.Message, history []domain.Message, settle settleFunc, generate generateFunc) (*domain.TurnResult, error) {
+	matchID := match.ID
+	currentTurn := userMsg.TurnCount
+	judgeLLM := models.judgeLLM
+
+	// 이번 턴의 LLM 호출을 매치와 유저 메시지 기준으로 감사 로그에 기록
+	ctx = contexts.WithLLMCallScope(ctx, matchID, userMsg.ID)
 
 	fullHistory := make([]domain.Message, 0, len(history)+1)
 	fullHistory = append(fullHistory, domain.Message{
 		Role:    domain.MessageRoleSystem,
-		Content: game.SystemPrompt,
+		Content: prompt.Render(game.SystemPrompt, match.Secrets),
 	})
 	fullHistory = append(fullHistory, history...)
 
 	// ==========================================
 	// 4. 외부 LLM 연동 및 결과 처리
 	// ==========================================
-	aiContent, promptTokens, completionTokens, err := uc.llmService.GenerateResponse(ctx, fullHistory)
+	reply, err := generate(ctx, models.chatLLM, fullHistory)
 	if err != nil {
-		match.Status = domain.MatchStatusError
-		if _, updateErr := uc.matchRepo.Update(context.WithoutCancel(ctx), match); updateErr != nil {
-			return nil, fmt.Errorf("llm failed and status update also failed: %v (original: %w)", updateErr, err)
-		}
 		return nil, fmt.Errorf("llm failed to generate response: %w", err)
 	}
 
-	// 유저 메시지 토큰 업데이트
+	aiContent, promptTokens, completionTokens := reply.Content, reply.PromptTokens, reply.CompletionTokens
+
+	// 유저 메시지 토큰 (턴 결과와 함께 저장)
 	userMsg.TokenCount = promptTokens
-	if _, err := uc.messageRepo.Update(ctx, userMsg); err != nil {
-		// 에러를 무시하고 진행 (핵심 로직이 아니므로)
-	}
 
-	// AI 메시지 저장
+	// AI 메시지 (판정이 끝난 뒤 턴 결과와 함께 저장)
 	aiMsg := &domain.Message{
 		MatchID:    matchID,
 		Role:       domain.MessageRoleAssistant,
@@ -136,19 +419,20 @@ func (uc *messageUseCase) Create(ctx context.Context, matchID string, userID str
 		IsVisible:  true,
 		TurnCount:  currentTurn,
 		TokenCount: completionTokens,
-	}
-	savedAIMsg, err := uc.messageRepo.Create(ctx, aiMsg)
-	if err != nil {
-		return nil, fmt.Errorf("failed to save ai message: %w", err)
+		Provider:   reply.Provider,
+		Model:      reply.Model,
 	}
 
 	// ==========================================
 	// 5. 비동기 판정 및 훈수 처리 (Concurrent Evaluation)
 	// ==========================================
-	match.TotalTokens += promptTokens
-	
 	nextStatus := domain.MatchStatusActive
 	var promptAdvice string
+	var turnVerdict *domain.TurnVerdict
+	var judgeProgress *domain.JudgeProgress
+
+	// 매치별 시크릿으로 채운 판정 조건 (게임의 조건은 템플릿일 수 있음)
+	condition := prompt.RenderCondition(game.JudgeType, game.JudgeCondition, match.Secrets)
 
 	// Use errgroup for concurrent execution
 	// Create a new context for goroutines to avoid early cancellation if the parent request is already ending
@@ -163,24 +447,52 @@ func (uc *messageUseCase) Create(ctx context.Context, matchID string, userID str
 	eg.Go(func() error {
 		status := domain.MatchStatusActive
 
-		if game.JudgeType == domain.JudgeTypeTargetWord && game.JudgeCondition != "" {
-			if strings.Contains(strings.ToLower(aiContent), strings.ToLower(game.JudgeCondition)) {
-				status = domain.MatchStatusWon
-			}
-		} else if game.JudgeType == domain.JudgeTypeLLMJudge && game.JudgeCondition != "" {
-			evaluationHistory := []domain.Message{*aiMsg}
-			isWon, _, _, evalErr := uc.judgeLLMService.EvaluateWinCondition(egCtx, game.JudgeCondition, evaluationHistory)
-			if evalErr != nil {
-				fmt.Printf("failed to evaluate win condition: %v\n", evalErr)
-			} else if isWon {
-				status = domain.MatchStatusWon
-			}
-		} else if game.JudgeType == domain.JudgeTypeFormatBreak && game.JudgeCondition != "" {
-			isBroken, evalErr := uc.judgeLLMService.EvaluateFormatBreak(egCtx, game.JudgeCondition, aiContent)
-			if evalErr != nil {
-				fmt.Printf("failed to evaluate format break condition: %v\n", evalErr)
-			} else if isBroken {
+		verdict, evalErr := models.judge.Evaluate(egCtx, domain.JudgeInput{
+			Condition: condition,
+			Reply:     aiMsg,
+			History:   append(slices.Clone(history), *aiMsg),
+			LLM:       judgeLLM,
+			Panel:     models.panel,
+			Policy:    game.JudgePolicy,
+			Quorum:    game.JudgeQuorum,
+
+			Strictness: game.JudgeStrictness,
+			Scope:      game.JudgeScope,
+			ScopeTurns: game.JudgeScopeTurns,
+			Progress:   match.JudgeProgress,
+		})
+		turnVerdict = &domain.TurnVerdict{
+			MatchID:   matchID,
+			TurnCount: currentTurn,
+			JudgeType: game.JudgeType,
+			Outcome:   domain.JudgeOutcomeContinue,
+		}
+		if evalErr != nil {
+			contexts.GetLogger(ctx).Warn("failed to judge turn",
+				"match_id", matchID,
+				"turn_count", currentTurn,
+				"judge_type", game.JudgeType,
+				"error", evalErr,
+			)
+			turnVerdict.Reason = "the judge could not decide this turn"
+			turnVerdict.Error = evalErr.Error()
+		} else {
+			turnVerdict.Outcome = verdict.Outcome
+			turnVerdict.Reason = verdict.Reason
+			turnVerdict.Output = verdict.Output
+			turnVerdict.Votes = verdict.Votes
+			turnVerdict.Match = verdict.Match
+			turnVerdict.Leaves = verdict.Leaves
+			turnVerdict.Progress = verdict.Progress
+			judgeProgress = verdict.Progress
+			turnVerdict.PromptTokens = verdict.PromptTokens
+			turnVerdict.CompletionTokens = verdict.CompletionTokens
+
+			switch verdict.Outcome {
+			case domain.JudgeOutcomeWon:
 				status = domain.MatchStatusWon
+			case domain.JudgeOutcomeLost:
+				status = domain.MatchStatusLost
 			}
 		}
 
@@ -194,9 +506,13 @@ func (uc *messageUseCase) Create(ctx context.Context, matchID string, userID str
 
 	// 5-2. 훈수 고루틴 (Prompt Advice)
 	eg.Go(func() error {
-		advice, evalErr := uc.judgeLLMService.EvaluatePromptAdvice(egCtx, game.JudgeCondition, req.Content, aiContent)
+		advice, evalErr := judgeLLM.EvaluatePromptAdvice(egCtx, condition, userMsg.Content, aiContent)
 		if evalErr != nil {
-			fmt.Printf("failed to evaluate prompt advice: %v\n", evalErr)
+			contexts.GetLogger(ctx).Warn("failed to evaluate prompt advice",
+				"match_id", matchID,
+				"turn_count", currentTurn,
+				"error", evalErr,
+			)
 		} else {
 			promptAdvice = advice
 		}
@@ -205,25 +521,66 @@ func (uc *messageUseCase) Create(ctx context.Context, matchID string, userID str
 
 	// 5-3. 대기 및 최종 반영
 	if err := eg.Wait(); err != nil {
-		fmt.Printf("concurrent evaluation error: %v\n", err)
+		contexts.GetLogger(ctx).Warn("concurrent evaluation error",
+			"match_id", matchID,
+			"turn_count", currentTurn,
+			"error", err,
+		)
 	}
 
-	// 훈수 업데이트
+	// 훈수 반영
 	if promptAdvice != "" {
 		userMsg.PromptAdvice = &promptAdvice
-		if _, updateErr := uc.messageRepo.Update(ctx, userMsg); updateErr != nil {
-			fmt.Printf("failed to update user message with advice: %v\n", updateErr)
-		}
 	}
 
-	match.Status = nextStatus
-	if _, err := uc.matchRepo.Update(ctx, match); err != nil {
-		match.Status = domain.MatchStatusError
-		_, _ = uc.matchRepo.Update(context.WithoutCancel(ctx), match)
-		return nil, fmt.Errorf("failed to update final match status: %w", err)
+	// ==========================================
+	// 6. 턴 결과 저장 (Unit of Work)
+	// ==========================================
+	// 유저 메시지, AI 메시지, 판정 근거, 매치 상태를 한 트랜잭션으로 저장해 일부만 반영되지 않게 함
+	// 실패하면 매치는 generating으로 남고 호출한 쪽의 안전장치가 정리
+	settled := *match
+	settled.Status = nextStatus
+	settled.TotalTokens += promptTokens
+	if judgeProgress != nil {
+		settled.JudgeProgress = judgeProgress
+	}
+	var result *domain.TurnResult
+	err = uc.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		if _, err := uc.messageRepo.Update(ctx, userMsg); err != nil {
+			return fmt.Errorf("failed to update user message: %w", err)
+		}
+		savedAIMsg, err := uc.messageRepo.Create(ctx, aiMsg)
+		if err != nil {
+			return fmt.Errorf("failed to save ai message: %w", err)
+		}
+		turnVerdict.MessageID = savedAIMsg.ID
+		if _, err := uc.verdictRepo.Create(ctx, turnVerdict); err != nil {
+			return fmt.Errorf("failed to save turn verdict: %w", err)
+		}
+		if _, err := uc.matchRepo.CompareAndSet(ctx, &settled, domain.MatchStatusGenerating); err != nil {
+			return fmt.Errorf("failed to update final match status: %w", err)
+		}
+
+		result = &domain.TurnResult{
+			Message:       savedAIMsg,
+			MatchStatus:   settled.Status,
+			PromptAdvice:  userMsg.PromptAdvice,
+			JudgeProgress: settled.JudgeProgress,
+		}
+		if settled.Status != domain.MatchStatusActive {
+			result.Verdict = turnVerdict
+		}
+		if settle != nil {
+			return settle(ctx, result)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
+	*match = settled
 
-	return savedAIMsg, nil
+	return result, nil
 }
 
 func (uc *messageUseCase) GetByID(ctx context.Context, id string) (*domain.Message, error) {
@@ -0,0 +1,105 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/everyday-studio/ollm/internal/config"
+	"github.com/everyday-studio/ollm/internal/domain"
+)
+
+type quotaUseCase struct {
+	quotaRepo domain.QuotaRepository
+	userRepo  domain.UserRepository
+	config    config.QuotaConfig
+	now       func() time.Time
+}
+
+// NewQuotaUseCase creates a new quota usecase enforcing the limits of cfg.Quota
+func NewQuotaUseCase(quotaRepo domain.QuotaRepository, userRepo domain.UserRepository, cfg *config.Config) domain.QuotaUseCase {
+	return &quotaUseCase{
+		quotaRepo: quotaRepo,
+		userRepo:  userRepo,
+		config:    cfg.Quota,
+		now:       time.Now,
+	}
+}
+
+// startOfDay returns 00:00 UTC of the current day, when daily quotas reset.
+func (uc *quotaUseCase) startOfDay() time.Time {
+	return uc.now().UTC().Truncate(24 * time.Hour)
+}
+
+// limitFor returns the daily limit of the user: the guest limit for guest accounts, otherwise the limit of the role.
+func (uc *quotaUseCase) limitFor(user *domain.User) config.QuotaLimitConfig {
+	if user.IsGuest() {
+		return uc.config.Guest
+	}
+	return uc.config.Roles[strings.ToLower(string(user.Role))]
+}
+
+func remaining(limit, used int) *int {
+	if limit <= 0 {
+		return nil
+	}
+	left := max(limit-used, 0)
+	return &left
+}
+
+// Get returns the daily quota of the user with today's usage.
+func (uc *quotaUseCase) Get(ctx context.Context, userID string) (*domain.Quota, error) {
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user for quota: %w", err)
+	}
+
+	since := uc.startOfDay()
+	limit := uc.limitFor(user)
+
+	usage := &domain.QuotaUsage{}
+	if limit.DailyTokens > 0 || limit.DailyTurns > 0 {
+		usage, err = uc.quotaRepo.GetUserUsage(ctx, userID, since)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get quota usage: %w", err)
+		}
+	}
+
+	return &domain.Quota{
+		DailyTokens:     limit.DailyTokens,
+		DailyTurns:      limit.DailyTurns,
+		UsedTokens:      usage.Tokens,
+		UsedTurns:       usage.Turns,
+		RemainingTokens: remaining(limit.DailyTokens, usage.Tokens),
+		RemainingTurns:  remaining(limit.DailyTurns, usage.Turns),
+		ResetsAt:        since.Add(24 * time.Hour),
+	}, nil
+}
+
+// Check rejects the user once the platform's daily spend cap or the user's own daily quota is used up.
+func (uc *quotaUseCase) Check(ctx context.Context, userID string) error {
+	if uc.config.DailySpendCapUSD > 0 {
+		spend, err := uc.quotaRepo.GetSpendSince(ctx, uc.startOfDay())
+		if err != nil {
+			return fmt.Errorf("failed to get platform spend: %w", err)
+		}
+		if spend >= uc.config.DailySpendCapUSD {
+			return fmt.Errorf("%w: the platform's daily LLM budget is used up, try again tomorrow", domain.ErrQuotaExceeded)
+		}
+	}
+
+	quota, err := uc.Get(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case quota.RemainingTurns != nil && *quota.RemainingTurns == 0:
+		return fmt.Errorf("%w: daily limit of %d turns reached", domain.ErrQuotaExceeded, quota.DailyTurns)
+	case quota.RemainingTokens != nil && *quota.RemainingTokens == 0:
+		return fmt.Errorf("%w: daily limit of %d tokens reached", domain.ErrQuotaExceeded, quota.DailyTokens)
+	}
+
+	return nil
+}
@@ -0,0 +1,156 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/everyday-studio/ollm/internal/config"
+	"github.com/everyday-studio/ollm/internal/domain"
+	"github.com/everyday-studio/ollm/internal/domain/mocks"
+)
+
+var (
+	quotaNow        = time.Date(2026, 4, 1, 15, 30, 0, 0, time.UTC)
+	quotaStartOfDay = time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+)
+
+func testQuotaConfig() *config.Config {
+	return &config.Config{
+		Quota: config.QuotaConfig{
+			DailySpendCapUSD: 10,
+			Guest:            config.QuotaLimitConfig{DailyTokens: 1000, DailyTurns: 5},
+			Roles: map[string]config.QuotaLimitConfig{
+				"user": {DailyTokens: 10000, DailyTurns: 50},
+			},
+		},
+	}
+}
+
+func newTestQuotaUseCase(quotaRepo domain.QuotaRepository, userRepo domain.UserRepository, cfg *config.Config) *quotaUseCase {
+	uc := NewQuotaUseCase(quotaRepo, userRepo, cfg).(*quotaUseCase)
+	uc.now = func() time.Time { return quotaNow }
+	return uc
+}
+
+func TestQuotaUseCase_Get(t *testing.T) {
+	guest := &domain.User{ID: "guest-1", Email: "guest_abc@ollm.xyz", Role: domain.RoleUser}
+	user := &domain.User{ID: "user-1", Email: "john@example.com", Role: domain.RoleUser}
+	admin := &domain.User{ID: "admin-1", Email: "admin@example.com", Role: domain.RoleAdmin}
+
+	tests := []struct {
+		name          string
+		user          *domain.User
+		usage         *domain.QuotaUsage
+		wantTokens    int
+		wantTurns     int
+		wantRemaining *int
+	}{
+		{
+			name:          "Apply the guest limit to guest accounts",
+			user:          guest,
+			usage:         &domain.QuotaUsage{Tokens: 400, Turns: 2},
+			wantTokens:    1000,
+			wantTurns:     5,
+			wantRemaining: intPtr(600),
+		},
+		{
+			name:          "Apply the role limit to registered users",
+			user:          user,
+			usage:         &domain.QuotaUsage{Tokens: 12000, Turns: 10},
+			wantTokens:    10000,
+			wantTurns:     50,
+			wantRemaining: intPtr(0),
+		},
+		{
+			name:       "Leave roles without a limit unlimited",
+			user:       admin,
+			wantTokens: 0,
+			wantTurns:  0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			quotaRepo := new(mocks.QuotaRepository)
+			userRepo := new(mocks.UserRepository)
+			userRepo.On("GetByID", mock.Anything, tt.user.ID).Return(tt.user, nil)
+			if tt.usage != nil {
+				quotaRepo.On("GetUserUsage", mock.Anything, tt.user.ID, quotaStartOfDay).Return(tt.usage, nil)
+			}
+			uc := newTestQuotaUseCase(quotaRepo, userRepo, testQuotaConfig())
+
+			quota, err := uc.Get(context.Background(), tt.user.ID)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantTokens, quota.DailyTokens)
+			assert.Equal(t, tt.wantTurns, quota.DailyTurns)
+			assert.Equal(t, tt.wantRemaining, quota.RemainingTokens)
+			assert.Equal(t, quotaStartOfDay.Add(24*time.Hour), quota.ResetsAt)
+			quotaRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestQuotaUseCase_Check(t *testing.T) {
+	user := &domain.User{ID: "user-1", Email: "john@example.com", Role: domain.RoleUser}
+
+	tests := []struct {
+		name    string
+		spend   float64
+		usage   *domain.QuotaUsage
+		wantErr error
+	}{
+		{
+			name:  "Allow users within their quota",
+			spend: 3.5,
+			usage: &domain.QuotaUsage{Tokens: 5000, Turns: 20},
+		},
+		{
+			name:    "Reject everyone once the platform spend cap is reached",
+			spend:   10,
+			wantErr: domain.ErrQuotaExceeded,
+		},
+		{
+			name:    "Reject users who used all their turns",
+			spend:   1,
+			usage:   &domain.QuotaUsage{Tokens: 5000, Turns: 50},
+			wantErr: domain.ErrQuotaExceeded,
+		},
+		{
+			name:    "Reject users who used all their tokens",
+			spend:   1,
+			usage:   &domain.QuotaUsage{Tokens: 10500, Turns: 20},
+			wantErr: domain.ErrQuotaExceeded,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			quotaRepo := new(mocks.QuotaRepository)
+			userRepo := new(mocks.UserRepository)
+			quotaRepo.On("GetSpendSince", mock.Anything, quotaStartOfDay).Return(tt.spend, nil)
+			userRepo.On("GetByID", mock.Anything, user.ID).Return(user, nil).Maybe()
+			if tt.usage != nil {
+				quotaRepo.On("GetUserUsage", mock.Anything, user.ID, quotaStartOfDay).Return(tt.usage, nil)
+			}
+			uc := newTestQuotaUseCase(quotaRepo, userRepo, testQuotaConfig())
+
+			err := uc.Check(context.Background(), user.ID)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			quotaRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func intPtr(v int) *int {
+	return &v
+}
@@ -0,0 +1,95 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/everyday-studio/ollm/internal/config"
+	"github.com/everyday-studio/ollm/internal/domain"
+)
+
+const (
+	defaultIdleTTL           = 24 * time.Hour
+	defaultGeneratingTimeout = 5 * time.Minute
+	defaultSweepBatchSize    = 100
+	// Keys are only replayed to clients retrying a turn, which give up long before this
+	defaultIdempotencyRetention = 24 * time.Hour
+	defaultPurgeBatchSize       = 1000
+)
+
+type sweeperUseCase struct {
+	matchRepo            domain.MatchRepository
+	idempotencyRepo      domain.IdempotencyRepository
+	idleTTL              time.Duration
+	generatingTimeout    time.Duration
+	batchSize            int
+	idempotencyRetention time.Duration
+	purgeBatchSize       int
+}
+
+// NewSweeperUseCase creates a new sweeper usecase tuned by cfg.Jobs.Sweeper and cfg.Jobs.IdempotencyPurge
+func NewSweeperUseCase(matchRepo domain.MatchRepository, idempotencyRepo domain.IdempotencyRepository, cfg *config.Config) domain.SweeperUseCase {
+	uc := &sweeperUseCase{
+		matchRepo:            matchRepo,
+		idempotencyRepo:      idempotencyRepo,
+		idleTTL:              defaultIdleTTL,
+		generatingTimeout:    generatingTimeout(cfg),
+		batchSize:            defaultSweepBatchSize,
+		idempotencyRetention: defaultIdempotencyRetention,
+		purgeBatchSize:       defaultPurgeBatchSize,
+	}
+
+	sweeper := cfg.Jobs.Sweeper
+	if sweeper.IdleTTLMin > 0 {
+		uc.idleTTL = time.Duration(sweeper.IdleTTLMin) * time.Minute
+	}
+	if sweeper.BatchSize > 0 {
+		uc.batchSize = sweeper.BatchSize
+	}
+
+	purge := cfg.Jobs.IdempotencyPurge
+	if purge.RetentionHours > 0 {
+		uc.idempotencyRetention = time.Duration(purge.RetentionHours) * time.Hour
+	}
+	if purge.BatchSize > 0 {
+		uc.purgeBatchSize = purge.BatchSize
+	}
+
+	return uc
+}
+
+// generatingTimeout is how long a turn can generate before the sweeper recovers its match
+func generatingTimeout(cfg *config.Config) time.Duration {
+	if cfg.Jobs.Sweeper.GeneratingTimeoutSec > 0 {
+		return time.Duration(cfg.Jobs.Sweeper.GeneratingTimeoutSec) * time.Second
+	}
+	return defaultGeneratingTimeout
+}
+
+// ExpireIdle expires one batch of active matches left idle past their game's TTL
+func (uc *sweeperUseCase) ExpireIdle(ctx context.Context) ([]domain.Match, error) {
+	matches, err := uc.matchRepo.ExpireIdle(ctx, uc.idleTTL, uc.batchSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to expire idle matches: %w", err)
+	}
+	return matches, nil
+}
+
+// RecoverStuck recovers one batch of matches generating for longer than the timeout
+func (uc *sweeperUseCase) RecoverStuck(ctx context.Context) ([]domain.Match, error) {
+	matches, err := uc.matchRepo.RecoverStuck(ctx, uc.generatingTimeout, uc.batchSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to recover stuck matches: %w", err)
+	}
+	return matches, nil
+}
+
+// PurgeIdempotencyKeys deletes one batch of idempotency keys older than the retention
+func (uc *sweeperUseCase) PurgeIdempotencyKeys(ctx context.Context) (int64, error) {
+	purged, err := uc.idempotencyRepo.Purge(ctx, time.Now().Add(-uc.idempotencyRetention), uc.purgeBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge idempotency keys: %w", err)
+	}
+	return purged, nil
+}
@@ -0,0 +1,154 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/everyday-studio/ollm/internal/config"
+	"github.com/everyday-studio/ollm/internal/domain"
+	"github.com/everyday-studio/ollm/internal/domain/mocks"
+)
+
+func TestSweeperUseCase_ExpireIdle(t *testing.T) {
+	tests := []struct {
+		name      string
+		cfg       config.SweeperConfig
+		wantTTL   time.Duration
+		wantLimit int
+		repoErr   error
+		wantErr   error
+	}{
+		{
+			name:      "Use the defaults when unset",
+			wantTTL:   24 * time.Hour,
+			wantLimit: 100,
+		},
+		{
+			name:      "Use the configured TTL and batch size",
+			cfg:       config.SweeperConfig{IdleTTLMin: 30, BatchSize: 10},
+			wantTTL:   30 * time.Minute,
+			wantLimit: 10,
+		},
+		{
+			name:      "Propagate repository errors",
+			wantTTL:   24 * time.Hour,
+			wantLimit: 100,
+			repoErr:   domain.ErrInternal,
+			wantErr:   domain.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockMatchRepo := new(mocks.MatchRepository)
+			expired := []domain.Match{{ID: "M1", Status: domain.MatchStatusExpired}}
+			if tt.repoErr != nil {
+				expired = nil
+			}
+			mockMatchRepo.On("ExpireIdle", mock.Anything, tt.wantTTL, tt.wantLimit).Return(expired, tt.repoErr)
+
+			uc := NewSweeperUseCase(mockMatchRepo, nil, &config.Config{Jobs: config.JobsConfig{Sweeper: tt.cfg}})
+			matches, err := uc.ExpireIdle(context.Background())
+
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, expired, matches)
+			mockMatchRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestSweeperUseCase_RecoverStuck(t *testing.T) {
+	tests := []struct {
+		name        string
+		cfg         config.SweeperConfig
+		wantTimeout time.Duration
+		wantLimit   int
+	}{
+		{
+			name:        "Use the defaults when unset",
+			wantTimeout: 5 * time.Minute,
+			wantLimit:   100,
+		},
+		{
+			name:        "Use the configured timeout",
+			cfg:         config.SweeperConfig{GeneratingTimeoutSec: 90},
+			wantTimeout: 90 * time.Second,
+			wantLimit:   100,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockMatchRepo := new(mocks.MatchRepository)
+			recovered := []domain.Match{{ID: "M1", Status: domain.MatchStatusError}}
+			mockMatchRepo.On("RecoverStuck", mock.Anything, tt.wantTimeout, tt.wantLimit).Return(recovered, nil)
+
+			uc := NewSweeperUseCase(mockMatchRepo, nil, &config.Config{Jobs: config.JobsConfig{Sweeper: tt.cfg}})
+			matches, err := uc.RecoverStuck(context.Background())
+
+			assert.NoError(t, err)
+			assert.Equal(t, recovered, matches)
+			mockMatchRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestSweeperUseCase_PurgeIdempotencyKeys(t *testing.T) {
+	tests := []struct {
+		name          string
+		cfg           config.IdempotencyPurgeConfig
+		wantRetention time.Duration
+		wantLimit     int
+		repoErr       error
+		wantErr       error
+	}{
+		{
+			name:          "Use the defaults when unset",
+			wantRetention: 24 * time.Hour,
+			wantLimit:     1000,
+		},
+		{
+			name:          "Use the configured retention and batch size",
+			cfg:           config.IdempotencyPurgeConfig{RetentionHours: 2, BatchSize: 50},
+			wantRetention: 2 * time.Hour,
+			wantLimit:     50,
+		},
+		{
+			name:          "Propagate repository errors",
+			wantRetention: 24 * time.Hour,
+			wantLimit:     1000,
+			repoErr:       domain.ErrInternal,
+			wantErr:       domain.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockIdempotencyRepo := new(mocks.IdempotencyRepository)
+			now := time.Now()
+			mockIdempotencyRepo.On("Purge", mock.Anything, mock.MatchedBy(func(before time.Time) bool {
+				return before.Sub(now.Add(-tt.wantRetention)).Abs() < time.Minute
+			}), tt.wantLimit).Return(int64(3), tt.repoErr)
+
+			uc := NewSweeperUseCase(nil, mockIdempotencyRepo, &config.Config{Jobs: config.JobsConfig{IdempotencyPurge: tt.cfg}})
+			purged, err := uc.PurgeIdempotencyKeys(context.Background())
+
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, int64(3), purged)
+			mockIdempotencyRepo.AssertExpectations(t)
+		})
+	}
+}
@@ -1,6 +1,12 @@
 // src/lib/features/game/api.ts
 import client from '$lib/api/client';
-import type { GameDTO, MatchDTO, LeaderboardEntry, PaginatedResponse } from './types';
+import type {
+  GameDTO,
+  MatchDTO,
+  LeaderboardEntry,
+  PaginatedResponse,
+  TurnVerdict
+} from './types';
 
 export const gameApi = {
   // GET /games - Fetch all games (large limit to bypass default pagination)
@@ -21,6 +27,9 @@ export const gameApi = {
   // GET /matches/:id - Fetch a single match by ID
   getMatchById: (id: string) => client.get<MatchDTO>(`/api/matches/${id}`),
 
+  // GET /matches/:id/verdicts - Fetch the judge verdict of every turn (only once the match is over)
+  getVerdicts: (id: string) => client.get<TurnVerdict[]>(`/api/matches/${id}/verdicts`),
+
   // POST /matches/:id/resign - Resign from a match
   resignMatch: (id: string) => client.post<{ message: string }>(`/api/matches/${id}/resign`),
 
@@ -0,0 +1,12 @@
+// src/lib/features/game/appealApi.ts
+import client from '$lib/api/client';
+import type { Appeal, CreateAppealRequest } from './types';
+
+export const appealApi = {
+  // POST /matches/:matchId/appeals - Dispute the verdict of a turn of a finished match
+  create: (matchId: string, req: CreateAppealRequest) =>
+    client.post<Appeal>(`/api/matches/${matchId}/appeals`, req),
+
+  // GET /matches/:matchId/appeals - Fetch the appeals of a match with their resolutions
+  getByMatch: (matchId: string) => client.get<Appeal[]>(`/api/matches/${matchId}/appeals`)
+};
@@ -1,11 +1,114 @@
 // src/lib/features/game/messageApi.ts
+import { get } from 'svelte/store';
 import client from '$lib/api/client';
-import type { MessageDTO, CreateMessageRequest } from './types';
+import { authStore } from '$lib/features/auth/model';
+import type { MessageDTO, CreateMessageRequest, TurnResult } from './types';
+
+// Thrown by streamMessage, shaped like an axios error so callers can map statuses the same way.
+// answered is false when the stream broke before the server settled the turn, so it can be re-sent.
+export class StreamError extends Error {
+  answered: boolean;
+  response?: { status: number; data?: { error?: string } };
+
+  constructor(message: string, answered: boolean, status?: number, data?: { error?: string }) {
+    super(message);
+    this.name = 'StreamError';
+    this.answered = answered;
+    if (status !== undefined) {
+      this.response = { status, data };
+    }
+  }
+}
+
+// Parses one Server-Sent Event block ("event: ...\ndata: ...")
+function parseEvent(block: string): { event: string; data: string } {
+  let event = 'message';
+  const data: string[] = [];
+  for (const line of block.split('\n')) {
+    if (line.startsWith('event:')) {
+      event = line.slice(6).trim();
+    } else if (line.startsWith('data:')) {
+      data.push(line.slice(5).trimStart());
+    }
+  }
+  return { event, data: data.join('\n') };
+}
 
 export const messageApi = {
   // POST /matches/:matchId/messages - Send user message and receive AI response
-  sendMessage: (matchId: string, req: CreateMessageRequest) =>
-    client.post<MessageDTO>(`/api/matches/${matchId}/messages`, req),
+  // The idempotency key makes a re-sent turn replay the first reply instead of playing twice
+  sendMessage: (matchId: string, req: CreateMessageRequest, idempotencyKey: string) =>
+    client.post<MessageDTO>(`/api/matches/${matchId}/messages`, req, {
+      headers: { 'Idempotency-Key': idempotencyKey }
+    }),
+
+  // POST /matches/:matchId/messages/stream - Send user message and stream the AI response
+  // onDelta receives each chunk of the reply; resolves with the turn result of the "done" event.
+  // axios can't read a response as it arrives, so this uses fetch and doesn't refresh an expired token.
+  streamMessage: async (
+    matchId: string,
+    req: CreateMessageRequest,
+    idempotencyKey: string,
+    onDelta: (content: string) => void
+  ): Promise<TurnResult> => {
+    const headers: Record<string, string> = {
+      'Content-Type': 'application/json',
+      Accept: 'text/event-stream',
+      'Idempotency-Key': idempotencyKey
+    };
+    const { accessToken } = get(authStore);
+    if (accessToken) {
+      headers.Authorization = `Bearer ${accessToken}`;
+    }
+
+    const res = await fetch(`${client.defaults.baseURL}/api/matches/${matchId}/messages/stream`, {
+      method: 'POST',
+      headers,
+      body: JSON.stringify(req),
+      credentials: 'include'
+    });
+
+    // Errors before the first event come back as a regular JSON response
+    if (!res.ok) {
+      const data = await res.json().catch(() => undefined);
+      const message = data?.error ?? `stream failed with status ${res.status}`;
+      throw new StreamError(message, true, res.status, data);
+    }
+    // A success that isn't a stream (e.g. rewritten by a proxy) can't be read as one
+    if (!res.body || !res.headers.get('Content-Type')?.includes('text/event-stream')) {
+      throw new StreamError('response is not an event stream', false);
+    }
+
+    const reader = res.body.pipeThrough(new TextDecoderStream()).getReader();
+    let buffer = '';
+    for (;;) {
+      const { value, done } = await reader.read();
+      if (done) break;
+      buffer += value.replace(/\r\n/g, '\n');
+
+      let boundary: number;
+      while ((boundary = buffer.indexOf('\n\n')) !== -1) {
+        const { event, data } = parseEvent(buffer.slice(0, boundary));
+        buffer = buffer.slice(boundary + 2);
+
+        if (event === 'delta') {
+          onDelta(JSON.parse(data).content);
+        } else if (event === 'done') {
+          await reader.cancel();
+          return JSON.parse(data) as TurnResult;
+        } else if (event === 'error') {
+          await reader.cancel();
+          // The turn failed after the stream started, so the server left the match in error status
+          throw new StreamError(JSON.parse(data).error, true);
+        }
+      }
+    }
+
+    throw new StreamError('stream closed before the turn finished', false);
+  },
+
+  // POST /matches/:matchId/retry - Regenerate the reply of the failed turn of a match in error status
+  retryTurn: (matchId: string) => client.post<TurnResult>(`/api/matches/${matchId}/retry`),
 
   // GET /matches/:matchId/messages - Fetch conversation history
   getHistory: (matchId: string) =>
@@ -17,6 +17,12 @@ export interface GameDTO {
   updated_at: string;
 }
 
+// [Backend DTO] How far a match has come towards a judge condition with several goals
+export interface JudgeProgress {
+  achieved: string[]; // goals met so far, in the order they were met
+  total: number;
+}
+
 export type MatchStatus = 'active' | 'generating' | 'won' | 'lost' | 'resigned' | 'expired' | 'error';
 
 export interface MatchDTO {
@@ -27,6 +33,7 @@ export interface MatchDTO {
   max_turns: number;
   total_tokens: number;
   turn_count: number;
+  judge_progress?: JudgeProgress; // only for judge conditions with several goals
   created_at: string;
   updated_at: string;
 }
@@ -49,6 +56,92 @@ export interface CreateMessageRequest {
   content: string;
 }
 
+// [Backend DTO] Outcome of a played turn, sent with the "done" event of a streamed turn
+export interface TurnResult {
+  message: MessageDTO;
+  match_status: MatchStatus;
+  prompt_advice?: string | null;
+  judge_progress?: JudgeProgress;
+}
+
+export type JudgeType =
+  | 'target_word'
+  | 'llm_judge'
+  | 'format_break'
+  | 'regex'
+  | 'json_schema'
+  | 'composite';
+
+export type JudgeOutcome = 'continue' | 'won' | 'lost';
+
+// [Backend DTO] Ruling of one judge model of a consensus panel
+export interface JudgeVote {
+  model: string;
+  result: boolean;
+  reason: string;
+  failed?: boolean;
+}
+
+// [Backend DTO] Where a judge found its target in the AI reply
+export interface JudgeMatch {
+  text: string;
+  start: number; // byte offsets in the reply
+  end: number;
+  transformations?: string[]; // e.g. "base64"
+}
+
+// [Backend DTO] Verdict of one leaf judge of a composite condition
+export interface JudgeLeaf {
+  leaf: number;
+  judge_type: JudgeType;
+  outcome: JudgeOutcome;
+  reason: string;
+  votes?: JudgeVote[];
+  match?: JudgeMatch;
+  failed?: boolean;
+}
+
+// [Backend DTO] Recorded judge verdict of a turn (players see them once the match is over)
+export interface TurnVerdict {
+  id: string; // ULID
+  match_id: string;
+  message_id: string; // AI reply that was judged
+  turn_count: number;
+  judge_type: JudgeType;
+  outcome: JudgeOutcome;
+  reason: string;
+  output?: string;
+  votes?: JudgeVote[];
+  match?: JudgeMatch;
+  leaves?: JudgeLeaf[];
+  progress?: JudgeProgress; // progress of the match after this turn
+  prompt_tokens: number;
+  completion_tokens: number;
+  created_at: string;
+}
+
+export type AppealStatus = 'pending' | 'upheld' | 'overturned';
+
+// [Backend DTO] Player's dispute of the verdict of a turn of a finished match
+export interface Appeal {
+  id: string; // ULID
+  match_id: string;
+  user_id: string;
+  verdict_id: string; // verdict of the turn that is disputed
+  turn_count: number;
+  comment: string;
+  status: AppealStatus;
+  resolution?: string; // admin's answer to the player
+  resolved_at?: string;
+  created_at: string;
+  updated_at: string;
+}
+
+export interface CreateAppealRequest {
+  turn_count: number;
+  comment: string;
+}
+
 // [Backend DTO] Paginated response wrapper
 export interface PaginatedResponse<T> {
   data: T[];
@@ -1,4 +1,4 @@
-import type { MatchStatus } from '$lib/features/game/types';
+import type { AppealStatus, JudgeOutcome, MatchStatus } from '$lib/features/game/types';
 
 export function getJudgeBadgeStyle(judgeType: string): { label: string; classes: string } {
 	switch (judgeType) {
@@ -17,6 +17,21 @@ export function getJudgeBadgeStyle(judgeType: string): { label: string; classes:
 				label: 'Format Break',
 				classes: 'bg-orange-500/90 text-white border border-orange-300/40'
 			};
+		case 'regex':
+			return {
+				label: 'Regex',
+				classes: 'bg-teal-500/90 text-white border border-teal-300/40'
+			};
+		case 'json_schema':
+			return {
+				label: 'JSON Schema',
+				classes: 'bg-indigo-500/90 text-white border border-indigo-300/40'
+			};
+		case 'composite':
+			return {
+				label: 'Composite',
+				classes: 'bg-pink-500/90 text-white border border-pink-300/40'
+			};
 		default:
 			return {
 				label: 'Unknown',
@@ -87,3 +102,51 @@ export function getShortStatusLabel(status: MatchStatus): string {
 			return status;
 	}
 }
+
+export function getVerdictOutcomeLabel(outcome: JudgeOutcome): string {
+	switch (outcome) {
+		case 'won':
+			return '승리 판정';
+		case 'lost':
+			return '패배 판정';
+		default:
+			return '계속';
+	}
+}
+
+export function getVerdictOutcomeColor(outcome: JudgeOutcome): string {
+	switch (outcome) {
+		case 'won':
+			return 'bg-green-500/20 text-green-400 border-green-500/30';
+		case 'lost':
+			return 'bg-red-500/20 text-red-400 border-red-500/30';
+		default:
+			return 'bg-gray-500/20 text-gray-400 border-gray-500/30';
+	}
+}
+
+export function getAppealStatusLabel(status: AppealStatus): string {
+	switch (status) {
+		case 'pending':
+			return '검토 중';
+		case 'upheld':
+			return '판정 유지';
+		case 'overturned':
+			return '판정 번복';
+		default:
+			return status;
+	}
+}
+
+export function getAppealStatusColor(status: AppealStatus): string {
+	switch (status) {
+		case 'pending':
+			return 'bg-yellow-500/20 text-yellow-400 border-yellow-500/30';
+		case 'upheld':
+			return 'bg-gray-500/20 text-gray-400 border-gray-500/30';
+		case 'overturned':
+			return 'bg-green-500/20 text-green-400 border-green-500/30';
+		default:
+			return 'bg-gray-500/20 text-gray-400 border-gray-500/30';
+	}
+}